@@ -33,6 +33,9 @@ type ClientBuilder struct {
 	StorageUseAzureAD           bool
 	SubscriptionID              string
 	TerraformVersion            string
+
+	// RequestRetrier is optional, and when specified can retry requests to Resource Manager which have failed
+	RequestRetrier common.RequestRetrier
 }
 
 const azureStackEnvironmentError = `
@@ -139,6 +142,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		TenantId:         account.TenantId,
 		PartnerId:        builder.PartnerID,
 		TerraformVersion: builder.TerraformVersion,
+		RequestRetrier:   builder.RequestRetrier,
 
 		BatchManagementAuthorizer: authWrapper.AutorestAuthorizer(batchManagementAuth),
 		KeyVaultAuthorizer:        authWrapper.AutorestAuthorizer(keyVaultAuth).BearerAuthorizerCallback(),
@@ -5,6 +5,7 @@ package common
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
 
@@ -31,6 +32,10 @@ type Authorizers struct {
 
 type ApiAuthorizerFunc func(api environments.Api) (auth.Authorizer, error)
 
+// RequestRetrier is called with each response from Resource Manager, and can perform the request again (using `send`)
+// once whatever caused the request to fail has been resolved - returning the original response when it isn't retried
+type RequestRetrier func(req *http.Request, resp *http.Response, send func(*http.Request) (*http.Response, error)) (*http.Response, error)
+
 type ClientOptions struct {
 	Authorizers *Authorizers
 	AuthConfig  *auth.Credentials
@@ -50,6 +55,9 @@ type ClientOptions struct {
 
 	ResourceManagerEndpoint string
 
+	// RequestRetrier is optional, when specified this is used by both the go-azure-sdk and go-autorest clients
+	RequestRetrier RequestRetrier
+
 	// Legacy authorizers for go-autorest
 	BatchManagementAuthorizer autorest.Authorizer
 	KeyVaultAuthorizer        autorest.Authorizer
@@ -76,6 +84,11 @@ func (o ClientOptions) Configure(c client.BaseClient, authorizer auth.Authorizer
 
 	c.AppendRequestMiddleware(requestLoggerMiddleware("AzureRM"))
 	c.AppendResponseMiddleware(responseLoggerMiddleware("AzureRM"))
+
+	if o.RequestRetrier != nil {
+		c.AppendRequestMiddleware(retryableRequestBodyMiddleware())
+		c.AppendResponseMiddleware(requestRetrierMiddleware(o.RequestRetrier))
+	}
 }
 
 // ConfigureClient sets up an autorest.Client using an autorest.Authorizer
@@ -84,6 +97,9 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 
 	c.Authorizer = authorizer
 	c.Sender = sender.BuildSender("AzureRM")
+	if o.RequestRetrier != nil {
+		c.Sender = autorest.DecorateSender(c.Sender, withRequestRetrier(o.RequestRetrier))
+	}
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
 	if !o.DisableCorrelationRequestID {
 		id := o.CustomCorrelationRequestID
@@ -4,10 +4,14 @@
 package common
 
 import (
+	"bytes"
+	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"net/http/httputil"
 
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/go-azure-sdk/sdk/client"
 )
 
@@ -59,3 +63,57 @@ func responseLoggerMiddleware(providerName string) client.ResponseMiddleware {
 		return response, nil
 	}
 }
+
+// retryableRequestBodyMiddleware buffers the body of the request, so that the request can be sent again by the
+// RequestRetrier
+func retryableRequestBodyMiddleware() client.RequestMiddleware {
+	return func(request *http.Request) (*http.Request, error) {
+		if err := bufferRequestBody(request); err != nil {
+			return nil, err
+		}
+		return request, nil
+	}
+}
+
+func requestRetrierMiddleware(retrier RequestRetrier) client.ResponseMiddleware {
+	return func(request *http.Request, response *http.Response) (*http.Response, error) {
+		return retrier(request, response, http.DefaultClient.Do)
+	}
+}
+
+func withRequestRetrier(retrier RequestRetrier) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(request *http.Request) (*http.Response, error) {
+			if err := bufferRequestBody(request); err != nil {
+				return nil, err
+			}
+
+			response, err := s.Do(request)
+			if err != nil {
+				return response, err
+			}
+
+			return retrier(request, response, s.Do)
+		})
+	}
+}
+
+// bufferRequestBody reads the body of the request, allowing a copy of the body to be obtained using `GetBody`
+func bufferRequestBody(request *http.Request) error {
+	if request.Body == nil || request.Body == http.NoBody || request.GetBody != nil {
+		return nil
+	}
+
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		return fmt.Errorf("reading request body: %+v", err)
+	}
+	_ = request.Body.Close()
+
+	request.Body = io.NopCloser(bytes.NewReader(body))
+	request.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	return nil
+}
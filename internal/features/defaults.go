@@ -39,7 +39,8 @@ func Default() UserFeatures {
 			PermanentlyDeleteOnDestroy: false,
 		},
 		ManagedDisk: ManagedDiskFeatures{
-			ExpandWithoutDowntime: true,
+			ExpandWithoutDowntime:      true,
+			FallbackToOfflineExpansion: false,
 		},
 		ResourceGroup: ResourceGroupFeatures{
 			PreventDeletionIfContainsResources: true,
@@ -60,6 +61,7 @@ func Default() UserFeatures {
 			ForceDelete:               false,
 			ReimageOnManualUpgrade:    true,
 			RollInstancesWhenRequired: true,
+			RollInstancesBatchSize:    1,
 			ScaleToZeroOnDelete:       true,
 		},
 		Storage: StorageFeatures{
@@ -86,5 +88,10 @@ func Default() UserFeatures {
 		DatabricksWorkspace: DatabricksWorkspaceFeatures{
 			ForceDelete: false,
 		},
+		ManagementLock: ManagementLockFeatures{
+			Enabled:                  false,
+			DetectDuringPlan:         false,
+			TemporarilyRemoveLockIds: make([]string, 0),
+		},
 	}
 }
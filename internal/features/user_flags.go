@@ -23,6 +23,7 @@ type UserFeatures struct {
 	RecoveryService          RecoveryServiceFeatures
 	NetApp                   NetAppFeatures
 	DatabricksWorkspace      DatabricksWorkspaceFeatures
+	ManagementLock           ManagementLockFeatures
 }
 
 type CognitiveAccountFeatures struct {
@@ -37,10 +38,14 @@ type VirtualMachineFeatures struct {
 }
 
 type VirtualMachineScaleSetFeatures struct {
-	ForceDelete               bool
-	ReimageOnManualUpgrade    bool
-	RollInstancesWhenRequired bool
-	ScaleToZeroOnDelete       bool
+	ForceDelete                               bool
+	ReimageOnManualUpgrade                    bool
+	RollInstancesWhenRequired                 bool
+	RollInstancesBatchSize                    int
+	RollInstancesBatchPercent                 int
+	RollInstancesPauseBetweenBatchesInSeconds int
+	RollInstancesWaitForHealthyInstances      bool
+	ScaleToZeroOnDelete                       bool
 }
 
 type KeyVaultFeatures struct {
@@ -79,7 +84,8 @@ type ApplicationInsightFeatures struct {
 }
 
 type ManagedDiskFeatures struct {
-	ExpandWithoutDowntime bool
+	ExpandWithoutDowntime      bool
+	FallbackToOfflineExpansion bool
 }
 
 type AppConfigurationFeatures struct {
@@ -121,3 +127,9 @@ type NetAppFeatures struct {
 type DatabricksWorkspaceFeatures struct {
 	ForceDelete bool
 }
+
+type ManagementLockFeatures struct {
+	Enabled                  bool
+	DetectDuringPlan         bool
+	TemporarilyRemoveLockIds []string
+}
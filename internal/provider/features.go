@@ -6,9 +6,11 @@ package provider
 import (
 	"os"
 
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/managementlocks"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
 func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
@@ -250,6 +252,29 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 						Optional: true,
 						Default:  true,
 					},
+					"roll_instances_batch_size": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      1,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+					"roll_instances_batch_percent": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      0,
+						ValidateFunc: validation.IntBetween(0, 100),
+					},
+					"roll_instances_pause_between_batches_in_seconds": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      0,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+					"roll_instances_wait_for_healthy_instances": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
 					"scale_to_zero_before_deletion": {
 						Type:     pluginsdk.TypeBool,
 						Optional: true,
@@ -300,6 +325,11 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 						Optional: true,
 						Default:  true,
 					},
+					"fallback_to_offline_expansion": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
 				},
 			},
 		},
@@ -427,6 +457,37 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 				},
 			},
 		},
+
+		"management_lock": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"enabled": {
+						Description: "When enabled, operations which are blocked by Management Locks report the Management Locks responsible, and `detect_during_plan` and `temporarily_remove_lock_ids` take effect.",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+					"detect_during_plan": {
+						Description: "When enabled, Management Locks which would block changes to existing resources are detected during the plan.",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+					"temporarily_remove_lock_ids": {
+						Description: "A list of IDs of Management Locks managed by this configuration which are removed for the duration of an operation which they block, and then recreated.",
+						Type:        pluginsdk.TypeList,
+						Optional:    true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: managementlocks.ValidateScopedLockID,
+						},
+					},
+				},
+			},
+		},
 	}
 
 	if !features.FivePointOh() {
@@ -605,6 +666,18 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 			if v, ok := scaleSetRaw["roll_instances_when_required"]; ok {
 				featuresMap.VirtualMachineScaleSet.RollInstancesWhenRequired = v.(bool)
 			}
+			if v, ok := scaleSetRaw["roll_instances_batch_size"]; ok {
+				featuresMap.VirtualMachineScaleSet.RollInstancesBatchSize = v.(int)
+			}
+			if v, ok := scaleSetRaw["roll_instances_batch_percent"]; ok {
+				featuresMap.VirtualMachineScaleSet.RollInstancesBatchPercent = v.(int)
+			}
+			if v, ok := scaleSetRaw["roll_instances_pause_between_batches_in_seconds"]; ok {
+				featuresMap.VirtualMachineScaleSet.RollInstancesPauseBetweenBatchesInSeconds = v.(int)
+			}
+			if v, ok := scaleSetRaw["roll_instances_wait_for_healthy_instances"]; ok {
+				featuresMap.VirtualMachineScaleSet.RollInstancesWaitForHealthyInstances = v.(bool)
+			}
 			if v, ok := scaleSetRaw["force_delete"]; ok {
 				featuresMap.VirtualMachineScaleSet.ForceDelete = v.(bool)
 			}
@@ -641,6 +714,9 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 			if v, ok := managedDiskRaw["expand_without_downtime"]; ok {
 				featuresMap.ManagedDisk.ExpandWithoutDowntime = v.(bool)
 			}
+			if v, ok := managedDiskRaw["fallback_to_offline_expansion"]; ok {
+				featuresMap.ManagedDisk.FallbackToOfflineExpansion = v.(bool)
+			}
 		}
 	}
 	if raw, ok := val["storage"]; ok {
@@ -722,5 +798,25 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["management_lock"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			managementLockRaw := items[0].(map[string]interface{})
+			if v, ok := managementLockRaw["enabled"]; ok {
+				featuresMap.ManagementLock.Enabled = v.(bool)
+			}
+			if v, ok := managementLockRaw["detect_during_plan"]; ok {
+				featuresMap.ManagementLock.DetectDuringPlan = v.(bool)
+			}
+			if v, ok := managementLockRaw["temporarily_remove_lock_ids"]; ok {
+				lockIds := make([]string, 0)
+				for _, id := range v.([]interface{}) {
+					lockIds = append(lockIds, id.(string))
+				}
+				featuresMap.ManagementLock.TemporarilyRemoveLockIds = lockIds
+			}
+		}
+	}
+
 	return featuresMap
 }
@@ -66,6 +66,7 @@ func TestExpandFeatures(t *testing.T) {
 					ForceDelete:               false,
 					ReimageOnManualUpgrade:    true,
 					RollInstancesWhenRequired: true,
+					RollInstancesBatchSize:    1,
 					ScaleToZeroOnDelete:       true,
 				},
 				ResourceGroup: features.ResourceGroupFeatures{
@@ -98,6 +99,11 @@ func TestExpandFeatures(t *testing.T) {
 				DatabricksWorkspace: features.DatabricksWorkspaceFeatures{
 					ForceDelete: false,
 				},
+				ManagementLock: features.ManagementLockFeatures{
+					Enabled:                  false,
+					DetectDuringPlan:         false,
+					TemporarilyRemoveLockIds: []string{},
+				},
 			},
 		},
 		{
@@ -148,7 +154,8 @@ func TestExpandFeatures(t *testing.T) {
 					},
 					"managed_disk": []interface{}{
 						map[string]interface{}{
-							"expand_without_downtime": true,
+							"expand_without_downtime":       true,
+							"fallback_to_offline_expansion": true,
 						},
 					},
 					"postgresql_flexible_server": []interface{}{
@@ -190,10 +197,14 @@ func TestExpandFeatures(t *testing.T) {
 					},
 					"virtual_machine_scale_set": []interface{}{
 						map[string]interface{}{
-							"reimage_on_manual_upgrade":     true,
-							"roll_instances_when_required":  true,
-							"force_delete":                  true,
-							"scale_to_zero_before_deletion": true,
+							"reimage_on_manual_upgrade":                       true,
+							"roll_instances_when_required":                    true,
+							"roll_instances_batch_size":                       5,
+							"roll_instances_batch_percent":                    20,
+							"roll_instances_pause_between_batches_in_seconds": 30,
+							"roll_instances_wait_for_healthy_instances":       true,
+							"force_delete":                                    true,
+							"scale_to_zero_before_deletion":                   true,
 						},
 					},
 					"machine_learning": []interface{}{
@@ -219,6 +230,15 @@ func TestExpandFeatures(t *testing.T) {
 							"force_delete": true,
 						},
 					},
+					"management_lock": []interface{}{
+						map[string]interface{}{
+							"enabled":            true,
+							"detect_during_plan": true,
+							"temporarily_remove_lock_ids": []interface{}{
+								"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Authorization/locks/example",
+							},
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -253,7 +273,8 @@ func TestExpandFeatures(t *testing.T) {
 					PermanentlyDeleteOnDestroy: true,
 				},
 				ManagedDisk: features.ManagedDiskFeatures{
-					ExpandWithoutDowntime: true,
+					ExpandWithoutDowntime:      true,
+					FallbackToOfflineExpansion: true,
 				},
 				ResourceGroup: features.ResourceGroupFeatures{
 					PreventDeletionIfContainsResources: true,
@@ -276,10 +297,14 @@ func TestExpandFeatures(t *testing.T) {
 					SkipShutdownAndForceDelete:       true,
 				},
 				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
-					ReimageOnManualUpgrade:    true,
-					RollInstancesWhenRequired: true,
-					ForceDelete:               true,
-					ScaleToZeroOnDelete:       true,
+					ReimageOnManualUpgrade:                    true,
+					RollInstancesWhenRequired:                 true,
+					RollInstancesBatchSize:                    5,
+					RollInstancesBatchPercent:                 20,
+					RollInstancesPauseBetweenBatchesInSeconds: 30,
+					RollInstancesWaitForHealthyInstances:      true,
+					ForceDelete:                               true,
+					ScaleToZeroOnDelete:                       true,
 				},
 				PostgresqlFlexibleServer: features.PostgresqlFlexibleServerFeatures{
 					RestartServerOnConfigurationValueChange: true,
@@ -299,6 +324,13 @@ func TestExpandFeatures(t *testing.T) {
 				DatabricksWorkspace: features.DatabricksWorkspaceFeatures{
 					ForceDelete: true,
 				},
+				ManagementLock: features.ManagementLockFeatures{
+					Enabled:          true,
+					DetectDuringPlan: true,
+					TemporarilyRemoveLockIds: []string{
+						"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Authorization/locks/example",
+					},
+				},
 			},
 		},
 		{
@@ -349,7 +381,8 @@ func TestExpandFeatures(t *testing.T) {
 					},
 					"managed_disk": []interface{}{
 						map[string]interface{}{
-							"expand_without_downtime": false,
+							"expand_without_downtime":       false,
+							"fallback_to_offline_expansion": false,
 						},
 					},
 					"postgresql_flexible_server": []interface{}{
@@ -391,10 +424,14 @@ func TestExpandFeatures(t *testing.T) {
 					},
 					"virtual_machine_scale_set": []interface{}{
 						map[string]interface{}{
-							"force_delete":                  false,
-							"reimage_on_manual_upgrade":     false,
-							"roll_instances_when_required":  false,
-							"scale_to_zero_before_deletion": false,
+							"force_delete":                                    false,
+							"reimage_on_manual_upgrade":                       false,
+							"roll_instances_when_required":                    false,
+							"roll_instances_batch_size":                       1,
+							"roll_instances_batch_percent":                    0,
+							"roll_instances_pause_between_batches_in_seconds": 0,
+							"roll_instances_wait_for_healthy_instances":       false,
+							"scale_to_zero_before_deletion":                   false,
 						},
 					},
 					"machine_learning": []interface{}{
@@ -420,6 +457,13 @@ func TestExpandFeatures(t *testing.T) {
 							"force_delete": false,
 						},
 					},
+					"management_lock": []interface{}{
+						map[string]interface{}{
+							"enabled":                     false,
+							"detect_during_plan":          false,
+							"temporarily_remove_lock_ids": []interface{}{},
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -480,6 +524,7 @@ func TestExpandFeatures(t *testing.T) {
 					ForceDelete:               false,
 					ReimageOnManualUpgrade:    false,
 					RollInstancesWhenRequired: false,
+					RollInstancesBatchSize:    1,
 					ScaleToZeroOnDelete:       false,
 				},
 				PostgresqlFlexibleServer: features.PostgresqlFlexibleServerFeatures{
@@ -500,6 +545,11 @@ func TestExpandFeatures(t *testing.T) {
 				DatabricksWorkspace: features.DatabricksWorkspaceFeatures{
 					ForceDelete: false,
 				},
+				ManagementLock: features.ManagementLockFeatures{
+					Enabled:                  false,
+					DetectDuringPlan:         false,
+					TemporarilyRemoveLockIds: []string{},
+				},
 			},
 		},
 	}
@@ -1121,6 +1171,7 @@ func TestExpandFeaturesVirtualMachineScaleSet(t *testing.T) {
 				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
 					ReimageOnManualUpgrade:    true,
 					RollInstancesWhenRequired: true,
+					RollInstancesBatchSize:    1,
 					ScaleToZeroOnDelete:       true,
 				},
 			},
@@ -1142,6 +1193,7 @@ func TestExpandFeaturesVirtualMachineScaleSet(t *testing.T) {
 					ForceDelete:               true,
 					ReimageOnManualUpgrade:    true,
 					RollInstancesWhenRequired: false,
+					RollInstancesBatchSize:    1,
 					ScaleToZeroOnDelete:       true,
 				},
 			},
@@ -1163,6 +1215,7 @@ func TestExpandFeaturesVirtualMachineScaleSet(t *testing.T) {
 					ForceDelete:               false,
 					ReimageOnManualUpgrade:    true,
 					RollInstancesWhenRequired: true,
+					RollInstancesBatchSize:    1,
 					ScaleToZeroOnDelete:       true,
 				},
 			},
@@ -1185,10 +1238,36 @@ func TestExpandFeaturesVirtualMachineScaleSet(t *testing.T) {
 					ForceDelete:               false,
 					ReimageOnManualUpgrade:    true,
 					RollInstancesWhenRequired: true,
+					RollInstancesBatchSize:    1,
 					ScaleToZeroOnDelete:       false,
 				},
 			},
 		},
+		{
+			Name: "Roll Instances In Batches",
+			Input: []interface{}{
+				map[string]interface{}{
+					"virtual_machine_scale_set": []interface{}{
+						map[string]interface{}{
+							"roll_instances_batch_percent":                    25,
+							"roll_instances_pause_between_batches_in_seconds": 60,
+							"roll_instances_wait_for_healthy_instances":       true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				VirtualMachineScaleSet: features.VirtualMachineScaleSetFeatures{
+					ReimageOnManualUpgrade:                    true,
+					RollInstancesWhenRequired:                 true,
+					RollInstancesBatchSize:                    1,
+					RollInstancesBatchPercent:                 25,
+					RollInstancesPauseBetweenBatchesInSeconds: 60,
+					RollInstancesWaitForHealthyInstances:      true,
+					ScaleToZeroOnDelete:                       true,
+				},
+			},
+		},
 		{
 			Name: "All Fields Disabled",
 			Input: []interface{}{
@@ -1208,6 +1287,7 @@ func TestExpandFeaturesVirtualMachineScaleSet(t *testing.T) {
 					ForceDelete:               false,
 					ReimageOnManualUpgrade:    false,
 					RollInstancesWhenRequired: false,
+					RollInstancesBatchSize:    1,
 					ScaleToZeroOnDelete:       false,
 				},
 			},
@@ -1471,6 +1551,25 @@ func TestExpandFeaturesManagedDisk(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "Offline Expansion Fallback Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"managed_disk": []interface{}{
+						map[string]interface{}{
+							"expand_without_downtime":       true,
+							"fallback_to_offline_expansion": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ManagedDisk: features.ManagedDiskFeatures{
+					ExpandWithoutDowntime:      true,
+					FallbackToOfflineExpansion: true,
+				},
+			},
+		},
 	}
 
 	for _, testCase := range testData {
@@ -1936,3 +2035,104 @@ func TestExpandFeaturesDatabricksWorkspace(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesManagementLock(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"management_lock": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				ManagementLock: features.ManagementLockFeatures{
+					Enabled:                  false,
+					DetectDuringPlan:         false,
+					TemporarilyRemoveLockIds: []string{},
+				},
+			},
+		},
+		{
+			Name: "Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"management_lock": []interface{}{
+						map[string]interface{}{
+							"enabled":                     true,
+							"detect_during_plan":          false,
+							"temporarily_remove_lock_ids": []interface{}{},
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ManagementLock: features.ManagementLockFeatures{
+					Enabled:                  true,
+					DetectDuringPlan:         false,
+					TemporarilyRemoveLockIds: []string{},
+				},
+			},
+		},
+		{
+			Name: "Detect During Plan Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"management_lock": []interface{}{
+						map[string]interface{}{
+							"enabled":                     true,
+							"detect_during_plan":          true,
+							"temporarily_remove_lock_ids": []interface{}{},
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ManagementLock: features.ManagementLockFeatures{
+					Enabled:                  true,
+					DetectDuringPlan:         true,
+					TemporarilyRemoveLockIds: []string{},
+				},
+			},
+		},
+		{
+			Name: "Temporarily Removed Locks",
+			Input: []interface{}{
+				map[string]interface{}{
+					"management_lock": []interface{}{
+						map[string]interface{}{
+							"detect_during_plan": false,
+							"temporarily_remove_lock_ids": []interface{}{
+								"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Authorization/locks/first",
+								"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/locks/second",
+							},
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				ManagementLock: features.ManagementLockFeatures{
+					Enabled:          false,
+					DetectDuringPlan: false,
+					TemporarilyRemoveLockIds: []string{
+						"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Authorization/locks/first",
+						"/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/locks/second",
+					},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.ManagementLock, testCase.Expected.ManagementLock) {
+			t.Fatalf("Expected %+v but got %+v", result.ManagementLock, testCase.Expected.ManagementLock)
+		}
+	}
+}
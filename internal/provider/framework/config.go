@@ -367,6 +367,26 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 				f.VirtualMachineScaleSet.RollInstancesWhenRequired = feature[0].RollInstancesWhenRequired.ValueBool()
 			}
 
+			f.VirtualMachineScaleSet.RollInstancesBatchSize = 1
+			if !feature[0].RollInstancesBatchSize.IsNull() && !feature[0].RollInstancesBatchSize.IsUnknown() {
+				f.VirtualMachineScaleSet.RollInstancesBatchSize = int(feature[0].RollInstancesBatchSize.ValueInt64())
+			}
+
+			f.VirtualMachineScaleSet.RollInstancesBatchPercent = 0
+			if !feature[0].RollInstancesBatchPercent.IsNull() && !feature[0].RollInstancesBatchPercent.IsUnknown() {
+				f.VirtualMachineScaleSet.RollInstancesBatchPercent = int(feature[0].RollInstancesBatchPercent.ValueInt64())
+			}
+
+			f.VirtualMachineScaleSet.RollInstancesPauseBetweenBatchesInSeconds = 0
+			if !feature[0].RollInstancesPauseBetweenBatchesInSeconds.IsNull() && !feature[0].RollInstancesPauseBetweenBatchesInSeconds.IsUnknown() {
+				f.VirtualMachineScaleSet.RollInstancesPauseBetweenBatchesInSeconds = int(feature[0].RollInstancesPauseBetweenBatchesInSeconds.ValueInt64())
+			}
+
+			f.VirtualMachineScaleSet.RollInstancesWaitForHealthyInstances = false
+			if !feature[0].RollInstancesWaitForHealthyInstances.IsNull() && !feature[0].RollInstancesWaitForHealthyInstances.IsUnknown() {
+				f.VirtualMachineScaleSet.RollInstancesWaitForHealthyInstances = feature[0].RollInstancesWaitForHealthyInstances.ValueBool()
+			}
+
 			f.VirtualMachineScaleSet.ScaleToZeroOnDelete = false
 			if !feature[0].ScaleToZeroBeforeDeletion.IsNull() && !feature[0].ScaleToZeroBeforeDeletion.IsUnknown() {
 				f.VirtualMachineScaleSet.ScaleToZeroOnDelete = feature[0].ScaleToZeroBeforeDeletion.ValueBool()
@@ -375,6 +395,10 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 			f.VirtualMachineScaleSet.ForceDelete = false
 			f.VirtualMachineScaleSet.ReimageOnManualUpgrade = true
 			f.VirtualMachineScaleSet.RollInstancesWhenRequired = true
+			f.VirtualMachineScaleSet.RollInstancesBatchSize = 1
+			f.VirtualMachineScaleSet.RollInstancesBatchPercent = 0
+			f.VirtualMachineScaleSet.RollInstancesPauseBetweenBatchesInSeconds = 0
+			f.VirtualMachineScaleSet.RollInstancesWaitForHealthyInstances = false
 			f.VirtualMachineScaleSet.ScaleToZeroOnDelete = false
 		}
 
@@ -406,8 +430,14 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 			if !feature[0].ExpandWithoutDowntime.IsNull() && !feature[0].ExpandWithoutDowntime.IsUnknown() {
 				f.ManagedDisk.ExpandWithoutDowntime = feature[0].ExpandWithoutDowntime.ValueBool()
 			}
+
+			f.ManagedDisk.FallbackToOfflineExpansion = false
+			if !feature[0].FallbackToOfflineExpansion.IsNull() && !feature[0].FallbackToOfflineExpansion.IsUnknown() {
+				f.ManagedDisk.FallbackToOfflineExpansion = feature[0].FallbackToOfflineExpansion.ValueBool()
+			}
 		} else {
 			f.ManagedDisk.ExpandWithoutDowntime = true
+			f.ManagedDisk.FallbackToOfflineExpansion = false
 		}
 
 		if !features.Storage.IsNull() && !features.Storage.IsUnknown() {
@@ -519,6 +549,32 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 		} else {
 			f.DatabricksWorkspace.ForceDelete = false
 		}
+
+		if !features.ManagementLock.IsNull() && !features.ManagementLock.IsUnknown() {
+			var feature []ManagementLock
+			d := features.ManagementLock.ElementsAs(ctx, &feature, true)
+			diags.Append(d...)
+			if diags.HasError() {
+				return
+			}
+
+			f.ManagementLock.DetectDuringPlan = false
+			if !feature[0].DetectDuringPlan.IsNull() && !feature[0].DetectDuringPlan.IsUnknown() {
+				f.ManagementLock.DetectDuringPlan = feature[0].DetectDuringPlan.ValueBool()
+			}
+
+			f.ManagementLock.TemporarilyRemoveLockIds = make([]string, 0)
+			if !feature[0].TemporarilyRemoveLockIds.IsNull() && !feature[0].TemporarilyRemoveLockIds.IsUnknown() {
+				d := feature[0].TemporarilyRemoveLockIds.ElementsAs(ctx, &f.ManagementLock.TemporarilyRemoveLockIds, true)
+				diags.Append(d...)
+				if diags.HasError() {
+					return
+				}
+			}
+		} else {
+			f.ManagementLock.DetectDuringPlan = false
+			f.ManagementLock.TemporarilyRemoveLockIds = make([]string, 0)
+		}
 	}
 
 	p.clientBuilder.Features = f
@@ -167,6 +167,14 @@ func TestProviderConfig_LoadDefault(t *testing.T) {
 		t.Errorf("expected virtual_machine.roll_instances_when_required to be true")
 	}
 
+	if features.VirtualMachineScaleSet.RollInstancesBatchSize != 1 {
+		t.Errorf("expected virtual_machine_scale_set.roll_instances_batch_size to be 1")
+	}
+
+	if features.VirtualMachineScaleSet.RollInstancesWaitForHealthyInstances {
+		t.Errorf("expected virtual_machine_scale_set.roll_instances_wait_for_healthy_instances to be false")
+	}
+
 	if features.VirtualMachineScaleSet.ScaleToZeroOnDelete {
 		t.Errorf("expected virtual_machine.scale_to_zero_on_delete to be false")
 	}
@@ -175,6 +183,10 @@ func TestProviderConfig_LoadDefault(t *testing.T) {
 		t.Errorf("expected managed_disk.expand_without_downtime to be true")
 	}
 
+	if features.ManagedDisk.FallbackToOfflineExpansion {
+		t.Errorf("expected managed_disk.fallback_to_offline_expansion to be false")
+	}
+
 	if features.Subscription.PreventCancellationOnDestroy {
 		t.Errorf("expected subscription.prevent_cancellation_on_destroy to be false")
 	}
@@ -214,6 +226,14 @@ func TestProviderConfig_LoadDefault(t *testing.T) {
 	if features.DatabricksWorkspace.ForceDelete {
 		t.Errorf("expected databricks_workspace.ForceDelete to be false")
 	}
+
+	if features.ManagementLock.DetectDuringPlan {
+		t.Errorf("expected management_lock.DetectDuringPlan to be false")
+	}
+
+	if len(features.ManagementLock.TemporarilyRemoveLockIds) != 0 {
+		t.Errorf("expected management_lock.TemporarilyRemoveLockIds to be empty")
+	}
 }
 
 // TODO - helper functions to make setting up test date more easily so we can add more configuration coverage
@@ -271,10 +291,14 @@ func defaultFeaturesList() types.List {
 	virtualMachineList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(VirtualMachineAttributes), []attr.Value{virtualMachine})
 
 	virtualMachineScaleSet, _ := basetypes.NewObjectValueFrom(context.Background(), VirtualMachineScaleSetAttributes, map[string]attr.Value{
-		"force_delete":                  basetypes.NewBoolNull(),
-		"reimage_on_manual_upgrade":     basetypes.NewBoolNull(),
-		"roll_instances_when_required":  basetypes.NewBoolNull(),
-		"scale_to_zero_before_deletion": basetypes.NewBoolNull(),
+		"force_delete":                                    basetypes.NewBoolNull(),
+		"reimage_on_manual_upgrade":                       basetypes.NewBoolNull(),
+		"roll_instances_when_required":                    basetypes.NewBoolNull(),
+		"roll_instances_batch_size":                       basetypes.NewInt64Null(),
+		"roll_instances_batch_percent":                    basetypes.NewInt64Null(),
+		"roll_instances_pause_between_batches_in_seconds": basetypes.NewInt64Null(),
+		"roll_instances_wait_for_healthy_instances":       basetypes.NewBoolNull(),
+		"scale_to_zero_before_deletion":                   basetypes.NewBoolNull(),
 	})
 	virtualMachineScaleSetList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(VirtualMachineScaleSetAttributes), []attr.Value{virtualMachineScaleSet})
 
@@ -284,7 +308,8 @@ func defaultFeaturesList() types.List {
 	resourceGroupList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(ResourceGroupAttributes), []attr.Value{resourceGroup})
 
 	managedDisk, _ := basetypes.NewObjectValueFrom(context.Background(), ManagedDiskAttributes, map[string]attr.Value{
-		"expand_without_downtime": basetypes.NewBoolNull(),
+		"expand_without_downtime":       basetypes.NewBoolNull(),
+		"fallback_to_offline_expansion": basetypes.NewBoolNull(),
 	})
 	managedDiskList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(ManagedDiskAttributes), []attr.Value{managedDisk})
 
@@ -333,6 +358,12 @@ func defaultFeaturesList() types.List {
 	})
 	databricksWorkspaceList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(DatabricksWorkspaceAttributes), []attr.Value{databricksWorkspace})
 
+	managementLock, _ := basetypes.NewObjectValueFrom(context.Background(), ManagementLockAttributes, map[string]attr.Value{
+		"detect_during_plan":          basetypes.NewBoolNull(),
+		"temporarily_remove_lock_ids": basetypes.NewListNull(types.StringType),
+	})
+	managementLockList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(ManagementLockAttributes), []attr.Value{managementLock})
+
 	fData, d := basetypes.NewObjectValue(FeaturesAttributes, map[string]attr.Value{
 		"api_management":             apiManagementList,
 		"app_configuration":          appConfigurationList,
@@ -353,6 +384,7 @@ func defaultFeaturesList() types.List {
 		"recovery_services_vaults":   recoveryServicesVaultsList,
 		"netapp":                     netappList,
 		"databricks_workspace":       databricksWorkspaceList,
+		"management_lock":            managementLockList,
 	})
 
 	fmt.Printf("%+v", d)
@@ -62,6 +62,7 @@ type Features struct {
 	RecoveryServicesVaults   types.List `tfsdk:"recovery_services_vaults"`
 	NetApp                   types.List `tfsdk:"netapp"`
 	DatabricksWorkspace      types.List `tfsdk:"databricks_workspace"`
+	ManagementLock           types.List `tfsdk:"management_lock"`
 }
 
 // FeaturesAttributes and the other block attribute vars are required for unit testing on the Load func
@@ -86,6 +87,7 @@ var FeaturesAttributes = map[string]attr.Type{
 	"recovery_services_vaults":   types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(RecoveryServiceVaultsAttributes)),
 	"netapp":                     types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(NetAppAttributes)),
 	"databricks_workspace":       types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(DatabricksWorkspaceAttributes)),
+	"management_lock":            types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(ManagementLockAttributes)),
 }
 
 type APIManagement struct {
@@ -183,17 +185,25 @@ var VirtualMachineAttributes = map[string]attr.Type{
 }
 
 type VirtualMachineScaleSet struct {
-	ForceDelete               types.Bool `tfsdk:"force_delete"`
-	ReimageOnManualUpgrade    types.Bool `tfsdk:"reimage_on_manual_upgrade"`
-	RollInstancesWhenRequired types.Bool `tfsdk:"roll_instances_when_required"`
-	ScaleToZeroBeforeDeletion types.Bool `tfsdk:"scale_to_zero_before_deletion"`
+	ForceDelete                               types.Bool  `tfsdk:"force_delete"`
+	ReimageOnManualUpgrade                    types.Bool  `tfsdk:"reimage_on_manual_upgrade"`
+	RollInstancesWhenRequired                 types.Bool  `tfsdk:"roll_instances_when_required"`
+	RollInstancesBatchSize                    types.Int64 `tfsdk:"roll_instances_batch_size"`
+	RollInstancesBatchPercent                 types.Int64 `tfsdk:"roll_instances_batch_percent"`
+	RollInstancesPauseBetweenBatchesInSeconds types.Int64 `tfsdk:"roll_instances_pause_between_batches_in_seconds"`
+	RollInstancesWaitForHealthyInstances      types.Bool  `tfsdk:"roll_instances_wait_for_healthy_instances"`
+	ScaleToZeroBeforeDeletion                 types.Bool  `tfsdk:"scale_to_zero_before_deletion"`
 }
 
 var VirtualMachineScaleSetAttributes = map[string]attr.Type{
-	"force_delete":                  types.BoolType,
-	"reimage_on_manual_upgrade":     types.BoolType,
-	"roll_instances_when_required":  types.BoolType,
-	"scale_to_zero_before_deletion": types.BoolType,
+	"force_delete":                                    types.BoolType,
+	"reimage_on_manual_upgrade":                       types.BoolType,
+	"roll_instances_when_required":                    types.BoolType,
+	"roll_instances_batch_size":                       types.Int64Type,
+	"roll_instances_batch_percent":                    types.Int64Type,
+	"roll_instances_pause_between_batches_in_seconds": types.Int64Type,
+	"roll_instances_wait_for_healthy_instances":       types.BoolType,
+	"scale_to_zero_before_deletion":                   types.BoolType,
 }
 
 type ResourceGroup struct {
@@ -205,11 +215,13 @@ var ResourceGroupAttributes = map[string]attr.Type{
 }
 
 type ManagedDisk struct {
-	ExpandWithoutDowntime types.Bool `tfsdk:"expand_without_downtime"`
+	ExpandWithoutDowntime      types.Bool `tfsdk:"expand_without_downtime"`
+	FallbackToOfflineExpansion types.Bool `tfsdk:"fallback_to_offline_expansion"`
 }
 
 var ManagedDiskAttributes = map[string]attr.Type{
-	"expand_without_downtime": types.BoolType,
+	"expand_without_downtime":       types.BoolType,
+	"fallback_to_offline_expansion": types.BoolType,
 }
 
 type Storage struct {
@@ -281,3 +293,13 @@ type DatabricksWorkspace struct {
 var DatabricksWorkspaceAttributes = map[string]attr.Type{
 	"force_delete": types.BoolType,
 }
+
+type ManagementLock struct {
+	DetectDuringPlan         types.Bool `tfsdk:"detect_during_plan"`
+	TemporarilyRemoveLockIds types.List `tfsdk:"temporarily_remove_lock_ids"`
+}
+
+var ManagementLockAttributes = map[string]attr.Type{
+	"detect_during_plan":          types.BoolType,
+	"temporarily_remove_lock_ids": types.ListType{}.WithElementType(types.StringType),
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
@@ -394,6 +395,27 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 									"roll_instances_when_required": schema.BoolAttribute{
 										Optional: true,
 									},
+									"roll_instances_batch_size": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+									"roll_instances_batch_percent": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.Between(0, 100),
+										},
+									},
+									"roll_instances_pause_between_batches_in_seconds": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
+									},
+									"roll_instances_wait_for_healthy_instances": schema.BoolAttribute{
+										Optional: true,
+									},
 									"scale_to_zero_before_deletion": schema.BoolAttribute{
 										Optional: true,
 									},
@@ -415,6 +437,9 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 									"expand_without_downtime": schema.BoolAttribute{
 										Optional: true,
 									},
+									"fallback_to_offline_expansion": schema.BoolAttribute{
+										Optional: true,
+									},
 								},
 							},
 						},
@@ -502,6 +527,21 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 								},
 							},
 						},
+						"management_lock": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"detect_during_plan": schema.BoolAttribute{
+										Optional:    true,
+										Description: "When enabled, Management Locks which would block changes to existing resources are detected during the plan.",
+									},
+									"temporarily_remove_lock_ids": schema.ListAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "A list of IDs of Management Locks managed by this configuration which are removed for the duration of an operation which they block, and then recreated.",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -582,6 +622,7 @@ func (p *azureRmFrameworkProvider) EphemeralResources(_ context.Context) []func(
 
 func (p *azureRmFrameworkProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
+		providerfunction.NewNormalisePolicyJSONFunction,
 		providerfunction.NewNormaliseResourceIDFunction,
 		providerfunction.NewParseResourceIDFunction,
 	}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/policyrule"
+)
+
+type NormalisePolicyJSONFunction struct{}
+
+var _ function.Function = NormalisePolicyJSONFunction{}
+
+func NewNormalisePolicyJSONFunction() function.Function {
+	return &NormalisePolicyJSONFunction{}
+}
+
+func (a NormalisePolicyJSONFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "normalise_policy_json"
+}
+
+func (a NormalisePolicyJSONFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Summary:             "normalise_policy_json",
+		Description:         "Renders an Azure Policy Rule, Parameters or Policy Definition as canonical JSON, with sorted keys and the Policy Rule keywords and effects in their documented casing",
+		MarkdownDescription: "Renders an Azure Policy Rule, Parameters or Policy Definition as canonical JSON, with sorted keys and the Policy Rule keywords and effects in their documented casing",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "policy",
+				Description:         "Policy JSON as a string, or an object to render as JSON",
+				MarkdownDescription: "Policy JSON as a string, or an object to render as JSON",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (a NormalisePolicyJSONFunction) Run(ctx context.Context, request function.RunRequest, response *function.RunResponse) {
+	var input types.Dynamic
+
+	response.Error = function.ConcatFuncErrors(request.Arguments.Get(ctx, &input))
+
+	if response.Error != nil {
+		return
+	}
+
+	var result string
+	var err error
+	if v, ok := input.UnderlyingValue().(basetypes.StringValue); ok {
+		result, err = policyrule.NormaliseJSON(v.ValueString())
+	} else {
+		var value interface{}
+		value, err = policyJSONValue(input.UnderlyingValue())
+		if err == nil {
+			result, err = policyrule.Render(value)
+		}
+	}
+	if err != nil {
+		response.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	response.Error = function.ConcatFuncErrors(response.Result.Set(ctx, result))
+}
+
+// policyJSONValue converts a Terraform value into the equivalent value for encoding as JSON
+func policyJSONValue(input attr.Value) (interface{}, error) {
+	if input == nil || input.IsNull() {
+		return nil, nil
+	}
+	if input.IsUnknown() {
+		return nil, fmt.Errorf("the value must be known")
+	}
+
+	switch v := input.(type) {
+	case basetypes.DynamicValue:
+		return policyJSONValue(v.UnderlyingValue())
+	case basetypes.StringValue:
+		return v.ValueString(), nil
+	case basetypes.BoolValue:
+		return v.ValueBool(), nil
+	case basetypes.NumberValue:
+		return json.Number(v.ValueBigFloat().Text('f', -1)), nil
+	case basetypes.ObjectValue:
+		return policyJSONObject(v.Attributes())
+	case basetypes.MapValue:
+		return policyJSONObject(v.Elements())
+	case basetypes.ListValue:
+		return policyJSONArray(v.Elements())
+	case basetypes.SetValue:
+		return policyJSONArray(v.Elements())
+	case basetypes.TupleValue:
+		return policyJSONArray(v.Elements())
+	}
+
+	return nil, fmt.Errorf("values of type %s are not supported", input.Type(context.Background()))
+}
+
+func policyJSONObject(input map[string]attr.Value) (interface{}, error) {
+	output := make(map[string]interface{}, len(input))
+	for key, value := range input {
+		v, err := policyJSONValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %+v", key, err)
+		}
+		output[key] = v
+	}
+	return output, nil
+}
+
+func policyJSONArray(input []attr.Value) (interface{}, error) {
+	output := make([]interface{}, 0, len(input))
+	for i, value := range input {
+		v, err := policyJSONValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("[%d]: %+v", i, err)
+		}
+		output = append(output, v)
+	}
+	return output, nil
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestProviderFunctionNormalisePolicyJSON_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0-beta1"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: testNormalisePolicyJSONOutput(),
+				Check: acceptance.ComposeTestCheckFunc(
+					acceptance.TestCheckOutput("string", `{"if":{"equals":"Microsoft.Web/sites","field":"type"},"then":{"effect":"deny"}}`),
+					acceptance.TestCheckOutput("object", `{"if":{"allOf":[{"equals":"Microsoft.Web/sites","field":"type"},{"field":"location","notIn":"[parameters('allowedLocations')]"}]},"then":{"effect":"audit"}}`),
+				),
+			},
+		},
+	})
+}
+
+func testNormalisePolicyJSONOutput() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+output "string" {
+  value = provider::azurerm::normalise_policy_json(<<JSON
+{
+  "Then": { "Effect": "Deny" },
+  "If": { "Field": "type", "Equals": "Microsoft.Web/sites" }
+}
+JSON
+  )
+}
+
+output "object" {
+  value = provider::azurerm::normalise_policy_json({
+    if = {
+      AllOf = [
+        { field = "type", equals = "Microsoft.Web/sites" },
+        { field = "location", NotIn = "[parameters('allowedLocations')]" },
+      ]
+    }
+    then = {
+      effect = "Audit"
+    }
+  })
+}
+`
+}
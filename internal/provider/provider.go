@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/resourceproviders"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/managementlock"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
@@ -138,6 +139,11 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 		}
 	}
 
+	// any Resource can be blocked by a Management Lock on a parent scope, so these are handled centrally
+	for _, resource := range resources {
+		managementlock.WrapResource(resource)
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -545,7 +551,15 @@ func buildClient(ctx context.Context, p *schema.Provider, d *schema.ResourceData
 		stopCtx = ctx
 	}
 
-	client, err := clients.Build(stopCtx, clientBuilder)
+	// Management Locks listed in `temporarily_remove_lock_ids` are removed whilst retrying the request which they blocked
+	var client *clients.Client
+	if lockFeatures := clientBuilder.Features.ManagementLock; lockFeatures.Enabled && len(lockFeatures.TemporarilyRemoveLockIds) > 0 {
+		clientBuilder.RequestRetrier = managementlock.RequestRetrier(lockFeatures.TemporarilyRemoveLockIds, func() *clients.Client {
+			return client
+		})
+	}
+
+	client, err = clients.Build(stopCtx, clientBuilder)
 	if err != nil {
 		return nil, diag.FromErr(err)
 	}
@@ -346,9 +346,12 @@ func SupportedFrameworkServices() []sdk.FrameworkServiceRegistration {
 		// Services with Framework Resources, Data Sources, or Ephemeral Resources to be listed here
 		// e.g.
 		// resource.Registration{}
+		authorization.Registration{},
 		compute.Registration{},
+		containers.Registration{},
 		keyvault.Registration{},
 		network.Registration{},
+		privatedns.Registration{},
 		storage.Registration{},
 	}
 
@@ -6,6 +6,7 @@ package sdk
 import (
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 )
@@ -43,3 +44,14 @@ func (r *ListResourceMetadata) Defaults(request resource.ConfigureRequest, respo
 	r.SubscriptionId = c.Account.SubscriptionId
 	r.Features = c.Features
 }
+
+// ResourceMetaData returns a ResourceMetaData for `d`, allowing List Resources for Typed Resources to reuse the
+// Resource's flatten functions when populating the results
+func (r *ListResourceMetadata) ResourceMetaData(d *schema.ResourceData) ResourceMetaData {
+	return ResourceMetaData{
+		Client:                   r.Client,
+		Logger:                   NullLogger{},
+		ResourceData:             d,
+		serializationDebugLogger: NullLogger{},
+	}
+}
@@ -41,3 +41,8 @@ func (d *DiagnosticsLogger) Warnf(format string, args ...interface{}) {
 		AttributePath: nil,
 	})
 }
+
+// Diagnostics returns the warnings which have been logged
+func (d *DiagnosticsLogger) Diagnostics() diag.Diagnostics {
+	return d.diagnostics
+}
@@ -122,6 +122,20 @@ type ResourceWithIdentityTypeOverride interface {
 	IdentityType() pluginsdk.ResourceTypeForIdentity
 }
 
+// ResourceWithCompositeIdentity is an optional interface for Resources whose ID isn't a Resource ID (for example
+// an ID composed of several other IDs) and so can't implement ResourceWithIdentity.
+//
+// Resources implementing this interface are responsible for setting the identity data in the Read function.
+type ResourceWithCompositeIdentity interface {
+	Resource
+
+	// IdentitySchema returns the schema for the resource's identity
+	IdentitySchema() map[string]*pluginsdk.Schema
+
+	// IDFromIdentity returns the ID of the resource from the identity data provided in an import block
+	IDFromIdentity(identity *schema.IdentityData) (string, error)
+}
+
 // ResourceWithUpdate is an optional interface
 //
 // Notably the Arguments for Resources implementing this interface
@@ -205,6 +205,36 @@ and we recommend using the %[2]q resource instead.
 		}, idType)
 	}
 
+	if v, ok := rw.resource.(ResourceWithCompositeIdentity); ok {
+		if _, ok := rw.resource.(ResourceWithIdentity); ok {
+			return nil, fmt.Errorf("Resource %q can implement either ResourceWithIdentity or ResourceWithCompositeIdentity but not both", rw.resource.ResourceType())
+		}
+
+		resource.Identity = &schema.ResourceIdentity{
+			SchemaFunc: v.IdentitySchema,
+		}
+
+		// when importing using the resource identity there's no ID, so this is built from the identity data before
+		// being validated by the existing importer
+		importer := resource.Importer.StateContext
+		resource.Importer.StateContext = func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+			if d.Id() == "" {
+				identity, err := d.Identity()
+				if err != nil {
+					return nil, fmt.Errorf("getting identity: %+v", err)
+				}
+
+				id, err := v.IDFromIdentity(identity)
+				if err != nil {
+					return nil, err
+				}
+				d.SetId(id)
+			}
+
+			return importer(ctx, d, meta)
+		}
+	}
+
 	return &resource, nil
 }
 
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/roleassignmentschedulerequests"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/roleassignmentschedules"
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
@@ -27,7 +28,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
-var _ sdk.Resource = PimActiveRoleAssignmentResource{}
+var (
+	_ sdk.Resource                      = PimActiveRoleAssignmentResource{}
+	_ sdk.ResourceWithCompositeIdentity = PimActiveRoleAssignmentResource{}
+)
 
 type PimActiveRoleAssignmentResource struct{}
 
@@ -69,6 +73,14 @@ func (PimActiveRoleAssignmentResource) IDValidationFunc() pluginsdk.SchemaValida
 	return validate.PimRoleAssignmentID
 }
 
+func (PimActiveRoleAssignmentResource) IdentitySchema() map[string]*pluginsdk.Schema {
+	return pimRoleAssignmentIdentitySchema()
+}
+
+func (PimActiveRoleAssignmentResource) IDFromIdentity(identity *schema.IdentityData) (string, error) {
+	return pimRoleAssignmentIDFromIdentity(identity)
+}
+
 func (PimActiveRoleAssignmentResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"scope": {
@@ -490,6 +502,10 @@ func (r PimActiveRoleAssignmentResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := setPimRoleAssignmentIdentity(metadata.ResourceData, *id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
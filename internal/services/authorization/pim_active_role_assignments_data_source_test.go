@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type PimActiveRoleAssignmentsDataSource struct{}
+
+func TestAccPimActiveRoleAssignmentsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_pim_active_role_assignments", "test")
+	d := PimActiveRoleAssignmentsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("role_assignments.#").HasValue("1"),
+				check.That(data.ResourceName).Key("role_assignments.0.pim_role_assignment_id").Exists(),
+				check.That(data.ResourceName).Key("role_assignments.0.member_type").HasValue("Direct"),
+			),
+		},
+	})
+}
+
+func (d PimActiveRoleAssignmentsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_pim_active_role_assignments" "test" {
+  scope          = azurerm_pim_active_role_assignment.test.scope
+  principal_id   = azurerm_pim_active_role_assignment.test.principal_id
+  limit_at_scope = true
+}
+`, PimActiveRoleAssignmentResource{}.noExpiration(data))
+}
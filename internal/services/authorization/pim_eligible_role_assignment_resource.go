@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/roleeligibilityschedulerequests"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/roleeligibilityschedules"
 	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
@@ -28,7 +29,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
-var _ sdk.Resource = PimEligibleRoleAssignmentResource{}
+var (
+	_ sdk.Resource                      = PimEligibleRoleAssignmentResource{}
+	_ sdk.ResourceWithCompositeIdentity = PimEligibleRoleAssignmentResource{}
+)
 
 type PimEligibleRoleAssignmentResource struct{}
 
@@ -72,6 +76,14 @@ func (PimEligibleRoleAssignmentResource) IDValidationFunc() pluginsdk.SchemaVali
 	return validate.PimRoleAssignmentID
 }
 
+func (PimEligibleRoleAssignmentResource) IdentitySchema() map[string]*pluginsdk.Schema {
+	return pimRoleAssignmentIdentitySchema()
+}
+
+func (PimEligibleRoleAssignmentResource) IDFromIdentity(identity *schema.IdentityData) (string, error) {
+	return pimRoleAssignmentIDFromIdentity(identity)
+}
+
 func (PimEligibleRoleAssignmentResource) Arguments() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{
 		"scope": {
@@ -523,6 +535,10 @@ func (r PimEligibleRoleAssignmentResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			if err := setPimRoleAssignmentIdentity(metadata.ResourceData, *id); err != nil {
+				return err
+			}
+
 			return metadata.Encode(&state)
 		},
 	}
@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/roleeligibilityschedules"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ListResourceWithRawV5Schemas = &PimEligibleRoleAssignmentListResource{}
+
+type PimEligibleRoleAssignmentListResource struct {
+	sdk.ListResourceMetadata
+}
+
+type PimEligibleRoleAssignmentListModel struct {
+	Scope       types.String `tfsdk:"scope"`
+	PrincipalId types.String `tfsdk:"principal_id"`
+}
+
+func NewPimEligibleRoleAssignmentListResource() list.ListResource {
+	return &PimEligibleRoleAssignmentListResource{}
+}
+
+func (r *PimEligibleRoleAssignmentListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = PimEligibleRoleAssignmentResource{}.ResourceType()
+}
+
+func (r *PimEligibleRoleAssignmentListResource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	res := pimEligibleRoleAssignmentPluginSdkResource()
+	response.ProtoV5Schema = res.ProtoSchema(ctx)()
+	response.ProtoV5IdentitySchema = res.ProtoIdentitySchema(ctx)()
+}
+
+func (r *PimEligibleRoleAssignmentListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"scope": listschema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.StringIsNotEmpty,
+					},
+				},
+			},
+			"principal_id": listschema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: validation.IsUUID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *PimEligibleRoleAssignmentListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	client := r.Client.Authorization.RoleEligibilitySchedulesClient
+
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*60)
+	defer cancel()
+
+	var data PimEligibleRoleAssignmentListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	scopeId, err := commonids.ParseScopeID(data.Scope.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, "parsing scope", err)
+		return
+	}
+
+	filter := "atScope()"
+	if principalId := data.PrincipalId.ValueString(); principalId != "" {
+		filter = fmt.Sprintf("(principalId eq '%s') and atScope()", principalId)
+	}
+
+	resp, err := client.ListForScopeComplete(ctx, *scopeId, roleeligibilityschedules.ListForScopeOperationOptions{
+		Filter: pointer.To(filter),
+	})
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing %s", PimEligibleRoleAssignmentResource{}.ResourceType()), err)
+		return
+	}
+
+	// `atScope()` also returns the schedules above the scope, and only the schedules assigned directly to the
+	// principal are managed by the resource, so anything else is discarded
+	schedules := make([]roleeligibilityschedules.RoleEligibilitySchedule, 0)
+	for _, schedule := range resp.Items {
+		if props := schedule.Properties; props != nil {
+			if strings.EqualFold(pointer.From(props.Scope), scopeId.ID()) && pointer.From(props.MemberType) == roleeligibilityschedules.MemberTypeDirect {
+				schedules = append(schedules, schedule)
+			}
+		}
+	}
+
+	res := pimEligibleRoleAssignmentPluginSdkResource()
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
+		defer cancel()
+		for _, schedule := range schedules {
+			props := schedule.Properties
+			id := parse.NewPimRoleAssignmentID(scopeId.ID(), pointer.From(props.RoleDefinitionId), pointer.From(props.PrincipalId))
+
+			result := request.NewListResult(ctx)
+			result.DisplayName = pointer.From(schedule.Name)
+
+			rd := res.Data(&terraform.InstanceState{})
+			rd.SetId(id.ID())
+
+			if err := setPimRoleAssignmentIdentity(rd, id); err != nil {
+				sdk.SetResponseErrorDiagnostic(stream, "setting identity data", err)
+				return
+			}
+
+			state := PimEligibleRoleAssignmentModel{
+				Condition:        pointer.From(props.Condition),
+				ConditionVersion: pointer.From(props.ConditionVersion),
+				PrincipalId:      id.PrincipalId,
+				PrincipalType:    string(pointer.From(props.PrincipalType)),
+				RoleDefinitionId: id.RoleDefinitionId,
+				Scope:            id.Scope,
+			}
+
+			if props.StartDateTime != nil || props.EndDateTime != nil {
+				scheduleInfo := PimEligibleRoleAssignmentScheduleInfo{
+					StartDateTime: pointer.From(props.StartDateTime),
+				}
+				if props.EndDateTime != nil {
+					scheduleInfo.Expiration = []PimEligibleRoleAssignmentScheduleInfoExpiration{
+						{
+							EndDateTime: *props.EndDateTime,
+						},
+					}
+				}
+				state.ScheduleInfo = []PimEligibleRoleAssignmentScheduleInfo{scheduleInfo}
+			}
+
+			if err := r.ResourceMetaData(rd).Encode(&state); err != nil {
+				sdk.SetResponseErrorDiagnostic(stream, "encoding resource data", err)
+				return
+			}
+
+			tfTypeIdentity, err := rd.TfTypeIdentityState()
+			if err != nil {
+				sdk.SetResponseErrorDiagnostic(stream, "converting Identity State", err)
+				return
+			}
+
+			if err := result.Identity.Set(ctx, *tfTypeIdentity); err != nil {
+				sdk.SetResponseErrorDiagnostic(stream, "setting identity data", err)
+				return
+			}
+
+			tfTypeResource, err := rd.TfTypeResourceState()
+			if err != nil {
+				sdk.SetResponseErrorDiagnostic(stream, "converting Resource State data", err)
+				return
+			}
+
+			if err := result.Resource.Set(ctx, *tfTypeResource); err != nil {
+				sdk.SetResponseErrorDiagnostic(stream, "setting resource data", err)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// pimEligibleRoleAssignmentPluginSdkResource returns the Plugin SDK representation of the Typed Resource, the wrapper
+// only errors when the Resource is misconfigured, which would already have failed when the Provider was instantiated
+func pimEligibleRoleAssignmentPluginSdkResource() *pluginsdk.Resource {
+	wrapper := sdk.NewResourceWrapper(PimEligibleRoleAssignmentResource{})
+	res, err := wrapper.Resource()
+	if err != nil {
+		panic(fmt.Errorf("creating Wrapper for Resource %q: %+v", PimEligibleRoleAssignmentResource{}.ResourceType(), err))
+	}
+
+	return res
+}
+
+func (r *PimEligibleRoleAssignmentListResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	r.Defaults(request, response)
+}
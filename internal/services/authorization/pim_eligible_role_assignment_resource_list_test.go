@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestAccPimEligibleRoleAssignment_list_basic(t *testing.T) {
+	r := PimEligibleRoleAssignmentResource{}
+
+	data := acceptance.BuildTestData(t, "azurerm_pim_eligible_role_assignment", "test")
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.noExpiration(data),
+			},
+			{
+				Query:  true,
+				Config: r.basicList_query(data),
+			},
+		},
+	})
+}
+
+func (r PimEligibleRoleAssignmentResource) basicList_query(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+list "azurerm_pim_eligible_role_assignment" "test" {
+  provider = azurerm
+
+  config {
+    scope = "/subscriptions/%s"
+  }
+}
+`, data.Subscriptions.Primary)
+}
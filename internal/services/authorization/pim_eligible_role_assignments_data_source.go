@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/authorization/2020-10-01/roleeligibilityscheduleinstances"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type PimEligibleRoleAssignmentsDataSource struct{}
+
+type PimEligibleRoleAssignmentsDataSourceModel struct {
+	Scope           string                                `tfschema:"scope"`
+	LimitAtScope    bool                                  `tfschema:"limit_at_scope"`
+	PrincipalID     string                                `tfschema:"principal_id"`
+	RoleAssignments []PimEligibleRoleAssignmentsItemModel `tfschema:"role_assignments"`
+}
+
+type PimEligibleRoleAssignmentsItemModel struct {
+	Condition           string `tfschema:"condition"`
+	ConditionVersion    string `tfschema:"condition_version"`
+	EndDateTime         string `tfschema:"end_date_time"`
+	MemberType          string `tfschema:"member_type"`
+	PimRoleAssignmentID string `tfschema:"pim_role_assignment_id"`
+	PrincipalID         string `tfschema:"principal_id"`
+	PrincipalType       string `tfschema:"principal_type"`
+	RoleDefinitionID    string `tfschema:"role_definition_id"`
+	ScheduleInstanceID  string `tfschema:"schedule_instance_id"`
+	Scope               string `tfschema:"scope"`
+	StartDateTime       string `tfschema:"start_date_time"`
+	Status              string `tfschema:"status"`
+}
+
+var _ sdk.DataSource = PimEligibleRoleAssignmentsDataSource{}
+
+func (r PimEligibleRoleAssignmentsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"scope": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"limit_at_scope": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"principal_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+	}
+}
+
+func (r PimEligibleRoleAssignmentsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"role_assignments": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: pimRoleAssignmentScheduleInstanceSchema(),
+			},
+		},
+	}
+}
+
+func (r PimEligibleRoleAssignmentsDataSource) ModelObject() interface{} {
+	return &PimEligibleRoleAssignmentsDataSourceModel{}
+}
+
+func (r PimEligibleRoleAssignmentsDataSource) ResourceType() string {
+	return "azurerm_pim_eligible_role_assignments"
+}
+
+func (r PimEligibleRoleAssignmentsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Authorization.RoleEligibilityScheduleInstancesClient
+
+			var state PimEligibleRoleAssignmentsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := commonids.NewScopeID(state.Scope)
+
+			options := roleeligibilityscheduleinstances.DefaultListForScopeOperationOptions()
+			if filter := pimRoleAssignmentScheduleInstancesFilter(state.PrincipalID, state.LimitAtScope); filter != "" {
+				options.Filter = pointer.To(filter)
+			}
+
+			resp, err := client.ListForScopeComplete(ctx, id, options)
+			if err != nil {
+				return fmt.Errorf("listing eligible role assignment schedule instances for %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			state.RoleAssignments = make([]PimEligibleRoleAssignmentsItemModel, 0)
+			for _, instance := range resp.Items {
+				props := instance.Properties
+				if props == nil {
+					continue
+				}
+
+				// `atScope()` also returns the schedule instances above the scope, so these are discarded
+				if state.LimitAtScope && !strings.EqualFold(state.Scope, pointer.From(props.Scope)) {
+					continue
+				}
+
+				state.RoleAssignments = append(state.RoleAssignments, PimEligibleRoleAssignmentsItemModel{
+					Condition:           pointer.From(props.Condition),
+					ConditionVersion:    pointer.From(props.ConditionVersion),
+					EndDateTime:         pointer.From(props.EndDateTime),
+					MemberType:          string(pointer.From(props.MemberType)),
+					PimRoleAssignmentID: parse.NewPimRoleAssignmentID(pointer.From(props.Scope), pointer.From(props.RoleDefinitionId), pointer.From(props.PrincipalId)).ID(),
+					PrincipalID:         pointer.From(props.PrincipalId),
+					PrincipalType:       string(pointer.From(props.PrincipalType)),
+					RoleDefinitionID:    pointer.From(props.RoleDefinitionId),
+					ScheduleInstanceID:  pointer.From(instance.Id),
+					Scope:               pointer.From(props.Scope),
+					StartDateTime:       pointer.From(props.StartDateTime),
+					Status:              string(pointer.From(props.Status)),
+				})
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// pimRoleAssignmentScheduleInstanceSchema returns the schema for the schedule instances common to both the eligible
+// and active role assignments
+func pimRoleAssignmentScheduleInstanceSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"condition": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"condition_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"end_date_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"member_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"pim_role_assignment_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"principal_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"principal_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"role_definition_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"schedule_instance_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"scope": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"start_date_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func pimRoleAssignmentScheduleInstancesFilter(principalId string, limitAtScope bool) string {
+	filters := make([]string, 0)
+	if principalId != "" {
+		filters = append(filters, fmt.Sprintf("(principalId eq '%s')", principalId))
+	}
+	if limitAtScope {
+		filters = append(filters, "atScope()")
+	}
+
+	return strings.Join(filters, " and ")
+}
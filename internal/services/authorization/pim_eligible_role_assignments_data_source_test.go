@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type PimEligibleRoleAssignmentsDataSource struct{}
+
+func TestAccPimEligibleRoleAssignmentsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_pim_eligible_role_assignments", "test")
+	d := PimEligibleRoleAssignmentsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("role_assignments.#").HasValue("1"),
+				check.That(data.ResourceName).Key("role_assignments.0.pim_role_assignment_id").Exists(),
+				check.That(data.ResourceName).Key("role_assignments.0.member_type").HasValue("Direct"),
+			),
+		},
+	})
+}
+
+func (d PimEligibleRoleAssignmentsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_pim_eligible_role_assignments" "test" {
+  scope          = azurerm_pim_eligible_role_assignment.test.scope
+  principal_id   = azurerm_pim_eligible_role_assignment.test.principal_id
+  limit_at_scope = true
+}
+`, PimEligibleRoleAssignmentResource{}.noExpiration(data))
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/authorization/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// PIM Role Assignments are identified by a composite of the Scope, Role Definition and Principal rather than a
+// Resource ID, so these share an identity made up of those values
+
+func pimRoleAssignmentIdentitySchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"scope": {
+			Type:              pluginsdk.TypeString,
+			RequiredForImport: true,
+		},
+		"role_definition_id": {
+			Type:              pluginsdk.TypeString,
+			RequiredForImport: true,
+		},
+		"principal_id": {
+			Type:              pluginsdk.TypeString,
+			RequiredForImport: true,
+		},
+	}
+}
+
+func pimRoleAssignmentIDFromIdentity(identity *schema.IdentityData) (string, error) {
+	values := make(map[string]string)
+	for _, name := range []string{"scope", "role_definition_id", "principal_id"} {
+		value, ok := identity.Get(name).(string)
+		if !ok || value == "" {
+			return "", fmt.Errorf("%q cannot be empty", name)
+		}
+		values[name] = value
+	}
+
+	return parse.NewPimRoleAssignmentID(values["scope"], values["role_definition_id"], values["principal_id"]).ID(), nil
+}
+
+func setPimRoleAssignmentIdentity(d *pluginsdk.ResourceData, id parse.PimRoleAssignmentId) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return fmt.Errorf("getting identity: %+v", err)
+	}
+
+	values := map[string]string{
+		"scope":              id.Scope,
+		"role_definition_id": id.RoleDefinitionId,
+		"principal_id":       id.PrincipalId,
+	}
+	for name, value := range values {
+		if err := identity.Set(name, value); err != nil {
+			return fmt.Errorf("setting `%s` in resource identity: %+v", name, err)
+		}
+	}
+
+	return nil
+}
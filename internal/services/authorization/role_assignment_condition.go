@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// The structured `storage_blob_condition` block builds an ABAC (v2.0) condition for the common Storage Blob scenarios
+// documented at https://learn.microsoft.com/azure/storage/blobs/storage-auth-abac-examples - each block restricts the
+// selected actions to the blobs matching all of the attributes, and multiple blocks must all be satisfied.
+
+const storageBlobConditionVersion = "2.0"
+
+const (
+	storageBlobConditionActionRead      = "Read"
+	storageBlobConditionActionWrite     = "Write"
+	storageBlobConditionActionAdd       = "Add"
+	storageBlobConditionActionDelete    = "Delete"
+	storageBlobConditionActionReadTags  = "ReadTags"
+	storageBlobConditionActionWriteTags = "WriteTags"
+)
+
+func possibleValuesForStorageBlobConditionAction() []string {
+	return []string{
+		storageBlobConditionActionRead,
+		storageBlobConditionActionWrite,
+		storageBlobConditionActionAdd,
+		storageBlobConditionActionDelete,
+		storageBlobConditionActionReadTags,
+		storageBlobConditionActionWriteTags,
+	}
+}
+
+// storageBlobConditionActionExpressions maps each action to the expression which excludes it from the condition.
+// Listing blobs doesn't support the blob attributes, so this is excluded from the `Read` action.
+var storageBlobConditionActionExpressions = map[string]string{
+	storageBlobConditionActionRead:      "!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'} AND NOT SubOperationMatches{'Blob.List'})",
+	storageBlobConditionActionWrite:     "!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/write'})",
+	storageBlobConditionActionAdd:       "!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/add/action'})",
+	storageBlobConditionActionDelete:    "!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/delete'})",
+	storageBlobConditionActionReadTags:  "!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/tags/read'})",
+	storageBlobConditionActionWriteTags: "!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/tags/write'})",
+}
+
+// the values are output as string literals within the condition, which can't contain a single quote
+var storageBlobConditionValueRegex = regexp.MustCompile(`^[^']+$`)
+
+// the keys for Blob Index Tags are limited to these characters, which also ensures the attribute name is valid
+var storageBlobConditionTagKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9 +\-./:=_]{1,128}$`)
+
+func storageBlobConditionSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:          pluginsdk.TypeList,
+		Optional:      true,
+		ForceNew:      true,
+		ConflictsWith: []string{"condition", "condition_version"},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"actions": {
+					Type:     pluginsdk.TypeSet,
+					Required: true,
+					ForceNew: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringInSlice(possibleValuesForStorageBlobConditionAction(), false),
+					},
+				},
+
+				"container_names": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					ForceNew: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringMatch(storageBlobConditionValueRegex, "cannot be empty or contain a single quote"),
+					},
+				},
+
+				"encryption_scope_names": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					ForceNew: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringMatch(storageBlobConditionValueRegex, "cannot be empty or contain a single quote"),
+					},
+				},
+
+				"path_prefixes": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					ForceNew: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringMatch(storageBlobConditionValueRegex, "cannot be empty or contain a single quote"),
+					},
+				},
+
+				"tags": {
+					Type:     pluginsdk.TypeMap,
+					Optional: true,
+					ForceNew: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringMatch(storageBlobConditionValueRegex, "cannot be empty or contain a single quote"),
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandStorageBlobCondition returns the ABAC condition for the `storage_blob_condition` blocks
+func expandStorageBlobCondition(input []interface{}) (string, error) {
+	conditions := make([]string, 0)
+
+	for i, raw := range input {
+		block, ok := raw.(map[string]interface{})
+		if !ok {
+			return "", fmt.Errorf("`storage_blob_condition.%d` must contain at least one action", i)
+		}
+
+		actions := make(map[string]struct{})
+		for _, v := range block["actions"].(*pluginsdk.Set).List() {
+			actions[v.(string)] = struct{}{}
+		}
+		actionExpressions := make([]string, 0)
+		for _, action := range possibleValuesForStorageBlobConditionAction() {
+			if _, ok := actions[action]; ok {
+				actionExpressions = append(actionExpressions, storageBlobConditionActionExpressions[action])
+			}
+		}
+		if len(actionExpressions) == 0 {
+			return "", fmt.Errorf("`storage_blob_condition.%d` must contain at least one action", i)
+		}
+
+		attributeExpressions := make([]string, 0)
+
+		if v := sortedStrings(block["container_names"]); len(v) > 0 {
+			attributeExpressions = append(attributeExpressions, anyOfExpression("@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name] StringEquals", v))
+		}
+
+		if v := sortedStrings(block["path_prefixes"]); len(v) > 0 {
+			attributeExpressions = append(attributeExpressions, anyOfExpression("@Resource[Microsoft.Storage/storageAccounts/blobServices/containers/blobs:path] StringStartsWith", v))
+		}
+
+		if v := sortedStrings(block["encryption_scope_names"]); len(v) > 0 {
+			attributeExpressions = append(attributeExpressions, fmt.Sprintf("@Resource[Microsoft.Storage/storageAccounts/encryptionScopes:name] ForAnyOfAnyValues:StringEquals {%s}", quotedList(v)))
+		}
+
+		if tags, ok := block["tags"].(map[string]interface{}); ok && len(tags) > 0 {
+			keys := make([]string, 0, len(tags))
+			for key := range tags {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+
+			for _, key := range keys {
+				if !storageBlobConditionTagKeyRegex.MatchString(key) {
+					return "", fmt.Errorf("`storage_blob_condition.%d.tags`: the key %q must be between 1 and 128 characters and can only contain letters, numbers, spaces and the characters `+-./:=_`", i, key)
+				}
+				attributeExpressions = append(attributeExpressions, fmt.Sprintf("@Resource[Microsoft.Storage/storageAccounts/blobServices/containers/blobs/tags:%s<$key_case_sensitive$>] StringEquals '%s'", key, tags[key].(string)))
+			}
+		}
+
+		if len(attributeExpressions) == 0 {
+			return "", fmt.Errorf("`storage_blob_condition.%d` must specify at least one of `container_names`, `encryption_scope_names`, `path_prefixes` or `tags`", i)
+		}
+
+		conditions = append(conditions, fmt.Sprintf("((%s) OR (%s))", strings.Join(actionExpressions, " AND "), strings.Join(attributeExpressions, " AND ")))
+	}
+
+	return strings.Join(conditions, " AND "), nil
+}
+
+// anyOfExpression returns an expression which is satisfied when the attribute matches any of the values
+func anyOfExpression(attribute string, values []string) string {
+	expressions := make([]string, 0, len(values))
+	for _, v := range values {
+		expressions = append(expressions, fmt.Sprintf("%s '%s'", attribute, v))
+	}
+	if len(expressions) == 1 {
+		return expressions[0]
+	}
+
+	return fmt.Sprintf("(%s)", strings.Join(expressions, " OR "))
+}
+
+func quotedList(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, v := range values {
+		quoted = append(quoted, fmt.Sprintf("'%s'", v))
+	}
+
+	return strings.Join(quoted, ", ")
+}
+
+func sortedStrings(input interface{}) []string {
+	set, ok := input.(*pluginsdk.Set)
+	if !ok || set == nil {
+		return nil
+	}
+
+	output := make([]string, 0, set.Len())
+	for _, v := range set.List() {
+		output = append(output, v.(string))
+	}
+	sort.Strings(output)
+
+	return output
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package authorization
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestExpandStorageBlobCondition(t *testing.T) {
+	set := func(values ...interface{}) *pluginsdk.Set {
+		return pluginsdk.NewSet(pluginsdk.HashString, values)
+	}
+
+	testCases := []struct {
+		Name     string
+		Input    []interface{}
+		Expected string
+		Error    bool
+	}{
+		{
+			Name: "container names",
+			Input: []interface{}{
+				map[string]interface{}{
+					"actions":         set("Write", "Read", "Delete"),
+					"container_names": set("logs"),
+				},
+			},
+			Expected: "((!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'} AND NOT SubOperationMatches{'Blob.List'}) AND !(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/write'}) AND !(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/delete'})) OR (@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name] StringEquals 'logs'))",
+		},
+		{
+			Name: "all attributes",
+			Input: []interface{}{
+				map[string]interface{}{
+					"actions":                set("Read"),
+					"container_names":        set("logs", "audit"),
+					"path_prefixes":          set("2024/"),
+					"encryption_scope_names": set("scope1", "scope2"),
+					"tags": map[string]interface{}{
+						"Project": "Cascade",
+					},
+				},
+			},
+			Expected: "((!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/read'} AND NOT SubOperationMatches{'Blob.List'})) OR ((@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name] StringEquals 'audit' OR @Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name] StringEquals 'logs') AND @Resource[Microsoft.Storage/storageAccounts/blobServices/containers/blobs:path] StringStartsWith '2024/' AND @Resource[Microsoft.Storage/storageAccounts/encryptionScopes:name] ForAnyOfAnyValues:StringEquals {'scope1', 'scope2'} AND @Resource[Microsoft.Storage/storageAccounts/blobServices/containers/blobs/tags:Project<$key_case_sensitive$>] StringEquals 'Cascade'))",
+		},
+		{
+			Name: "multiple blocks",
+			Input: []interface{}{
+				map[string]interface{}{
+					"actions":         set("Add"),
+					"container_names": set("uploads"),
+				},
+				map[string]interface{}{
+					"actions":       set("ReadTags", "WriteTags"),
+					"path_prefixes": set("tagged/"),
+				},
+			},
+			Expected: "((!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/add/action'})) OR (@Resource[Microsoft.Storage/storageAccounts/blobServices/containers:name] StringEquals 'uploads')) AND ((!(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/tags/read'}) AND !(ActionMatches{'Microsoft.Storage/storageAccounts/blobServices/containers/blobs/tags/write'})) OR (@Resource[Microsoft.Storage/storageAccounts/blobServices/containers/blobs:path] StringStartsWith 'tagged/'))",
+		},
+		{
+			Name: "no attributes",
+			Input: []interface{}{
+				map[string]interface{}{
+					"actions": set("Read"),
+				},
+			},
+			Error: true,
+		},
+		{
+			Name: "invalid tag key",
+			Input: []interface{}{
+				map[string]interface{}{
+					"actions": set("Read"),
+					"tags": map[string]interface{}{
+						"Project]": "Cascade",
+					},
+				},
+			},
+			Error: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		actual, err := expandStorageBlobCondition(tc.Input)
+		if err != nil {
+			if tc.Error {
+				continue
+			}
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if tc.Error {
+			t.Fatalf("expected an error but got %q", actual)
+		}
+
+		if actual != tc.Expected {
+			t.Fatalf("expected %q but got %q", tc.Expected, actual)
+		}
+	}
+}
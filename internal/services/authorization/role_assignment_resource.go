@@ -140,6 +140,10 @@ func resourceArmRoleAssignment() *pluginsdk.Resource {
 				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
+				DiffSuppressFunc: func(_, _, new string, d *pluginsdk.ResourceData) bool {
+					// the condition is generated from the `storage_blob_condition` blocks when these are specified
+					return new == "" && len(d.Get("storage_blob_condition").([]interface{})) > 0
+				},
 			},
 
 			"condition_version": {
@@ -152,7 +156,19 @@ func resourceArmRoleAssignment() *pluginsdk.Resource {
 					"2.0",
 				}, false),
 			},
+
+			"storage_blob_condition": storageBlobConditionSchema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			if !d.NewValueKnown("storage_blob_condition") {
+				return nil
+			}
+			if _, err := expandStorageBlobCondition(d.Get("storage_blob_condition").([]interface{})); err != nil {
+				return err
+			}
+			return nil
+		}),
 	}
 }
 
@@ -243,6 +259,14 @@ func resourceArmRoleAssignmentCreate(d *pluginsdk.ResourceData, meta interface{}
 	condition := d.Get("condition").(string)
 	conditionVersion := d.Get("condition_version").(string)
 
+	if v := d.Get("storage_blob_condition").([]interface{}); len(v) > 0 {
+		condition, err = expandStorageBlobCondition(v)
+		if err != nil {
+			return err
+		}
+		conditionVersion = storageBlobConditionVersion
+	}
+
 	switch {
 	case condition != "" && conditionVersion != "":
 		props.Condition = pointer.To(condition)
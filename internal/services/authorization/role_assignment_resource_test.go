@@ -232,6 +232,25 @@ func TestAccRoleAssignment_implicitCondition(t *testing.T) {
 	})
 }
 
+func TestAccRoleAssignment_storageBlobCondition(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_role_assignment", "test")
+	id := uuid.New().String()
+
+	r := RoleAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.storageBlobCondition(id),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("condition").Exists(),
+				check.That(data.ResourceName).Key("condition_version").HasValue("2.0"),
+			),
+		},
+		data.ImportStep("skip_service_principal_aad_check", "storage_blob_condition"),
+	})
+}
+
 func TestAccRoleAssignment_resourceScoped(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_role_assignment", "test")
 	id := uuid.New().String()
@@ -630,6 +649,40 @@ resource "azurerm_role_assignment" "test" {
 `, groupId)
 }
 
+func (RoleAssignmentResource) storageBlobCondition(groupId string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "primary" {
+}
+
+data "azurerm_client_config" "test" {
+}
+
+resource "azurerm_role_assignment" "test" {
+  name                 = "%s"
+  scope                = data.azurerm_subscription.primary.id
+  role_definition_name = "Storage Blob Data Contributor"
+  principal_id         = data.azurerm_client_config.test.object_id
+
+  storage_blob_condition {
+    actions         = ["Read", "Write", "Delete"]
+    container_names = ["logs", "audit"]
+    path_prefixes   = ["2024/"]
+  }
+
+  storage_blob_condition {
+    actions = ["Read"]
+    tags = {
+      Project = "Cascade"
+    }
+  }
+}
+`, groupId)
+}
+
 // nolint: unused
 func (RoleAssignmentResource) subscriptionScoped(data acceptance.TestData) string {
 	return fmt.Sprintf(`
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-11-01/virtualmachinescalesets"
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 )
 
 func expandIDsToSubResources(input []interface{}) *[]virtualmachinescalesets.SubResource {
@@ -95,8 +96,12 @@ func sortSharedImageVersions(values []galleryimageversions.GalleryImageVersion)
 	return values, nil
 }
 
-func resourceManagedDiskUpdateWithVmShutDown(ctx context.Context, clients *clients.Client, id *commonids.ManagedDiskId, virtualMachineId *virtualmachines.VirtualMachineId, diskUpdate disks.DiskUpdate, shouldDetach bool) error {
+// resourceManagedDiskUpdateWithVmShutDown updates the Managed Disk `id` whilst the Virtual Machine it's attached to is
+// deallocated, detaching the Disk where required. Once the Disk has been updated the Virtual Machine is started again
+// if it was previously running - the steps taken are reported as a warning using `logger`.
+func resourceManagedDiskUpdateWithVmShutDown(ctx context.Context, clients *clients.Client, id *commonids.ManagedDiskId, virtualMachineId *virtualmachines.VirtualMachineId, diskUpdate disks.DiskUpdate, shouldDetach bool, logger sdk.Logger) error {
 	expandedDisk := virtualmachines.DataDisk{}
+	steps := make([]string, 0)
 	shouldShutDown := true
 	diskClient := clients.Compute.DisksClient
 	virtualMachinesClient := clients.Compute.VirtualMachinesClient
@@ -169,6 +174,7 @@ func resourceManagedDiskUpdateWithVmShutDown(ctx context.Context, clients *clien
 			if err := virtualMachinesClient.CreateOrUpdateThenPoll(ctx, *virtualMachineId, *vm.Model, virtualmachines.DefaultCreateOrUpdateOperationOptions()); err != nil {
 				return fmt.Errorf("removing Disk %q from %s : %+v", id.DiskName, virtualMachineId, err)
 			}
+			steps = append(steps, "detached the Disk")
 		}
 	}
 
@@ -182,6 +188,7 @@ func resourceManagedDiskUpdateWithVmShutDown(ctx context.Context, clients *clien
 		}
 
 		log.Printf("[DEBUG] Shut Down %s", virtualMachineId)
+		steps = append(steps, "shut down the Virtual Machine")
 	}
 
 	// De-allocate
@@ -192,11 +199,15 @@ func resourceManagedDiskUpdateWithVmShutDown(ctx context.Context, clients *clien
 			return fmt.Errorf("deallocating to %s: %+v", virtualMachineId, err)
 		}
 		log.Printf("[DEBUG] Deallocated %s", virtualMachineId)
+		steps = append(steps, "deallocated the Virtual Machine")
 	}
 
 	// Update Disk
 	err = diskClient.UpdateThenPoll(ctx, *id, diskUpdate)
 	if err != nil {
+		if len(steps) > 0 {
+			return fmt.Errorf("updating %s (the following steps were performed on %s beforehand: %s): %+v", id, virtualMachineId, strings.Join(steps, ", "), err)
+		}
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
 
@@ -220,6 +231,7 @@ func resourceManagedDiskUpdateWithVmShutDown(ctx context.Context, clients *clien
 		if err := virtualMachinesClient.CreateOrUpdateThenPoll(ctx, *virtualMachineId, *vm.Model, virtualmachines.DefaultCreateOrUpdateOperationOptions()); err != nil {
 			return fmt.Errorf("updating %s to reattach Disk %s: %+v", virtualMachineId, id, err)
 		}
+		steps = append(steps, "reattached the Disk")
 	}
 
 	if shouldTurnBackOn && (shouldShutDown || shouldDeallocate) {
@@ -228,7 +240,22 @@ func resourceManagedDiskUpdateWithVmShutDown(ctx context.Context, clients *clien
 			return fmt.Errorf("starting %s: %+v", virtualMachineId, err)
 		}
 		log.Printf("[DEBUG] Started %s", virtualMachineId)
+		steps = append(steps, "started the Virtual Machine")
+	}
+
+	if len(steps) > 0 {
+		logger.Warn(managedDiskVirtualMachineStepsMessage(*id, *virtualMachineId, steps, !shouldTurnBackOn && shouldDeallocate))
 	}
 
 	return nil
 }
+
+// managedDiskVirtualMachineStepsMessage returns the warning describing the `steps` performed against the Virtual
+// Machine `virtualMachineId` to update the Managed Disk `id`
+func managedDiskVirtualMachineStepsMessage(id commonids.ManagedDiskId, virtualMachineId virtualmachines.VirtualMachineId, steps []string, leftDeallocated bool) string {
+	message := fmt.Sprintf("updating %s required %s to be taken offline, the following steps were performed: %s", id, virtualMachineId, strings.Join(steps, ", "))
+	if leftDeallocated {
+		message += " - the Virtual Machine has been left deallocated since it wasn't running beforehand"
+	}
+	return message
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-04-02/disks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -123,10 +124,35 @@ func resourceLinuxVirtualMachine() *pluginsdk.Resource {
 				DiffSuppressFunc: adminPasswordDiffSuppressFunc,
 				ValidateFunc:     computeValidate.LinuxAdminPassword,
 				ConflictsWith: []string{
+					"admin_password_wo",
 					"os_managed_disk_id",
 				},
 			},
 
+			"admin_password_wo": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				WriteOnly:    true,
+				ValidateFunc: computeValidate.LinuxAdminPassword,
+				RequiredWith: []string{
+					"admin_password_wo_version",
+				},
+				ConflictsWith: []string{
+					"admin_password",
+					"os_managed_disk_id",
+				},
+			},
+
+			"admin_password_wo_version": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{
+					"admin_password_wo",
+				},
+			},
+
 			"admin_ssh_key": SSHKeysSchemaVM(),
 
 			"allow_extension_operations": {
@@ -679,11 +705,19 @@ func resourceLinuxVirtualMachineCreate(d *pluginsdk.ResourceData, meta interface
 		}
 
 		adminPassword := d.Get("admin_password").(string)
+		adminPasswordWo, err := pluginsdk.GetWriteOnly(d, "admin_password_wo", cty.String)
+		if err != nil {
+			return err
+		}
+		if !adminPasswordWo.IsNull() {
+			adminPassword = adminPasswordWo.AsString()
+		}
+
 		if disablePasswordAuthentication && len(sshKeys) == 0 {
 			return fmt.Errorf("at least one `admin_ssh_key` must be specified when `disable_password_authentication` is set to `true`")
 		} else if !disablePasswordAuthentication {
 			if adminPassword == "" {
-				return fmt.Errorf("an `admin_password` must be specified if `disable_password_authentication` is set to `false`")
+				return fmt.Errorf("one of `admin_password` or `admin_password_wo` must be specified if `disable_password_authentication` is set to `false`")
 			}
 
 			params.Properties.OsProfile.AdminPassword = pointer.To(adminPassword)
@@ -1007,6 +1041,8 @@ func resourceLinuxVirtualMachineRead(d *pluginsdk.ResourceData, meta interface{}
 			}
 			d.Set("platform_fault_domain", platformFaultDomain)
 
+			d.Set("admin_password_wo_version", d.Get("admin_password_wo_version").(int))
+
 			if profile := props.OsProfile; profile != nil {
 				d.Set("admin_username", profile.AdminUsername)
 				d.Set("allow_extension_operations", profile.AllowExtensionOperations)
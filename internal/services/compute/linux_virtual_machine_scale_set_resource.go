@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/images"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/proximityplacementgroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-11-01/virtualmachinescalesets"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -298,6 +299,14 @@ func resourceLinuxVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData, meta i
 		virtualMachineProfile.OsProfile.AdminPassword = pointer.To(adminPassword.(string))
 	}
 
+	adminPasswordWo, err := pluginsdk.GetWriteOnly(d, "admin_password_wo", cty.String)
+	if err != nil {
+		return err
+	}
+	if !adminPasswordWo.IsNull() {
+		virtualMachineProfile.OsProfile.AdminPassword = pointer.To(adminPasswordWo.AsString())
+	}
+
 	if v, ok := d.Get("max_bid_price").(float64); ok && v > 0 {
 		if priority != virtualmachinescalesets.VirtualMachinePriorityTypesSpot {
 			return fmt.Errorf("`max_bid_price` can only be configured when `priority` is set to `Spot`")
@@ -817,14 +826,18 @@ func resourceLinuxVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData, meta i
 	update.Properties = &updateProps
 
 	metaData := virtualMachineScaleSetUpdateMetaData{
-		AutomaticOSUpgradeIsEnabled:  automaticOSUpgradeIsEnabled,
-		CanReimageOnManualUpgrade:    meta.(*clients.Client).Features.VirtualMachineScaleSet.ReimageOnManualUpgrade,
-		CanRollInstancesWhenRequired: meta.(*clients.Client).Features.VirtualMachineScaleSet.RollInstancesWhenRequired,
-		UpdateInstances:              updateInstances,
-		Client:                       meta.(*clients.Client).Compute,
-		Existing:                     *existing.Model,
-		ID:                           id,
-		OSType:                       virtualmachinescalesets.OperatingSystemTypesLinux,
+		AutomaticOSUpgradeIsEnabled:          automaticOSUpgradeIsEnabled,
+		CanReimageOnManualUpgrade:            meta.(*clients.Client).Features.VirtualMachineScaleSet.ReimageOnManualUpgrade,
+		CanRollInstancesWhenRequired:         meta.(*clients.Client).Features.VirtualMachineScaleSet.RollInstancesWhenRequired,
+		RollInstancesBatchSize:               meta.(*clients.Client).Features.VirtualMachineScaleSet.RollInstancesBatchSize,
+		RollInstancesBatchPercent:            meta.(*clients.Client).Features.VirtualMachineScaleSet.RollInstancesBatchPercent,
+		RollInstancesPauseBetweenBatches:     time.Duration(meta.(*clients.Client).Features.VirtualMachineScaleSet.RollInstancesPauseBetweenBatchesInSeconds) * time.Second,
+		RollInstancesWaitForHealthyInstances: meta.(*clients.Client).Features.VirtualMachineScaleSet.RollInstancesWaitForHealthyInstances,
+		UpdateInstances:                      updateInstances,
+		Client:                               meta.(*clients.Client).Compute,
+		Existing:                             *existing.Model,
+		ID:                                   id,
+		OSType:                               virtualmachinescalesets.OperatingSystemTypesLinux,
 	}
 
 	if err := metaData.performUpdate(ctx, update); err != nil {
@@ -978,6 +991,7 @@ func resourceLinuxVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, meta int
 				if osProfile := profile.OsProfile; osProfile != nil {
 					// admin_password isn't returned, but it's a top level field so we can ignore it without consequence
 					d.Set("admin_username", osProfile.AdminUsername)
+					d.Set("admin_password_wo_version", d.Get("admin_password_wo_version").(int))
 					d.Set("computer_name_prefix", osProfile.ComputerNamePrefix)
 
 					if osProfile.AllowExtensionOperations != nil {
@@ -1188,6 +1202,23 @@ func resourceLinuxVirtualMachineScaleSetSchema() map[string]*pluginsdk.Schema {
 			ForceNew:         true,
 			Sensitive:        true,
 			DiffSuppressFunc: adminPasswordDiffSuppressFunc,
+			ConflictsWith:    []string{"admin_password_wo"},
+		},
+
+		"admin_password_wo": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			WriteOnly:     true,
+			ConflictsWith: []string{"admin_password"},
+			RequiredWith:  []string{"admin_password_wo_version"},
+		},
+
+		"admin_password_wo_version": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			RequiredWith: []string{"admin_password_wo"},
 		},
 
 		"admin_ssh_key": SSHKeysSchema(false),
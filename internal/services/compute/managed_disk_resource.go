@@ -19,11 +19,13 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-02/diskaccesses"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-04-02/disks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -36,10 +38,10 @@ import (
 
 func resourceManagedDisk() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceManagedDiskCreate,
-		Read:   resourceManagedDiskRead,
-		Update: resourceManagedDiskUpdate,
-		Delete: resourceManagedDiskDelete,
+		Create:        resourceManagedDiskCreate,
+		Read:          resourceManagedDiskRead,
+		UpdateContext: resourceManagedDiskUpdateWithDiagnostics,
+		Delete:        resourceManagedDiskDelete,
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -598,7 +600,18 @@ func resourceManagedDiskCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 	return resourceManagedDiskRead(d, meta)
 }
 
-func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+// resourceManagedDiskUpdateWithDiagnostics surfaces any steps taken against the Virtual Machine the Managed Disk is
+// attached to as warnings, since these may require the Virtual Machine to be taken offline
+func resourceManagedDiskUpdateWithDiagnostics(_ context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	logger := &sdk.DiagnosticsLogger{}
+	if err := resourceManagedDiskUpdate(d, meta, logger); err != nil {
+		return append(logger.Diagnostics(), diag.FromErr(err)...)
+	}
+
+	return logger.Diagnostics()
+}
+
+func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}, logger sdk.Logger) error {
 	client := meta.(*clients.Client).Compute.DisksClient
 	virtualMachinesClient := meta.(*clients.Client).Compute.VirtualMachinesClient
 	skusClient := meta.(*clients.Client).Compute.SkusClient
@@ -615,6 +628,7 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 	onDemandBurstingEnabled := d.Get("on_demand_bursting_enabled").(bool)
 	shouldShutDown := false
 	shouldDetach := false
+	shouldFallbackToOfflineExpansion := false
 
 	id, err := commonids.ParseManagedDiskID(d.Id())
 	if err != nil {
@@ -734,6 +748,8 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 			if !canBeResizedWithoutDowntime {
 				log.Printf("[INFO] The %s, or the Virtual Machine that it's attached to, doesn't support no-downtime-resizing - requiring that the VM should be shutdown", *id)
 				shouldShutDown = true
+			} else {
+				shouldFallbackToOfflineExpansion = meta.(*clients.Client).Features.ManagedDisk.FallbackToOfflineExpansion
 			}
 			diskUpdate.Properties.DiskSizeGB = pointer.To(int64(newSize.(int)))
 		} else {
@@ -810,8 +826,7 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		shouldShutDown = false
 	}
 
-	// if we are attached to a VM we bring down the VM as necessary for the operations which are not allowed while it's online
-	if shouldShutDown {
+	updateWithVmShutDown := func() error {
 		virtualMachineId, err := virtualmachines.ParseVirtualMachineID(*disk.Model.ManagedBy)
 		if err != nil {
 			return fmt.Errorf("parsing VMID %q for disk attachment: %+v", *disk.Model.ManagedBy, err)
@@ -821,8 +836,21 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		locks.ByName(virtualMachineId.VirtualMachineName, VirtualMachineResourceName)
 		defer locks.UnlockByName(virtualMachineId.VirtualMachineName, VirtualMachineResourceName)
 
-		err = resourceManagedDiskUpdateWithVmShutDown(ctx, meta.(*clients.Client), id, virtualMachineId, diskUpdate, shouldDetach)
-		if err != nil {
+		return resourceManagedDiskUpdateWithVmShutDown(ctx, meta.(*clients.Client), id, virtualMachineId, diskUpdate, shouldDetach, logger)
+	}
+
+	// if we are attached to a VM we bring down the VM as necessary for the operations which are not allowed while it's online
+	if shouldShutDown {
+		if err := updateWithVmShutDown(); err != nil {
+			return err
+		}
+	} else if shouldFallbackToOfflineExpansion && disk.Model.ManagedBy != nil {
+		// the checks for no-downtime-resize are best-effort, so where opted in and the API rejects expanding the Disk
+		// whilst it's attached to a running VM, we instead expand it whilst the VM is deallocated
+		update := func() error {
+			return updateManagedDisk(ctx, client, *id, diskUpdate)
+		}
+		if err := updateManagedDiskWithOfflineExpansionFallback(*id, update, updateWithVmShutDown, logger); err != nil {
 			return err
 		}
 	} else { // otherwise, just update it
@@ -5,6 +5,7 @@ package compute
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -15,6 +16,8 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-04-02/disks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 )
 
 // The logic on this file is based on:
@@ -163,3 +166,104 @@ func determineIfVirtualMachineSupportsNoDowntimeResize(ctx context.Context, disk
 
 	return pointer.To(false), nil
 }
+
+// managedDiskOnlineExpansionNotSupportedErrors are the error codes (and a lower-case substring of the message) returned
+// by the API when a Managed Disk can't be expanded whilst it's attached to a running Virtual Machine. Since these error
+// codes are also used for unrelated failures (for example `OperationNotAllowed` is returned when a quota would be
+// exceeded) both the code and the message need to match.
+var managedDiskOnlineExpansionNotSupportedErrors = map[string]string{
+	"OperationNotAllowed":      "resiz",
+	"PropertyChangeNotAllowed": "disksizegb",
+}
+
+// managedDiskUpdateError is returned by updateManagedDisk, exposing the error code and message returned by the API
+type managedDiskUpdateError struct {
+	Code    string
+	Message string
+
+	err error
+}
+
+func (e managedDiskUpdateError) Error() string {
+	return e.err.Error()
+}
+
+func (e managedDiskUpdateError) Unwrap() error {
+	return e.err
+}
+
+// updateManagedDisk updates the Managed Disk `id`, returning a managedDiskUpdateError containing the error code and
+// message returned by the API when either the request or the long-running operation fails
+func updateManagedDisk(ctx context.Context, client *disks.DisksClient, id commonids.ManagedDiskId, input disks.DiskUpdate) error {
+	result, err := client.Update(ctx, id, input)
+	if err != nil {
+		updateErr := managedDiskUpdateError{
+			err: fmt.Errorf("performing Update: %+v", err),
+		}
+		if result.OData != nil && result.OData.Error != nil {
+			updateErr.Code = pointer.From(result.OData.Error.Code)
+			updateErr.Message = pointer.From(result.OData.Error.Message)
+		}
+		return updateErr
+	}
+
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		updateErr := managedDiskUpdateError{
+			err: fmt.Errorf("polling after Update: %+v", err),
+		}
+
+		var pollingErr pollers.PollingFailedError
+		if errors.As(err, &pollingErr) && pollingErr.HttpResponse != nil {
+			var lroError struct {
+				Error struct {
+					Code    string `json:"code"`
+					Message string `json:"message"`
+				} `json:"error"`
+			}
+			if err := pollingErr.HttpResponse.Unmarshal(&lroError); err == nil {
+				updateErr.Code = lroError.Error.Code
+				updateErr.Message = lroError.Error.Message
+			}
+		}
+		return updateErr
+	}
+
+	return nil
+}
+
+// isManagedDiskOnlineExpansionNotSupportedError returns whether `err` is the API rejecting the expansion of a Managed
+// Disk whilst it's attached to a running Virtual Machine, rather than any other failure (e.g. throttling or quota)
+func isManagedDiskOnlineExpansionNotSupportedError(err error) bool {
+	var updateErr managedDiskUpdateError
+	if !errors.As(err, &updateErr) {
+		return false
+	}
+
+	for code, message := range managedDiskOnlineExpansionNotSupportedErrors {
+		if strings.EqualFold(updateErr.Code, code) && strings.Contains(strings.ToLower(updateErr.Message), message) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// updateManagedDiskWithOfflineExpansionFallback expands the Managed Disk `id` using `update`. Should the API reject
+// expanding the Disk whilst it's attached to a running Virtual Machine, the Disk is instead expanded using
+// `updateOffline` (which deallocates the Virtual Machine) - any other error is returned without falling back.
+func updateManagedDiskWithOfflineExpansionFallback(id commonids.ManagedDiskId, update func() error, updateOffline func() error, logger sdk.Logger) error {
+	err := update()
+	if err == nil {
+		return nil
+	}
+
+	if !isManagedDiskOnlineExpansionNotSupportedError(err) {
+		return fmt.Errorf("expanding %s: %+v", id, err)
+	}
+
+	var updateErr managedDiskUpdateError
+	errors.As(err, &updateErr)
+	logger.Warnf("expanding %s without downtime isn't supported (%s: %s), falling back to expanding it whilst the Virtual Machine it's attached to is deallocated", id, updateErr.Code, updateErr.Message)
+
+	return updateOffline()
+}
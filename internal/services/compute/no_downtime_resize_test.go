@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+func TestIsManagedDiskOnlineExpansionNotSupportedError(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Error    error
+		Expected bool
+	}{
+		{
+			Name:     "untyped error",
+			Error:    errors.New("Disk resizing is allowed only when creating a VM or when the VM is deallocated."),
+			Expected: false,
+		},
+		{
+			Name: "resizing not allowed whilst running",
+			Error: managedDiskUpdateError{
+				Code:    "OperationNotAllowed",
+				Message: "Disk resizing is allowed only when creating a VM or when the VM is deallocated.",
+				err:     errors.New("performing Update"),
+			},
+			Expected: true,
+		},
+		{
+			Name: "disk size change not allowed",
+			Error: managedDiskUpdateError{
+				Code:    "PropertyChangeNotAllowed",
+				Message: "Changing property 'diskSizeGB' is not allowed.",
+				err:     errors.New("performing Update"),
+			},
+			Expected: true,
+		},
+		{
+			Name: "wrapped",
+			Error: fmt.Errorf("updating: %w", managedDiskUpdateError{
+				Code:    "operationnotallowed",
+				Message: "Cannot resize disk example while it is attached to running VM example.",
+				err:     errors.New("polling after Update"),
+			}),
+			Expected: true,
+		},
+		{
+			Name: "quota exceeded",
+			Error: managedDiskUpdateError{
+				Code:    "OperationNotAllowed",
+				Message: "Operation could not be completed as it results in exceeding approved Total Regional Cores quota.",
+				err:     errors.New("performing Update"),
+			},
+			Expected: false,
+		},
+		{
+			Name: "other property change not allowed",
+			Error: managedDiskUpdateError{
+				Code:    "PropertyChangeNotAllowed",
+				Message: "Changing property 'osType' is not allowed.",
+				err:     errors.New("performing Update"),
+			},
+			Expected: false,
+		},
+		{
+			Name: "throttled",
+			Error: managedDiskUpdateError{
+				Code:    "TooManyRequests",
+				Message: "The request is being throttled as the limit has been reached for operation type - Write.",
+				err:     errors.New("performing Update"),
+			},
+			Expected: false,
+		},
+		{
+			Name: "internal server error without a code",
+			Error: managedDiskUpdateError{
+				err: errors.New("polling after Update: unexpected status 500"),
+			},
+			Expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		if actual := isManagedDiskOnlineExpansionNotSupportedError(tc.Error); actual != tc.Expected {
+			t.Fatalf("expected %t but got %t", tc.Expected, actual)
+		}
+	}
+}
+
+func TestUpdateManagedDiskWithOfflineExpansionFallback(t *testing.T) {
+	id := commonids.NewManagedDiskID("00000000-0000-0000-0000-000000000000", "example", "disk1")
+
+	testCases := []struct {
+		Name             string
+		UpdateError      error
+		OfflineError     error
+		ExpectFallback   bool
+		ExpectError      bool
+		ExpectedWarnings int
+	}{
+		{
+			Name: "expanded without downtime",
+		},
+		{
+			Name: "online expansion not supported",
+			UpdateError: managedDiskUpdateError{
+				Code:    "OperationNotAllowed",
+				Message: "Disk resizing is allowed only when creating a VM or when the VM is deallocated.",
+				err:     errors.New("performing Update"),
+			},
+			ExpectFallback:   true,
+			ExpectedWarnings: 1,
+		},
+		{
+			Name: "online expansion not supported and offline expansion fails",
+			UpdateError: managedDiskUpdateError{
+				Code:    "PropertyChangeNotAllowed",
+				Message: "Changing property 'diskSizeGB' is not allowed.",
+				err:     errors.New("performing Update"),
+			},
+			OfflineError:     errors.New("deallocating"),
+			ExpectFallback:   true,
+			ExpectError:      true,
+			ExpectedWarnings: 1,
+		},
+		{
+			Name: "quota exceeded",
+			UpdateError: managedDiskUpdateError{
+				Code:    "OperationNotAllowed",
+				Message: "Operation could not be completed as it results in exceeding approved quota.",
+				err:     errors.New("performing Update"),
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "invalid sku",
+			UpdateError: managedDiskUpdateError{
+				Code:    "InvalidParameter",
+				Message: "The value of parameter sku.name is invalid.",
+				err:     errors.New("performing Update"),
+			},
+			ExpectError: true,
+		},
+		{
+			Name:        "transient error",
+			UpdateError: errors.New("polling after Update: unexpected status 503"),
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		fellBack := false
+		update := func() error {
+			return tc.UpdateError
+		}
+		updateOffline := func() error {
+			fellBack = true
+			return tc.OfflineError
+		}
+		logger := &sdk.DiagnosticsLogger{}
+
+		err := updateManagedDiskWithOfflineExpansionFallback(id, update, updateOffline, logger)
+		if tc.ExpectError && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		if fellBack != tc.ExpectFallback {
+			t.Fatalf("expected the offline expansion to be performed to be %t but got %t", tc.ExpectFallback, fellBack)
+		}
+
+		diags := logger.Diagnostics()
+		if len(diags) != tc.ExpectedWarnings {
+			t.Fatalf("expected %d warnings but got %d: %+v", tc.ExpectedWarnings, len(diags), diags)
+		}
+		for _, v := range diags {
+			if v.Severity != diag.Warning {
+				t.Fatalf("expected a warning but got %+v", v)
+			}
+			if !strings.Contains(v.Summary, id.String()) {
+				t.Fatalf("expected the warning %q to contain %q", v.Summary, id.String())
+			}
+		}
+	}
+}
+
+func TestManagedDiskVirtualMachineStepsMessage(t *testing.T) {
+	id := commonids.NewManagedDiskID("00000000-0000-0000-0000-000000000000", "example", "disk1")
+	virtualMachineId := virtualmachines.NewVirtualMachineID("00000000-0000-0000-0000-000000000000", "example", "vm1")
+
+	testCases := []struct {
+		Name            string
+		Steps           []string
+		LeftDeallocated bool
+		Expected        string
+	}{
+		{
+			Name:     "restarted",
+			Steps:    []string{"shut down the Virtual Machine", "deallocated the Virtual Machine", "started the Virtual Machine"},
+			Expected: fmt.Sprintf("updating %s required %s to be taken offline, the following steps were performed: shut down the Virtual Machine, deallocated the Virtual Machine, started the Virtual Machine", id, virtualMachineId),
+		},
+		{
+			Name:            "left deallocated",
+			Steps:           []string{"detached the Disk", "deallocated the Virtual Machine", "reattached the Disk"},
+			LeftDeallocated: true,
+			Expected:        fmt.Sprintf("updating %s required %s to be taken offline, the following steps were performed: detached the Disk, deallocated the Virtual Machine, reattached the Disk - the Virtual Machine has been left deallocated since it wasn't running beforehand", id, virtualMachineId),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		logger := &sdk.DiagnosticsLogger{}
+		logger.Warn(managedDiskVirtualMachineStepsMessage(id, virtualMachineId, tc.Steps, tc.LeftDeallocated))
+
+		diags := logger.Diagnostics()
+		if len(diags) != 1 || diags[0].Severity != diag.Warning {
+			t.Fatalf("expected a single warning but got %+v", diags)
+		}
+		if diags[0].Summary != tc.Expected {
+			t.Fatalf("expected %q but got %q", tc.Expected, diags[0].Summary)
+		}
+	}
+}
@@ -59,11 +59,39 @@ func OrchestratedVirtualMachineScaleSetWindowsConfigurationSchema() *pluginsdk.S
 
 				"admin_password": {
 					Type:             pluginsdk.TypeString,
-					Required:         true,
+					Optional:         true,
 					ForceNew:         true,
 					Sensitive:        true,
 					DiffSuppressFunc: adminPasswordDiffSuppressFunc,
 					ValidateFunc:     validatePasswordComplexityWindows,
+					ExactlyOneOf: []string{
+						"os_profile.0.windows_configuration.0.admin_password",
+						"os_profile.0.windows_configuration.0.admin_password_wo",
+					},
+				},
+
+				"admin_password_wo": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					WriteOnly:    true,
+					ValidateFunc: validatePasswordComplexityWindows,
+					ExactlyOneOf: []string{
+						"os_profile.0.windows_configuration.0.admin_password",
+						"os_profile.0.windows_configuration.0.admin_password_wo",
+					},
+					RequiredWith: []string{
+						"os_profile.0.windows_configuration.0.admin_password_wo_version",
+					},
+				},
+
+				"admin_password_wo_version": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					RequiredWith: []string{
+						"os_profile.0.windows_configuration.0.admin_password_wo",
+					},
 				},
 
 				"computer_name_prefix": computerPrefixWindowsSchema(),
@@ -146,6 +174,32 @@ func OrchestratedVirtualMachineScaleSetLinuxConfigurationSchema() *pluginsdk.Sch
 					Sensitive:        true,
 					DiffSuppressFunc: adminPasswordDiffSuppressFunc,
 					ValidateFunc:     validatePasswordComplexityLinux,
+					ConflictsWith: []string{
+						"os_profile.0.linux_configuration.0.admin_password_wo",
+					},
+				},
+
+				"admin_password_wo": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					WriteOnly:    true,
+					ValidateFunc: validatePasswordComplexityLinux,
+					ConflictsWith: []string{
+						"os_profile.0.linux_configuration.0.admin_password",
+					},
+					RequiredWith: []string{
+						"os_profile.0.linux_configuration.0.admin_password_wo_version",
+					},
+				},
+
+				"admin_password_wo_version": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					RequiredWith: []string{
+						"os_profile.0.linux_configuration.0.admin_password_wo",
+					},
 				},
 
 				"admin_ssh_key":        SSHKeysSchema(false),
@@ -1748,6 +1802,7 @@ func flattenOrchestratedVirtualMachineScaleSetWindowsConfiguration(input *virtua
 		if winConfigRaw := osProfile["windows_configuration"].([]interface{}); len(winConfigRaw) > 0 && winConfigRaw[0] != nil {
 			winCfg := winConfigRaw[0].(map[string]interface{})
 			output["admin_password"] = winCfg["admin_password"].(string)
+			output["admin_password_wo_version"] = winCfg["admin_password_wo_version"].(int)
 		}
 	}
 
@@ -1812,6 +1867,7 @@ func flattenOrchestratedVirtualMachineScaleSetLinuxConfiguration(input *virtualm
 		if linConfigRaw := osProfile["linux_configuration"].([]interface{}); len(linConfigRaw) > 0 && linConfigRaw[0] != nil {
 			linCfg := linConfigRaw[0].(map[string]interface{})
 			output["admin_password"] = linCfg["admin_password"].(string)
+			output["admin_password_wo_version"] = linCfg["admin_password_wo_version"].(int)
 		}
 	}
 
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/images"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/proximityplacementgroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-11-01/virtualmachinescalesets"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -572,6 +573,14 @@ func resourceOrchestratedVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData,
 			patchAssessmentMode := winConfig["patch_assessment_mode"].(string)
 			vmssOsProfile = expandOrchestratedVirtualMachineScaleSetOsProfileWithWindowsConfiguration(winConfig, customData)
 
+			adminPasswordWo, err := pluginsdk.GetWriteOnly(d, "os_profile.0.windows_configuration.0.admin_password_wo", cty.String)
+			if err != nil {
+				return err
+			}
+			if !adminPasswordWo.IsNull() {
+				vmssOsProfile.AdminPassword = pointer.To(adminPasswordWo.AsString())
+			}
+
 			// if the Computer Prefix Name was not defined use the computer name
 			if vmssOsProfile.ComputerNamePrefix == nil || len(*vmssOsProfile.ComputerNamePrefix) == 0 {
 				// validate that the computer name is a valid Computer Prefix Name
@@ -637,6 +646,14 @@ func resourceOrchestratedVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData,
 			patchAssessmentMode := linConfig["patch_assessment_mode"].(string)
 			vmssOsProfile = expandOrchestratedVirtualMachineScaleSetOsProfileWithLinuxConfiguration(linConfig, customData)
 
+			adminPasswordWo, err := pluginsdk.GetWriteOnly(d, "os_profile.0.linux_configuration.0.admin_password_wo", cty.String)
+			if err != nil {
+				return err
+			}
+			if !adminPasswordWo.IsNull() {
+				vmssOsProfile.AdminPassword = pointer.To(adminPasswordWo.AsString())
+			}
+
 			// if the Computer Prefix Name was not defined use the computer name
 			if vmssOsProfile.ComputerNamePrefix == nil || len(*vmssOsProfile.ComputerNamePrefix) == 0 {
 				// validate that the computer name is a valid Computer Prefix Name
@@ -1274,6 +1291,8 @@ func resourceOrchestratedVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData,
 	}
 
 	// AutomaticOSUpgradeIsEnabled currently is not supported in orchestrated VMSS flex
+	// the instances within an orchestrated VMSS aren't rolled by the provider, as such the `roll_instances_*` features
+	// (batching, pausing between batches and waiting for healthy instances) don't apply here
 	metaData := virtualMachineScaleSetUpdateMetaData{
 		AutomaticOSUpgradeIsEnabled:  false,
 		CanReimageOnManualUpgrade:    false,
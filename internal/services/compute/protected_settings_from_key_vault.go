@@ -21,7 +21,7 @@ func protectedSettingsFromKeyVaultSchema(conflictsWithProtectedSettings bool) *p
 		MaxItems: 1,
 		ConflictsWith: func() []string {
 			if conflictsWithProtectedSettings {
-				return []string{"protected_settings"}
+				return []string{"protected_settings", "protected_settings_wo"}
 			}
 			return []string{}
 		}(),
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachineextensions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -109,7 +110,23 @@ func resourceVirtualMachineExtension() *pluginsdk.Resource {
 				Sensitive:        true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
-				ConflictsWith:    []string{"protected_settings_from_key_vault"},
+				ConflictsWith:    []string{"protected_settings_from_key_vault", "protected_settings_wo"},
+			},
+
+			"protected_settings_wo": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				ValidateFunc:  validation.StringIsJSON,
+				ConflictsWith: []string{"protected_settings", "protected_settings_from_key_vault"},
+				RequiredWith:  []string{"protected_settings_wo_version"},
+			},
+
+			"protected_settings_wo_version": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{"protected_settings_wo"},
 			},
 
 			"protected_settings_from_key_vault": protectedSettingsFromKeyVaultSchema(true),
@@ -207,6 +224,18 @@ func resourceVirtualMachineExtensionsCreateUpdate(d *pluginsdk.ResourceData, met
 		extension.Properties.ProtectedSettings = pointer.To(result)
 	}
 
+	protectedSettingsWo, err := pluginsdk.GetWriteOnly(d, "protected_settings_wo", cty.String)
+	if err != nil {
+		return err
+	}
+	if !protectedSettingsWo.IsNull() {
+		var result interface{}
+		if err := json.Unmarshal([]byte(protectedSettingsWo.AsString()), &result); err != nil {
+			return fmt.Errorf("unmarshaling `protected_settings_wo`: %+v", err)
+		}
+		extension.Properties.ProtectedSettings = pointer.To(result)
+	}
+
 	if provisionAfterExtensionsValue, exists := d.GetOk("provision_after_extensions"); exists {
 		extension.Properties.ProvisionAfterExtensions = utils.ExpandStringSlice(provisionAfterExtensionsValue.([]interface{}))
 	}
@@ -263,6 +292,7 @@ func resourceVirtualMachineExtensionsRead(d *pluginsdk.ResourceData, meta interf
 			d.Set("auto_upgrade_minor_version", props.AutoUpgradeMinorVersion)
 			d.Set("automatic_upgrade_enabled", props.EnableAutomaticUpgrade)
 			d.Set("protected_settings_from_key_vault", flattenProtectedSettingsFromKeyVault(props.ProtectedSettingsFromKeyVault))
+			d.Set("protected_settings_wo_version", d.Get("protected_settings_wo_version").(int))
 			d.Set("provision_after_extensions", pointer.From(props.ProvisionAfterExtensions))
 
 			suppressFailure := false
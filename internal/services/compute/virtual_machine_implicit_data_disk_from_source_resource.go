@@ -540,7 +540,7 @@ func resizeImplicitDataDisk(ctx context.Context, metadata sdk.ResourceMetaData,
 			return err
 		}
 
-		err = resourceManagedDiskUpdateWithVmShutDown(ctx, metadata.Client, pointer.To(commonids.NewManagedDiskID(id.SubscriptionId, id.ResourceGroup, id.Name)), virtualMachineId, diskUpdate, shouldDetach)
+		err = resourceManagedDiskUpdateWithVmShutDown(ctx, metadata.Client, pointer.To(commonids.NewManagedDiskID(id.SubscriptionId, id.ResourceGroup, id.Name)), virtualMachineId, diskUpdate, shouldDetach, metadata.Logger)
 		if err != nil {
 			return err
 		}
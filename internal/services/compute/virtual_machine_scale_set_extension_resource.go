@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesetextensions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-11-01/virtualmachinescalesets"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -109,6 +110,23 @@ func resourceVirtualMachineScaleSetExtension() *pluginsdk.Resource {
 				Sensitive:        true,
 				ValidateFunc:     validation.StringIsJSON,
 				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+				ConflictsWith:    []string{"protected_settings_wo"},
+			},
+
+			"protected_settings_wo": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				WriteOnly:     true,
+				ValidateFunc:  validation.StringIsJSON,
+				ConflictsWith: []string{"protected_settings", "protected_settings_from_key_vault"},
+				RequiredWith:  []string{"protected_settings_wo_version"},
+			},
+
+			"protected_settings_wo_version": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{"protected_settings_wo"},
 			},
 
 			"protected_settings_from_key_vault": protectedSettingsFromKeyVaultSchema(true),
@@ -193,6 +211,18 @@ func resourceVirtualMachineScaleSetExtensionCreate(d *pluginsdk.ResourceData, me
 		props.Properties.ProtectedSettings = pointer.To(result)
 	}
 
+	protectedSettingsWo, err := pluginsdk.GetWriteOnly(d, "protected_settings_wo", cty.String)
+	if err != nil {
+		return err
+	}
+	if !protectedSettingsWo.IsNull() {
+		var result interface{}
+		if err := json.Unmarshal([]byte(protectedSettingsWo.AsString()), &result); err != nil {
+			return fmt.Errorf("unmarshaling `protected_settings_wo`: %+v", err)
+		}
+		props.Properties.ProtectedSettings = pointer.To(result)
+	}
+
 	if err := client.CreateOrUpdateThenPoll(ctx, id, props); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
@@ -240,6 +270,21 @@ func resourceVirtualMachineScaleSetExtensionUpdate(d *pluginsdk.ResourceData, me
 		props.ProtectedSettings = pointer.To(protectedSettings)
 	}
 
+	if d.HasChange("protected_settings_wo_version") {
+		protectedSettingsWo, err := pluginsdk.GetWriteOnly(d, "protected_settings_wo", cty.String)
+		if err != nil {
+			return err
+		}
+
+		var protectedSettings interface{}
+		if !protectedSettingsWo.IsNull() {
+			if err := json.Unmarshal([]byte(protectedSettingsWo.AsString()), &protectedSettings); err != nil {
+				return fmt.Errorf("unmarshaling `protected_settings_wo`: %+v", err)
+			}
+		}
+		props.ProtectedSettings = pointer.To(protectedSettings)
+	}
+
 	if d.HasChange("protected_settings_from_key_vault") {
 		props.ProtectedSettingsFromKeyVault = expandProtectedSettingsFromKeyVaultOldVMSSExtension(d.Get("protected_settings_from_key_vault").([]interface{}))
 	}
@@ -330,6 +375,7 @@ func resourceVirtualMachineScaleSetExtensionRead(d *pluginsdk.ResourceData, meta
 			d.Set("automatic_upgrade_enabled", props.EnableAutomaticUpgrade)
 			d.Set("force_update_tag", props.ForceUpdateTag)
 			d.Set("protected_settings_from_key_vault", flattenProtectedSettingsFromKeyVaultOldVMSSExtension(props.ProtectedSettingsFromKeyVault))
+			d.Set("protected_settings_wo_version", d.Get("protected_settings_wo_version").(int))
 			d.Set("provision_after_extensions", utils.FlattenStringSlice(props.ProvisionAfterExtensions))
 			d.Set("publisher", props.Publisher)
 			d.Set("type", props.Type)
@@ -8,12 +8,14 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesetrollingupgrades"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachinescalesetvms"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-11-01/virtualmachinescalesets"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 type virtualMachineScaleSetUpdateMetaData struct {
@@ -26,6 +28,18 @@ type virtualMachineScaleSetUpdateMetaData struct {
 	// can we roll instances if we need too? this is a feature toggle
 	CanRollInstancesWhenRequired bool
 
+	// the number (or percentage, which takes precedence when set) of instances to roll at once when `upgrade_mode` is
+	// set to `Manual`, these are feature toggles
+	RollInstancesBatchSize    int
+	RollInstancesBatchPercent int
+
+	// how long to wait between each batch of instances being rolled
+	RollInstancesPauseBetweenBatches time.Duration
+
+	// should we wait for the Application Health extension to report each instance in a batch as healthy before
+	// rolling the next batch? this is a feature toggle
+	RollInstancesWaitForHealthyInstances bool
+
 	// do we need to roll the instances in this scale set?
 	UpdateInstances bool
 
@@ -117,7 +131,6 @@ func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForAutomati
 }
 
 func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForManualUpgradePolicy(ctx context.Context) error {
-	client := metadata.Client.VirtualMachineScaleSetsClient
 	id := metadata.ID
 
 	log.Printf("[DEBUG] Rolling the VM Instances for %s %s", metadata.OSType, id)
@@ -140,35 +153,130 @@ func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForManualUp
 		}
 	}
 
-	// TODO: there's a performance enhancement to do batches here, but this is fine for a first pass
-	for _, instanceId := range instanceIdsToRoll {
-		instanceIds := []string{instanceId}
+	batches := batchInstanceIds(instanceIdsToRoll, metadata.RollInstancesBatchSize, metadata.RollInstancesBatchPercent)
+	for i, batch := range batches {
+		if err := metadata.rollInstances(ctx, batch); err != nil {
+			// the remaining instances (including those in the failed batch) may not be on the latest model
+			remaining := make([]string, 0)
+			for _, b := range batches[i:] {
+				remaining = append(remaining, b...)
+			}
+			return fmt.Errorf("%+v\n\nrolling the VM Instances for %s %s was stopped, the following Instances may not be using the latest model: %s", err, metadata.OSType, id, strings.Join(remaining, ", "))
+		}
+
+		if metadata.RollInstancesPauseBetweenBatches > 0 && i < len(batches)-1 {
+			log.Printf("[DEBUG] Pausing for %s before rolling the next batch of Instances..", metadata.RollInstancesPauseBetweenBatches)
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("waiting to roll the next batch of VM Instances for %s %s: %+v", metadata.OSType, id, ctx.Err())
+			case <-time.After(metadata.RollInstancesPauseBetweenBatches):
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Rolled the VM Instances for %s %s.", metadata.OSType, id)
+	return nil
+}
+
+// rollInstances updates (and if enabled, reimages) a batch of instances to the latest model
+func (metadata virtualMachineScaleSetUpdateMetaData) rollInstances(ctx context.Context, instanceIds []string) error {
+	client := metadata.Client.VirtualMachineScaleSetsClient
+	id := metadata.ID
 
-		log.Printf("[DEBUG] Updating Instance %q to the Latest Configuration..", instanceId)
-		ids := virtualmachinescalesets.VirtualMachineScaleSetVMInstanceRequiredIDs{
-			InstanceIds: instanceIds,
+	log.Printf("[DEBUG] Updating Instances %q to the Latest Configuration..", instanceIds)
+	ids := virtualmachinescalesets.VirtualMachineScaleSetVMInstanceRequiredIDs{
+		InstanceIds: instanceIds,
+	}
+	if err := client.UpdateInstancesThenPoll(ctx, *id, ids); err != nil {
+		return fmt.Errorf("updating Instances %q (%s %s) to the Latest Configuration: %+v", instanceIds, metadata.OSType, id, err)
+	}
+	log.Printf("[DEBUG] Updated Instances %q to the Latest Configuration.", instanceIds)
+
+	if metadata.CanReimageOnManualUpgrade {
+		log.Printf("[DEBUG] Reimaging Instances %q..", instanceIds)
+		reImageInput := virtualmachinescalesets.VirtualMachineScaleSetReimageParameters{
+			InstanceIds: &instanceIds,
 		}
-		if err := client.UpdateInstancesThenPoll(ctx, *id, ids); err != nil {
-			return fmt.Errorf("updating Instance %q (%s %s) to the Latest Configuration: %+v", instanceId, metadata.OSType, id, err)
+		if err := client.ReimageThenPoll(ctx, *id, reImageInput); err != nil {
+			return fmt.Errorf("reimaging Instances %q (%s %s): %+v", instanceIds, metadata.OSType, id, err)
 		}
-		log.Printf("[DEBUG] Updated Instance %q to the Latest Configuration.", instanceId)
+		log.Printf("[DEBUG] Reimaged Instances %q.", instanceIds)
+	}
 
-		if metadata.CanReimageOnManualUpgrade {
-			log.Printf("[DEBUG] Reimaging Instance %q..", instanceId)
-			reImageInput := virtualmachinescalesets.VirtualMachineScaleSetReimageParameters{
-				InstanceIds: &instanceIds,
-			}
-			if err := client.ReimageThenPoll(ctx, *id, reImageInput); err != nil {
-				return fmt.Errorf("reimaging Instance %q (%s %s): %+v", instanceId, metadata.OSType, id, err)
+	if metadata.RollInstancesWaitForHealthyInstances {
+		for _, instanceId := range instanceIds {
+			if err := metadata.waitForInstanceToBeHealthy(ctx, instanceId); err != nil {
+				return err
 			}
-			log.Printf("[DEBUG] Reimaged Instance %q..", instanceId)
 		}
 	}
 
-	log.Printf("[DEBUG] Rolled the VM Instances for %s %s.", metadata.OSType, id)
 	return nil
 }
 
+// waitForInstanceToBeHealthy waits for the Application Health extension to report the instance as healthy
+func (metadata virtualMachineScaleSetUpdateMetaData) waitForInstanceToBeHealthy(ctx context.Context, instanceId string) error {
+	instancesClient := metadata.Client.VirtualMachineScaleSetVMsClient
+	id := metadata.ID
+	instanceViewId := virtualmachinescalesetvms.NewVirtualMachineScaleSetVirtualMachineID(id.SubscriptionId, id.ResourceGroupName, id.VirtualMachineScaleSetName, instanceId)
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	log.Printf("[DEBUG] Waiting for Instance %q (%s %s) to be healthy..", instanceId, metadata.OSType, id)
+	// the health state codes (e.g. `HealthState/healthy`) are compared case-insensitively
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			"healthstate/initializing",
+			"healthstate/unhealthy",
+			"healthstate/unknown",
+		},
+		Target: []string{
+			"healthstate/healthy",
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := instancesClient.GetInstanceView(ctx, instanceViewId)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving the Instance View for %s: %+v", instanceViewId, err)
+			}
+
+			if resp.Model == nil || resp.Model.VMHealth == nil || resp.Model.VMHealth.Status == nil || resp.Model.VMHealth.Status.Code == nil {
+				return nil, "", fmt.Errorf("%s did not report its health, `roll_instances_wait_for_healthy_instances` requires the Application Health extension or a Load Balancer health probe", instanceViewId)
+			}
+
+			return resp, strings.ToLower(pointer.From(resp.Model.VMHealth.Status.Code)), nil
+		},
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for Instance %q (%s %s) to be healthy: %+v", instanceId, metadata.OSType, id, err)
+	}
+	log.Printf("[DEBUG] Instance %q (%s %s) is healthy.", instanceId, metadata.OSType, id)
+
+	return nil
+}
+
+// batchInstanceIds splits the instances to roll into batches, using the percentage of the instances when specified
+// and otherwise the batch size
+func batchInstanceIds(instanceIds []string, batchSize int, batchPercent int) [][]string {
+	if batchPercent > 0 {
+		batchSize = (len(instanceIds)*batchPercent + 99) / 100
+	}
+	if batchSize < 1 {
+		batchSize = 1
+	}
+
+	batches := make([][]string, 0)
+	for start := 0; start < len(instanceIds); start += batchSize {
+		end := min(start+batchSize, len(instanceIds))
+		batches = append(batches, instanceIds[start:end])
+	}
+	return batches
+}
+
 func isUsingLatestImage(update virtualmachinescalesets.VirtualMachineScaleSetUpdate) bool {
 	if update.Properties.VirtualMachineProfile.StorageProfile == nil ||
 		update.Properties.VirtualMachineProfile.StorageProfile.ImageReference == nil ||
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBatchInstanceIds(t *testing.T) {
+	instanceIds := []string{"0", "1", "2", "3", "4", "5", "6"}
+
+	testCases := []struct {
+		Name         string
+		BatchSize    int
+		BatchPercent int
+		Expected     [][]string
+	}{
+		{
+			Name:      "One At A Time",
+			BatchSize: 1,
+			Expected:  [][]string{{"0"}, {"1"}, {"2"}, {"3"}, {"4"}, {"5"}, {"6"}},
+		},
+		{
+			Name:      "Batch Size",
+			BatchSize: 3,
+			Expected:  [][]string{{"0", "1", "2"}, {"3", "4", "5"}, {"6"}},
+		},
+		{
+			Name:         "Batch Percent Takes Precedence",
+			BatchSize:    1,
+			BatchPercent: 50,
+			Expected:     [][]string{{"0", "1", "2", "3"}, {"4", "5", "6"}},
+		},
+		{
+			Name:         "Batch Percent Rounds Up To One Instance",
+			BatchPercent: 1,
+			Expected:     [][]string{{"0"}, {"1"}, {"2"}, {"3"}, {"4"}, {"5"}, {"6"}},
+		},
+		{
+			Name:      "Batch Larger Than Instances",
+			BatchSize: 10,
+			Expected:  [][]string{{"0", "1", "2", "3", "4", "5", "6"}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		actual := batchInstanceIds(instanceIds, testCase.BatchSize, testCase.BatchPercent)
+		if !reflect.DeepEqual(actual, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, actual)
+		}
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/proximityplacementgroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2023-04-02/disks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
@@ -76,18 +77,41 @@ func resourceWindowsVirtualMachine() *pluginsdk.Resource {
 					"admin_username",
 				},
 				ConflictsWith: []string{
+					"admin_password_wo",
 					"os_managed_disk_id",
 				},
 				ValidateFunc: computeValidate.WindowsAdminPassword,
 			},
 
+			"admin_password_wo": {
+				Type:      pluginsdk.TypeString,
+				Optional:  true,
+				WriteOnly: true,
+				RequiredWith: []string{
+					"admin_username",
+					"admin_password_wo_version",
+				},
+				ConflictsWith: []string{
+					"admin_password",
+					"os_managed_disk_id",
+				},
+				ValidateFunc: computeValidate.WindowsAdminPassword,
+			},
+
+			"admin_password_wo_version": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{
+					"admin_password_wo",
+				},
+			},
+
 			"admin_username": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
 				ForceNew: true,
-				RequiredWith: []string{
-					"admin_password",
-				},
 				ExactlyOneOf: []string{
 					"admin_username",
 					"os_managed_disk_id",
@@ -666,8 +690,20 @@ func resourceWindowsVirtualMachineCreate(d *pluginsdk.ResourceData, meta interfa
 			}
 		}
 
+		adminPassword := d.Get("admin_password").(string)
+		adminPasswordWo, err := pluginsdk.GetWriteOnly(d, "admin_password_wo", cty.String)
+		if err != nil {
+			return err
+		}
+		if !adminPasswordWo.IsNull() {
+			adminPassword = adminPasswordWo.AsString()
+		}
+		if adminPassword == "" {
+			return fmt.Errorf("one of `admin_password` or `admin_password_wo` must be specified when `admin_username` is set")
+		}
+
 		params.Properties.OsProfile = &virtualmachines.OSProfile{
-			AdminPassword:            pointer.To(d.Get("admin_password").(string)),
+			AdminPassword:            pointer.To(adminPassword),
 			AdminUsername:            pointer.To(d.Get("admin_username").(string)),
 			ComputerName:             pointer.To(computerName),
 			AllowExtensionOperations: pointer.To(allowExtensionOperations),
@@ -1065,6 +1101,8 @@ func resourceWindowsVirtualMachineRead(d *pluginsdk.ResourceData, meta interface
 			}
 			d.Set("platform_fault_domain", platformFaultDomain)
 
+			d.Set("admin_password_wo_version", d.Get("admin_password_wo_version").(int))
+
 			if profile := props.OsProfile; profile != nil {
 				d.Set("admin_username", profile.AdminUsername)
 				d.Set("allow_extension_operations", profile.AllowExtensionOperations)
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/images"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/proximityplacementgroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-11-01/virtualmachinescalesets"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -224,10 +225,19 @@ func resourceWindowsVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData, meta
 		RollingUpgradePolicy:     rollingUpgradePolicy,
 	}
 
+	adminPassword := d.Get("admin_password").(string)
+	adminPasswordWo, err := pluginsdk.GetWriteOnly(d, "admin_password_wo", cty.String)
+	if err != nil {
+		return err
+	}
+	if !adminPasswordWo.IsNull() {
+		adminPassword = adminPasswordWo.AsString()
+	}
+
 	virtualMachineProfile := virtualmachinescalesets.VirtualMachineScaleSetVMProfile{
 		Priority: pointer.To(priority),
 		OsProfile: &virtualmachinescalesets.VirtualMachineScaleSetOSProfile{
-			AdminPassword:      pointer.To(d.Get("admin_password").(string)),
+			AdminPassword:      pointer.To(adminPassword),
 			AdminUsername:      pointer.To(d.Get("admin_username").(string)),
 			ComputerNamePrefix: pointer.To(computerNamePrefix),
 			WindowsConfiguration: &virtualmachinescalesets.WindowsConfiguration{
@@ -833,14 +843,18 @@ func resourceWindowsVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData, meta
 	update.Properties = &updateProps
 
 	metaData := virtualMachineScaleSetUpdateMetaData{
-		AutomaticOSUpgradeIsEnabled:  automaticOSUpgradeIsEnabled,
-		CanReimageOnManualUpgrade:    meta.(*clients.Client).Features.VirtualMachineScaleSet.ReimageOnManualUpgrade,
-		CanRollInstancesWhenRequired: meta.(*clients.Client).Features.VirtualMachineScaleSet.RollInstancesWhenRequired,
-		UpdateInstances:              updateInstances,
-		Client:                       meta.(*clients.Client).Compute,
-		Existing:                     *existing.Model,
-		ID:                           id,
-		OSType:                       virtualmachinescalesets.OperatingSystemTypesWindows,
+		AutomaticOSUpgradeIsEnabled:          automaticOSUpgradeIsEnabled,
+		CanReimageOnManualUpgrade:            meta.(*clients.Client).Features.VirtualMachineScaleSet.ReimageOnManualUpgrade,
+		CanRollInstancesWhenRequired:         meta.(*clients.Client).Features.VirtualMachineScaleSet.RollInstancesWhenRequired,
+		RollInstancesBatchSize:               meta.(*clients.Client).Features.VirtualMachineScaleSet.RollInstancesBatchSize,
+		RollInstancesBatchPercent:            meta.(*clients.Client).Features.VirtualMachineScaleSet.RollInstancesBatchPercent,
+		RollInstancesPauseBetweenBatches:     time.Duration(meta.(*clients.Client).Features.VirtualMachineScaleSet.RollInstancesPauseBetweenBatchesInSeconds) * time.Second,
+		RollInstancesWaitForHealthyInstances: meta.(*clients.Client).Features.VirtualMachineScaleSet.RollInstancesWaitForHealthyInstances,
+		UpdateInstances:                      updateInstances,
+		Client:                               meta.(*clients.Client).Compute,
+		Existing:                             *existing.Model,
+		ID:                                   id,
+		OSType:                               virtualmachinescalesets.OperatingSystemTypesWindows,
 	}
 
 	if err := metaData.performUpdate(ctx, update); err != nil {
@@ -1012,6 +1026,7 @@ func resourceWindowsVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, meta i
 				if osProfile := profile.OsProfile; osProfile != nil {
 					// admin_password isn't returned, but it's a top level field so we can ignore it without consequence
 					d.Set("admin_username", osProfile.AdminUsername)
+					d.Set("admin_password_wo_version", d.Get("admin_password_wo_version").(int))
 					d.Set("computer_name_prefix", osProfile.ComputerNamePrefix)
 
 					if osProfile.AllowExtensionOperations != nil {
@@ -1189,11 +1204,29 @@ func resourceWindowsVirtualMachineScaleSetSchema() map[string]*pluginsdk.Schema
 
 		"admin_password": {
 			Type:             pluginsdk.TypeString,
-			Required:         true,
+			Optional:         true,
 			ForceNew:         true,
 			Sensitive:        true,
 			DiffSuppressFunc: adminPasswordDiffSuppressFunc,
 			ValidateFunc:     validation.StringIsNotEmpty,
+			ExactlyOneOf:     []string{"admin_password", "admin_password_wo"},
+		},
+
+		"admin_password_wo": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			WriteOnly:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			ExactlyOneOf: []string{"admin_password", "admin_password_wo"},
+			RequiredWith: []string{"admin_password_wo_version"},
+		},
+
+		"admin_password_wo_version": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			RequiredWith: []string{"admin_password_wo"},
 		},
 
 		"network_interface": VirtualMachineScaleSetNetworkInterfaceSchema(),
@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
 	"github.com/hashicorp/go-azure-sdk/sdk/environments"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdkhacks"
 )
 
 type Client struct {
@@ -34,6 +35,8 @@ type Client struct {
 	ContainerRegistryClient *containerregistry.Client
 	// v2019_06_01_preview is needed for container registry agent pools and tasks
 	ContainerRegistryClient_v2019_06_01_preview *containerregistry_v2019_06_01_preview.Client
+	FleetAutoUpgradeProfilesClient              *sdkhacks.AutoUpgradeProfilesClient
+	FleetGatesClient                            *sdkhacks.GatesClient
 	FleetUpdateRunsClient                       *updateruns.UpdateRunsClient
 	FleetUpdateStrategiesClient                 *fleetupdatestrategies.FleetUpdateStrategiesClient
 	KubernetesClustersClient                    *managedclusters.ManagedClustersClient
@@ -80,6 +83,18 @@ func NewContainersClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(credentialSetsClient.Client, o.Authorizers.ResourceManager)
 
 	// AKS
+	fleetAutoUpgradeProfilesClient, err := sdkhacks.NewAutoUpgradeProfilesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Fleet Auto Upgrade Profiles Client: %+v", err)
+	}
+	o.Configure(fleetAutoUpgradeProfilesClient.Client, o.Authorizers.ResourceManager)
+
+	fleetGatesClient, err := sdkhacks.NewGatesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Fleet Gates Client: %+v", err)
+	}
+	o.Configure(fleetGatesClient.Client, o.Authorizers.ResourceManager)
+
 	fleetUpdateRunsClient, err := updateruns.NewUpdateRunsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Fleet Update Runs Client: %+v", err)
@@ -147,6 +162,8 @@ func NewContainersClient(o *common.ClientOptions) (*Client, error) {
 		CredentialSetsClient:                        credentialSetsClient,
 		ContainerRegistryClient:                     containerRegistryClient,
 		ContainerRegistryClient_v2019_06_01_preview: containerRegistryClient_v2019_06_01_preview,
+		FleetAutoUpgradeProfilesClient:              fleetAutoUpgradeProfilesClient,
+		FleetGatesClient:                            fleetGatesClient,
 		FleetUpdateRunsClient:                       fleetUpdateRunsClient,
 		FleetUpdateStrategiesClient:                 fleetUpdateStrategiesClient,
 		KubernetesClustersClient:                    kubernetesClustersClient,
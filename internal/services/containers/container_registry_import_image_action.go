@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/registries"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type ContainerRegistryImportImageAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &ContainerRegistryImportImageAction{}
+
+func newContainerRegistryImportImageAction() action.Action {
+	return &ContainerRegistryImportImageAction{}
+}
+
+type ContainerRegistryImportImageActionModel struct {
+	ContainerRegistryId        types.String `tfsdk:"container_registry_id"`
+	SourceImage                types.String `tfsdk:"source_image"`
+	SourceContainerRegistryId  types.String `tfsdk:"source_container_registry_id"`
+	SourceRegistryUri          types.String `tfsdk:"source_registry_uri"`
+	SourceUsername             types.String `tfsdk:"source_username"`
+	SourcePassword             types.String `tfsdk:"source_password"`
+	TargetTags                 types.List   `tfsdk:"target_tags"`
+	UntaggedTargetRepositories types.List   `tfsdk:"untagged_target_repositories"`
+	OverwriteEnabled           types.Bool   `tfsdk:"overwrite_enabled"`
+}
+
+func (a *ContainerRegistryImportImageAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"container_registry_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Container Registry to import the image into.",
+				MarkdownDescription: "The ID of the Container Registry to import the image into.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: registries.ValidateRegistryID,
+					},
+				},
+			},
+
+			"source_image": schema.StringAttribute{
+				Required:            true,
+				Description:         "The repository and tag or digest of the image to import, for example `library/hello-world:latest` or `library/hello-world@sha256:...`.",
+				MarkdownDescription: "The repository and tag or digest of the image to import, for example `library/hello-world:latest` or `library/hello-world@sha256:...`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"source_container_registry_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The ID of the Azure Container Registry to import the image from.",
+				MarkdownDescription: "The ID of the Azure Container Registry to import the image from.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: registries.ValidateRegistryID,
+					},
+					stringvalidator.ExactlyOneOf(path.MatchRoot("source_registry_uri")),
+				},
+			},
+
+			"source_registry_uri": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The address of the registry to import the image from, for example `docker.io` or `myregistry.azurecr.io`.",
+				MarkdownDescription: "The address of the registry to import the image from, for example `docker.io` or `myregistry.azurecr.io`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"source_username": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The username used to authenticate with the source registry.",
+				MarkdownDescription: "The username used to authenticate with the source registry.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("source_password")),
+				},
+			},
+
+			"source_password": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The password or token used to authenticate with the source registry.",
+				MarkdownDescription: "The password or token used to authenticate with the source registry.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			"target_tags": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "A list of repository and tag pairs to import the image as, for example `hello-world:latest`. Defaults to the repository and tag of the `source_image`.",
+				MarkdownDescription: "A list of repository and tag pairs to import the image as, for example `hello-world:latest`. Defaults to the repository and tag of the `source_image`.",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+
+			"untagged_target_repositories": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "A list of repositories to import the image manifest into without a tag.",
+				MarkdownDescription: "A list of repositories to import the image manifest into without a tag.",
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+
+			"overwrite_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Should existing tags in the target Container Registry be overwritten? Defaults to `false`.",
+				MarkdownDescription: "Should existing tags in the target Container Registry be overwritten? Defaults to `false`.",
+			},
+		},
+	}
+}
+
+func (a *ContainerRegistryImportImageAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_container_registry_import_image"
+}
+
+func (a *ContainerRegistryImportImageAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.Containers.ContainerRegistryClient.Registries
+
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*30)
+	defer cancel()
+
+	model := ContainerRegistryImportImageActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := registries.ParseRegistryID(model.ContainerRegistryId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	source := registries.ImportSource{
+		SourceImage: model.SourceImage.ValueString(),
+	}
+
+	if v := model.SourceContainerRegistryId.ValueString(); v != "" {
+		sourceId, err := registries.ParseRegistryID(v)
+		if err != nil {
+			sdk.SetResponseErrorDiagnostic(response, "parsing `source_container_registry_id`", err)
+			return
+		}
+		source.ResourceId = pointer.To(sourceId.ID())
+	}
+
+	if v := model.SourceRegistryUri.ValueString(); v != "" {
+		source.RegistryUri = pointer.To(v)
+	}
+
+	if v := model.SourcePassword.ValueString(); v != "" {
+		source.Credentials = &registries.ImportSourceCredentials{
+			Password: v,
+		}
+
+		if username := model.SourceUsername.ValueString(); username != "" {
+			source.Credentials.Username = pointer.To(username)
+		}
+	}
+
+	mode := registries.ImportModeNoForce
+	if model.OverwriteEnabled.ValueBool() {
+		mode = registries.ImportModeForce
+	}
+
+	payload := registries.ImportImageParameters{
+		Mode:   pointer.To(mode),
+		Source: source,
+	}
+
+	if !model.TargetTags.IsNull() {
+		targetTags := make([]string, 0)
+		response.Diagnostics.Append(model.TargetTags.ElementsAs(ctx, &targetTags, false)...)
+		payload.TargetTags = pointer.To(targetTags)
+	}
+
+	if !model.UntaggedTargetRepositories.IsNull() {
+		repositories := make([]string, 0)
+		response.Diagnostics.Append(model.UntaggedTargetRepositories.ElementsAs(ctx, &repositories, false)...)
+		payload.UntaggedTargetRepositories = pointer.To(repositories)
+	}
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("importing %s into registry %s", source.SourceImage, id.RegistryName),
+	})
+
+	if err := client.ImportImageThenPoll(ctx, *id, payload); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("importing %s into %s: %+v", source.SourceImage, id, err))
+		return
+	}
+
+	message := fmt.Sprintf("imported %s into registry %s", source.SourceImage, id.RegistryName)
+	if tags := pointer.From(payload.TargetTags); len(tags) > 0 {
+		message = fmt.Sprintf("imported %s into registry %s as %s", source.SourceImage, id.RegistryName, strings.Join(tags, ", "))
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: message,
+	})
+}
+
+func (a *ContainerRegistryImportImageAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(ctx, request, response)
+}
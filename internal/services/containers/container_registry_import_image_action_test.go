@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+type ContainerRegistryImportImageAction struct{}
+
+func TestAccContainerRegistryImportImageAction_publicRegistry(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_import_image", "test")
+	a := ContainerRegistryImportImageAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.publicRegistry(data),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func TestAccContainerRegistryImportImageAction_fromContainerRegistry(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_import_image", "test")
+	a := ContainerRegistryImportImageAction{}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: a.fromContainerRegistry(data),
+				Check:  nil, // TODO - plugin-testing release?
+			},
+		},
+	})
+}
+
+func (a *ContainerRegistryImportImageAction) publicRegistry(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "terraform_data" "test" {
+  input = azurerm_container_registry.test.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_container_registry_import_image.test]
+    }
+  }
+}
+
+action "azurerm_container_registry_import_image" "test" {
+  config {
+    container_registry_id = azurerm_container_registry.test.id
+    source_registry_uri   = "mcr.microsoft.com"
+    source_image          = "hello-world:latest"
+    target_tags           = ["hello-world:latest", "hello-world:golden"]
+  }
+}
+`, a.template(data))
+}
+
+func (a *ContainerRegistryImportImageAction) fromContainerRegistry(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_container_registry" "target" {
+  name                = "testacccrtarget%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Basic"
+}
+
+resource "terraform_data" "test" {
+  input = azurerm_container_registry.target.id
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.azurerm_container_registry_import_image.seed, action.azurerm_container_registry_import_image.test]
+    }
+  }
+}
+
+action "azurerm_container_registry_import_image" "seed" {
+  config {
+    container_registry_id = azurerm_container_registry.test.id
+    source_registry_uri   = "mcr.microsoft.com"
+    source_image          = "hello-world:latest"
+  }
+}
+
+action "azurerm_container_registry_import_image" "test" {
+  config {
+    container_registry_id        = azurerm_container_registry.target.id
+    source_container_registry_id = azurerm_container_registry.test.id
+    source_image                 = "hello-world:latest"
+    untagged_target_repositories = ["hello-world-untagged"]
+    overwrite_enabled            = true
+  }
+}
+`, a.template(data), data.RandomInteger)
+}
+
+func (a *ContainerRegistryImportImageAction) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-acr-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "testacccr%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Basic"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
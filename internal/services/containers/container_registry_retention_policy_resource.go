@@ -0,0 +1,310 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/registries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name container_registry_retention_policy -service-package-name containers -compare-values "subscription_id:container_registry_id,resource_group_name:container_registry_id,registry_name:container_registry_id"
+
+var (
+	_ sdk.ResourceWithUpdate               = ContainerRegistryRetentionPolicyResource{}
+	_ sdk.ResourceWithIdentityTypeOverride = ContainerRegistryRetentionPolicyResource{}
+)
+
+type ContainerRegistryRetentionPolicyResource struct{}
+
+type ContainerRegistryRetentionPolicyResourceModel struct {
+	ContainerRegistryId       string                                       `tfschema:"container_registry_id"`
+	UntaggedManifestRetention []ContainerRegistryUntaggedManifestRetention `tfschema:"untagged_manifest_retention"`
+	SoftDelete                []ContainerRegistrySoftDelete                `tfschema:"soft_delete"`
+}
+
+type ContainerRegistryUntaggedManifestRetention struct {
+	Days int64 `tfschema:"days"`
+}
+
+type ContainerRegistrySoftDelete struct {
+	RetentionInDays int64 `tfschema:"retention_in_days"`
+}
+
+func (r ContainerRegistryRetentionPolicyResource) ModelObject() interface{} {
+	return &ContainerRegistryRetentionPolicyResourceModel{}
+}
+
+func (r ContainerRegistryRetentionPolicyResource) ResourceType() string {
+	return "azurerm_container_registry_retention_policy"
+}
+
+func (r ContainerRegistryRetentionPolicyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return registries.ValidateRegistryID
+}
+
+func (r ContainerRegistryRetentionPolicyResource) Identity() resourceids.ResourceId {
+	return &registries.RegistryId{}
+}
+
+func (r ContainerRegistryRetentionPolicyResource) IdentityType() pluginsdk.ResourceTypeForIdentity {
+	return pluginsdk.ResourceTypeForIdentityVirtual
+}
+
+func (r ContainerRegistryRetentionPolicyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"container_registry_id": commonschema.ResourceIDReferenceRequiredForceNew(&registries.RegistryId{}),
+
+		"untagged_manifest_retention": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			AtLeastOneOf: []string{"untagged_manifest_retention", "soft_delete"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"days": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 365),
+					},
+				},
+			},
+		},
+
+		"soft_delete": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			AtLeastOneOf: []string{"untagged_manifest_retention", "soft_delete"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"retention_in_days": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      7,
+						ValidateFunc: validation.IntBetween(1, 90),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ContainerRegistryRetentionPolicyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerRegistryRetentionPolicyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient.Registries
+
+			var config ContainerRegistryRetentionPolicyResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := registries.ParseRegistryID(config.ContainerRegistryId)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			if len(config.UntaggedManifestRetention) > 0 && !strings.EqualFold(string(existing.Model.Sku.Name), string(registries.SkuNamePremium)) {
+				return fmt.Errorf("`untagged_manifest_retention` can only be configured for a Container Registry using the `Premium` SKU")
+			}
+
+			if props := existing.Model.Properties; props != nil && props.Policies != nil {
+				if retention := props.Policies.RetentionPolicy; retention != nil && pointer.From(retention.Status) == registries.PolicyStatusEnabled {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+				if softDelete := props.Policies.SoftDeletePolicy; softDelete != nil && pointer.From(softDelete.Status) == registries.PolicyStatusEnabled {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			payload := registries.RegistryUpdateParameters{
+				Properties: &registries.RegistryPropertiesUpdateParameters{
+					Policies: &registries.Policies{
+						RetentionPolicy:  expandContainerRegistryUntaggedManifestRetention(config.UntaggedManifestRetention),
+						SoftDeletePolicy: expandContainerRegistrySoftDelete(config.SoftDelete),
+					},
+				},
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating the retention policies for %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryRetentionPolicyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient.Registries
+
+			id, err := registries.ParseRegistryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerRegistryRetentionPolicyResourceModel{
+				ContainerRegistryId: id.ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				if policies := model.Properties.Policies; policies != nil {
+					if retention := policies.RetentionPolicy; retention != nil && pointer.From(retention.Status) == registries.PolicyStatusEnabled {
+						state.UntaggedManifestRetention = []ContainerRegistryUntaggedManifestRetention{
+							{
+								Days: pointer.From(retention.Days),
+							},
+						}
+					}
+
+					if softDelete := policies.SoftDeletePolicy; softDelete != nil && pointer.From(softDelete.Status) == registries.PolicyStatusEnabled {
+						state.SoftDelete = []ContainerRegistrySoftDelete{
+							{
+								RetentionInDays: pointer.From(softDelete.RetentionDays),
+							},
+						}
+					}
+				}
+			}
+
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id, pluginsdk.ResourceTypeForIdentityVirtual); err != nil {
+				return err
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerRegistryRetentionPolicyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient.Registries
+
+			id, err := registries.ParseRegistryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ContainerRegistryRetentionPolicyResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			policies := registries.Policies{}
+
+			if metadata.ResourceData.HasChange("untagged_manifest_retention") {
+				policies.RetentionPolicy = expandContainerRegistryUntaggedManifestRetention(config.UntaggedManifestRetention)
+			}
+
+			if metadata.ResourceData.HasChange("soft_delete") {
+				policies.SoftDeletePolicy = expandContainerRegistrySoftDelete(config.SoftDelete)
+			}
+
+			payload := registries.RegistryUpdateParameters{
+				Properties: &registries.RegistryPropertiesUpdateParameters{
+					Policies: &policies,
+				},
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating the retention policies for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerRegistryRetentionPolicyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.ContainerRegistryClient.Registries
+
+			id, err := registries.ParseRegistryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			payload := registries.RegistryUpdateParameters{
+				Properties: &registries.RegistryPropertiesUpdateParameters{
+					Policies: &registries.Policies{
+						RetentionPolicy:  expandContainerRegistryUntaggedManifestRetention(nil),
+						SoftDeletePolicy: expandContainerRegistrySoftDelete(nil),
+					},
+				},
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("disabling the retention policies for %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandContainerRegistryUntaggedManifestRetention(input []ContainerRegistryUntaggedManifestRetention) *registries.RetentionPolicy {
+	if len(input) == 0 {
+		return &registries.RetentionPolicy{
+			Status: pointer.To(registries.PolicyStatusDisabled),
+		}
+	}
+
+	return &registries.RetentionPolicy{
+		Days:   pointer.To(input[0].Days),
+		Status: pointer.To(registries.PolicyStatusEnabled),
+	}
+}
+
+func expandContainerRegistrySoftDelete(input []ContainerRegistrySoftDelete) *registries.SoftDeletePolicy {
+	if len(input) == 0 {
+		return &registries.SoftDeletePolicy{
+			Status: pointer.To(registries.PolicyStatusDisabled),
+		}
+	}
+
+	return &registries.SoftDeletePolicy{
+		RetentionDays: pointer.To(input[0].RetentionInDays),
+		Status:        pointer.To(registries.PolicyStatusEnabled),
+	}
+}
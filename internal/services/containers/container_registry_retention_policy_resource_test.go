@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/registries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerRegistryRetentionPolicyResource struct{}
+
+func TestAccContainerRegistryRetentionPolicy_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_retention_policy", "test")
+	r := ContainerRegistryRetentionPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerRegistryRetentionPolicy_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_retention_policy", "test")
+	r := ContainerRegistryRetentionPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerRegistryRetentionPolicy_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_retention_policy", "test")
+	r := ContainerRegistryRetentionPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerRegistryRetentionPolicy_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_retention_policy", "test")
+	r := ContainerRegistryRetentionPolicyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.softDeleteOnly(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("untagged_manifest_retention.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ContainerRegistryRetentionPolicyResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := registries.ParseRegistryID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Containers.ContainerRegistryClient.Registries.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.Policies != nil {
+		policies := model.Properties.Policies
+		if policies.RetentionPolicy != nil && pointer.From(policies.RetentionPolicy.Status) == registries.PolicyStatusEnabled {
+			return pointer.To(true), nil
+		}
+		if policies.SoftDeletePolicy != nil && pointer.From(policies.SoftDeletePolicy.Status) == registries.PolicyStatusEnabled {
+			return pointer.To(true), nil
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (r ContainerRegistryRetentionPolicyResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_retention_policy" "test" {
+  container_registry_id = azurerm_container_registry.test.id
+
+  untagged_manifest_retention {
+    days = 7
+  }
+}
+`, r.template(data))
+}
+
+func (r ContainerRegistryRetentionPolicyResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_retention_policy" "import" {
+  container_registry_id = azurerm_container_registry_retention_policy.test.container_registry_id
+
+  untagged_manifest_retention {
+    days = 7
+  }
+}
+`, r.basic(data))
+}
+
+func (r ContainerRegistryRetentionPolicyResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_retention_policy" "test" {
+  container_registry_id = azurerm_container_registry.test.id
+
+  untagged_manifest_retention {
+    days = 30
+  }
+
+  soft_delete {
+    retention_in_days = 14
+  }
+}
+`, r.template(data))
+}
+
+func (r ContainerRegistryRetentionPolicyResource) softDeleteOnly(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_retention_policy" "test" {
+  container_registry_id = azurerm_container_registry.test.id
+
+  soft_delete {}
+}
+`, r.template(data))
+}
+
+func (ContainerRegistryRetentionPolicyResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-acr-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "testacccr%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Premium"
+
+  lifecycle {
+    ignore_changes = [retention_policy_in_days]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
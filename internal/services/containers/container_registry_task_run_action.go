@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2019-06-01-preview/tasks"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+type ContainerRegistryTaskRunAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &ContainerRegistryTaskRunAction{}
+
+func newContainerRegistryTaskRunAction() action.Action {
+	return &ContainerRegistryTaskRunAction{}
+}
+
+type ContainerRegistryTaskRunActionModel struct {
+	ContainerRegistryTaskId types.String `tfsdk:"container_registry_task_id"`
+}
+
+func (a *ContainerRegistryTaskRunAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"container_registry_task_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Container Registry Task to run.",
+				MarkdownDescription: "The ID of the Container Registry Task to run.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: tasks.ValidateTaskID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *ContainerRegistryTaskRunAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_container_registry_task_run"
+}
+
+func (a *ContainerRegistryTaskRunAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.Containers.ContainerRegistryClient_v2019_06_01_preview
+
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*60)
+	defer cancel()
+
+	model := ContainerRegistryTaskRunActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := tasks.ParseTaskID(model.ContainerRegistryTaskId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("running task %s on registry %s", id.TaskName, id.RegistryName),
+	})
+
+	runId, err := runContainerRegistryTask(ctx, client, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("running %s: %+v", id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("run %s of task %s completed", runId.RunId, id.TaskName),
+	})
+}
+
+func (a *ContainerRegistryTaskRunAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(ctx, request, response)
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	containerregistry_v2019_06_01_preview "github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2019-06-01-preview"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2019-06-01-preview/registries"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2019-06-01-preview/runs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2019-06-01-preview/tasks"
@@ -81,68 +82,8 @@ func (r ContainerRegistryTaskScheduleResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("properties was nil for %s", taskId)
 			}
 
-			req := registries.TaskRunRequest{
-				TaskId: taskId.ID(),
-			}
-
-			registryId := registries.NewRegistryID(taskId.SubscriptionId, taskId.ResourceGroupName, taskId.RegistryName)
-			registryClient := metadata.Client.Containers.ContainerRegistryClient_v2019_06_01_preview.Registries
-
-			scheduleResp, err := registryClient.ScheduleRun(ctx, registryId, req)
-			if err != nil {
-				return fmt.Errorf("scheduling the task: %+v", err)
-			}
-			if scheduleResp.Model == nil {
-				// If the SDK didn't parse the response body, try parsing it on our side.
-				if scheduleResp.HttpResponse != nil {
-					scheduleRunModel := registries.Run{}
-					err = json.
-						NewDecoder(scheduleResp.HttpResponse.Body).
-						Decode(&scheduleRunModel)
-					if err != nil {
-						return fmt.Errorf("can't decode ScheduleRun model, err: %w for taskID %s", err, taskId)
-					}
-
-					scheduleResp.Model = &scheduleRunModel
-				}
-
-				// If parsing on our side didn't work as well - throw error.
-				if scheduleResp.Model == nil {
-					return fmt.Errorf("ScheduleRun model was nil (status: %d) for taskID %s", scheduleResp.HttpResponse.StatusCode, taskId)
-				}
-			}
-
-			runName := pointer.From(scheduleResp.Model.Name)
-			if runName == "" {
-				return fmt.Errorf("unexpected nil scheduled run name")
-			}
-
-			runId := runs.NewRunID(registryId.SubscriptionId, registryId.ResourceGroupName, registryId.RegistryName, runName)
-
-			runsClient := metadata.Client.Containers.ContainerRegistryClient_v2019_06_01_preview.Runs
-
-			timeout, _ := ctx.Deadline()
-			stateConf := &pluginsdk.StateChangeConf{
-				Pending: []string{string(registries.RunStatusQueued), string(registries.RunStatusStarted), string(registries.RunStatusRunning)},
-				Target:  []string{string(registries.RunStatusSucceeded)},
-				Refresh: func() (interface{}, string, error) {
-					resp, err := runsClient.Get(ctx, runId)
-					if err != nil {
-						return nil, "", fmt.Errorf("getting the scheduled run: %v", err)
-					}
-
-					if resp.Model == nil {
-						return nil, "", fmt.Errorf("model was nil for %s", runId)
-					}
-
-					return resp, string(*resp.Model.Properties.Status), nil
-				},
-				ContinuousTargetOccurence: 1,
-				PollInterval:              5 * time.Second,
-				Timeout:                   time.Until(timeout),
-			}
-			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
-				return fmt.Errorf("waiting for scheduled task to finish: %+v", err)
+			if _, err := runContainerRegistryTask(ctx, metadata.Client.Containers.ContainerRegistryClient_v2019_06_01_preview, *taskId); err != nil {
+				return err
 			}
 
 			metadata.SetID(parse.NewContainerRegistryTaskScheduleID(taskId.SubscriptionId, taskId.ResourceGroupName, taskId.RegistryName, taskId.TaskName, "schedule"))
@@ -174,3 +115,69 @@ func (r ContainerRegistryTaskScheduleResource) Delete() sdk.ResourceFunc {
 		},
 	}
 }
+
+// runContainerRegistryTask schedules a run of the Container Registry Task `taskId` and waits for it to succeed
+func runContainerRegistryTask(ctx context.Context, client *containerregistry_v2019_06_01_preview.Client, taskId tasks.TaskId) (*runs.RunId, error) {
+	req := registries.TaskRunRequest{
+		TaskId: taskId.ID(),
+	}
+
+	registryId := registries.NewRegistryID(taskId.SubscriptionId, taskId.ResourceGroupName, taskId.RegistryName)
+
+	scheduleResp, err := client.Registries.ScheduleRun(ctx, registryId, req)
+	if err != nil {
+		return nil, fmt.Errorf("scheduling the task: %+v", err)
+	}
+	if scheduleResp.Model == nil {
+		// If the SDK didn't parse the response body, try parsing it on our side.
+		if scheduleResp.HttpResponse != nil {
+			scheduleRunModel := registries.Run{}
+			err = json.
+				NewDecoder(scheduleResp.HttpResponse.Body).
+				Decode(&scheduleRunModel)
+			if err != nil {
+				return nil, fmt.Errorf("can't decode ScheduleRun model, err: %w for taskID %s", err, taskId)
+			}
+
+			scheduleResp.Model = &scheduleRunModel
+		}
+
+		// If parsing on our side didn't work as well - throw error.
+		if scheduleResp.Model == nil {
+			return nil, fmt.Errorf("ScheduleRun model was nil (status: %d) for taskID %s", scheduleResp.HttpResponse.StatusCode, taskId)
+		}
+	}
+
+	runName := pointer.From(scheduleResp.Model.Name)
+	if runName == "" {
+		return nil, fmt.Errorf("unexpected nil scheduled run name")
+	}
+
+	runId := runs.NewRunID(registryId.SubscriptionId, registryId.ResourceGroupName, registryId.RegistryName, runName)
+
+	timeout, _ := ctx.Deadline()
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{string(registries.RunStatusQueued), string(registries.RunStatusStarted), string(registries.RunStatusRunning)},
+		Target:  []string{string(registries.RunStatusSucceeded)},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Runs.Get(ctx, runId)
+			if err != nil {
+				return nil, "", fmt.Errorf("getting the scheduled run: %v", err)
+			}
+
+			if resp.Model == nil || resp.Model.Properties == nil {
+				return nil, "", fmt.Errorf("model was nil for %s", runId)
+			}
+
+			return resp, string(pointer.From(resp.Model.Properties.Status)), nil
+		},
+		ContinuousTargetOccurence: 1,
+		PollInterval:              5 * time.Second,
+		Timeout:                   time.Until(timeout),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return nil, fmt.Errorf("waiting for scheduled task to finish: %+v", err)
+	}
+
+	return &runId, nil
+}
@@ -5,6 +5,7 @@ package containers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"time"
@@ -15,23 +16,25 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/kubernetesconfiguration/2024-11-01/extensions"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
 type KubernetesClusterExtensionModel struct {
-	Name                           string            `tfschema:"name"`
-	ClusterID                      string            `tfschema:"cluster_id"`
-	ConfigurationProtectedSettings map[string]string `tfschema:"configuration_protected_settings"`
-	ConfigurationSettings          map[string]string `tfschema:"configuration_settings"`
-	ExtensionType                  string            `tfschema:"extension_type"`
-	Plan                           []PlanModel       `tfschema:"plan"`
-	ReleaseNamespace               string            `tfschema:"release_namespace"`
-	ReleaseTrain                   string            `tfschema:"release_train"`
-	TargetNamespace                string            `tfschema:"target_namespace"`
-	Version                        string            `tfschema:"version"`
-	CurrentVersion                 string            `tfschema:"current_version"`
+	Name                                    string            `tfschema:"name"`
+	ClusterID                               string            `tfschema:"cluster_id"`
+	ConfigurationProtectedSettings          map[string]string `tfschema:"configuration_protected_settings"`
+	ConfigurationProtectedSettingsWoVersion int64             `tfschema:"configuration_protected_settings_wo_version"`
+	ConfigurationSettings                   map[string]string `tfschema:"configuration_settings"`
+	ExtensionType                           string            `tfschema:"extension_type"`
+	Plan                                    []PlanModel       `tfschema:"plan"`
+	ReleaseNamespace                        string            `tfschema:"release_namespace"`
+	ReleaseTrain                            string            `tfschema:"release_train"`
+	TargetNamespace                         string            `tfschema:"target_namespace"`
+	Version                                 string            `tfschema:"version"`
+	CurrentVersion                          string            `tfschema:"current_version"`
 }
 
 type PlanModel struct {
@@ -105,8 +108,9 @@ func (r KubernetesClusterExtensionResource) Arguments() map[string]*pluginsdk.Sc
 		},
 
 		"configuration_protected_settings": {
-			Type:     pluginsdk.TypeMap,
-			Optional: true,
+			Type:          pluginsdk.TypeMap,
+			Optional:      true,
+			ConflictsWith: []string{"configuration_protected_settings_wo"},
 			Elem: &pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				Sensitive:    true,
@@ -114,6 +118,23 @@ func (r KubernetesClusterExtensionResource) Arguments() map[string]*pluginsdk.Sc
 			},
 		},
 
+		// write-only attributes can't be maps, so this is a JSON encoded object of strings
+		"configuration_protected_settings_wo": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			WriteOnly:     true,
+			ValidateFunc:  validation.StringIsJSON,
+			ConflictsWith: []string{"configuration_protected_settings"},
+			RequiredWith:  []string{"configuration_protected_settings_wo_version"},
+		},
+
+		"configuration_protected_settings_wo_version": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			RequiredWith: []string{"configuration_protected_settings_wo"},
+		},
+
 		"configuration_settings": {
 			Type:     pluginsdk.TypeMap,
 			Optional: true,
@@ -244,11 +265,19 @@ func (r KubernetesClusterExtensionResource) Create() sdk.ResourceFunc {
 
 			autoUpgradeMinorVersion := model.Version == ""
 
+			configurationProtectedSettings, err := expandKubernetesClusterExtensionConfigurationProtectedSettingsWriteOnly(metadata.ResourceData)
+			if err != nil {
+				return err
+			}
+			if configurationProtectedSettings == nil {
+				configurationProtectedSettings = &model.ConfigurationProtectedSettings
+			}
+
 			properties := &extensions.Extension{
 				Plan: expandPlanModel(model.Plan),
 				Properties: &extensions.ExtensionProperties{
 					AutoUpgradeMinorVersion:        &autoUpgradeMinorVersion,
-					ConfigurationProtectedSettings: &model.ConfigurationProtectedSettings,
+					ConfigurationProtectedSettings: configurationProtectedSettings,
 					ConfigurationSettings:          &model.ConfigurationSettings,
 				},
 			}
@@ -315,6 +344,17 @@ func (r KubernetesClusterExtensionResource) Update() sdk.ResourceFunc {
 				properties.Properties.ConfigurationProtectedSettings = &model.ConfigurationProtectedSettings
 			}
 
+			if metadata.ResourceData.HasChange("configuration_protected_settings_wo_version") {
+				configurationProtectedSettings, err := expandKubernetesClusterExtensionConfigurationProtectedSettingsWriteOnly(metadata.ResourceData)
+				if err != nil {
+					return err
+				}
+				if configurationProtectedSettings == nil {
+					configurationProtectedSettings = &map[string]string{}
+				}
+				properties.Properties.ConfigurationProtectedSettings = configurationProtectedSettings
+			}
+
 			if metadata.ResourceData.HasChange("configuration_settings") {
 				properties.Properties.ConfigurationSettings = &model.ConfigurationSettings
 			}
@@ -369,6 +409,7 @@ func (r KubernetesClusterExtensionResource) Read() sdk.ResourceFunc {
 					}
 
 					state.ConfigurationProtectedSettings = originalModel.ConfigurationProtectedSettings
+					state.ConfigurationProtectedSettingsWoVersion = originalModel.ConfigurationProtectedSettingsWoVersion
 					state.ConfigurationSettings = pointer.From(properties.ConfigurationSettings)
 					state.CurrentVersion = pointer.From(properties.CurrentVersion)
 					state.ExtensionType = pointer.From(properties.ExtensionType)
@@ -469,3 +510,20 @@ func flattenAksAssignedIdentity(input *extensions.ExtensionPropertiesAksAssigned
 
 	return identity.FlattenSystemAssigned(&output)
 }
+
+func expandKubernetesClusterExtensionConfigurationProtectedSettingsWriteOnly(d *pluginsdk.ResourceData) (*map[string]string, error) {
+	value, err := pluginsdk.GetWriteOnly(d, "configuration_protected_settings_wo", cty.String)
+	if err != nil {
+		return nil, err
+	}
+	if value.IsNull() {
+		return nil, nil
+	}
+
+	result := make(map[string]string)
+	if err := json.Unmarshal([]byte(value.AsString()), &result); err != nil {
+		return nil, fmt.Errorf("unmarshaling `configuration_protected_settings_wo`: %+v", err)
+	}
+
+	return &result, nil
+}
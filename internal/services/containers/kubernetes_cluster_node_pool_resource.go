@@ -81,6 +81,10 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("upgrade_settings.0.drain_timeout_in_minutes", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old != 0 && new == 0
 			}),
+			// Spot node pools can't be System node pools, which is checked here since `mode` can be updated
+			func(ctx context.Context, diff *pluginsdk.ResourceDiff, meta interface{}) error {
+				return validateNodePoolSpotMode(diff.Get("priority").(string), diff.Get("mode").(string))
+			},
 		),
 	}
 
@@ -368,6 +372,8 @@ func resourceKubernetesClusterNodePoolSchema() map[string]*pluginsdk.Schema {
 			ValidateFunc: containerValidate.KubernetesAgentPoolName,
 		},
 
+		"rotation_settings": schemaNodePoolRotationSettings(),
+
 		"ultra_ssd_enabled": {
 			Type:     pluginsdk.TypeBool,
 			Default:  false,
@@ -991,46 +997,10 @@ func resourceKubernetesClusterNodePoolUpdate(d *pluginsdk.ResourceData, meta int
 		}
 
 		temporaryNodePoolName := d.Get("temporary_name_for_rotation").(string)
-		tempNodePoolId := agentpools.NewAgentPoolID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName, temporaryNodePoolName)
-
-		tempExisting, err := client.Get(ctx, tempNodePoolId)
-		if !response.WasNotFound(tempExisting.HttpResponse) && err != nil {
-			return fmt.Errorf("checking for existing temporary node pool %s: %+v", tempNodePoolId, err)
-		}
-
-		tempAgentProfile := *existing.Model
-		tempAgentProfile.Name = &temporaryNodePoolName
-
-		if tempAgentProfile.Properties != nil {
-			tempAgentProfile.Properties.NodeImageVersion = nil
-		}
-		if existing.Model != nil && existing.Model.Properties != nil {
-			existing.Model.Properties.NodeImageVersion = nil
-		}
-
-		// if the temp node pool already exists due to a previous failure, don't bother spinning it up.
-		// the temporary nodepool is created with the new values
-		if tempExisting.Model == nil {
-			if err := retryNodePoolCreation(ctx, client, tempNodePoolId, tempAgentProfile); err != nil {
-				return fmt.Errorf("creating temporary %s: %+v", tempNodePoolId, err)
-			}
-		}
-
-		// delete the old node pool if it exists
-		if existing.Model != nil {
-			if err := client.DeleteThenPoll(ctx, *id, agentpools.DefaultDeleteOperationOptions()); err != nil {
-				return fmt.Errorf("deleting old %s: %+v", *id, err)
-			}
-		}
+		rotationSettings := expandNodePoolRotationSettings(d.Get("rotation_settings").([]interface{}))
 
-		// create the new node pool with the new data
-		if err := retryNodePoolCreation(ctx, client, *id, *existing.Model); err != nil {
-			log.Printf("[DEBUG] Creation of redefined node pool failed")
-			return fmt.Errorf("creating default %s: %+v", *id, err)
-		}
-
-		if err := client.DeleteThenPoll(ctx, tempNodePoolId, agentpools.DefaultDeleteOperationOptions()); err != nil {
-			return fmt.Errorf("deleting temporary %s: %+v", tempNodePoolId, err)
+		if err := rotateNodePool(ctx, client, *id, temporaryNodePoolName, *existing.Model, rotationSettings); err != nil {
+			return err
 		}
 
 		log.Printf("[DEBUG] Cycled Node Pool..")
@@ -1073,6 +1043,10 @@ func resourceKubernetesClusterNodePoolRead(d *pluginsdk.ResourceData, meta inter
 	d.Set("name", id.AgentPoolName)
 	d.Set("kubernetes_cluster_id", clusterId.ID())
 
+	// these are pulled from the config/state, since the temporary node pool used for a rotation won't exist once it has succeeded
+	d.Set("temporary_name_for_rotation", d.Get("temporary_name_for_rotation").(string))
+	d.Set("rotation_settings", d.Get("rotation_settings").([]interface{}))
+
 	if model := resp.Model; model != nil && model.Properties != nil {
 		props := model.Properties
 		d.Set("zones", zones.FlattenUntyped(props.AvailabilityZones))
@@ -6,6 +6,7 @@ package containers_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -310,6 +311,28 @@ func TestAccKubernetesClusterNodePool_manualScaleUpdate(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesClusterNodePool_rotationSettings(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_node_pool", "test")
+	r := KubernetesClusterNodePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rotationSettingsConfig(data, "Standard_F2s_v2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("temporary_name_for_rotation", "rotation_settings.#", "rotation_settings.0.%", "rotation_settings.0.max_surge", "rotation_settings.0.drain_timeout_in_minutes", "rotation_settings.0.node_soak_duration_in_minutes", "rotation_settings.0.ignore_pod_disruption_budget_enabled"),
+		{
+			Config: r.rotationSettingsConfig(data, "Standard_F4s_v2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("temporary_name_for_rotation", "rotation_settings.#", "rotation_settings.0.%", "rotation_settings.0.max_surge", "rotation_settings.0.drain_timeout_in_minutes", "rotation_settings.0.node_soak_duration_in_minutes", "rotation_settings.0.ignore_pod_disruption_budget_enabled"),
+	})
+}
+
 func TestAccKubernetesClusterNodePool_manualScaleVMSku(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_node_pool", "test")
 	r := KubernetesClusterNodePoolResource{}
@@ -547,6 +570,37 @@ func TestAccKubernetesClusterNodePool_spot(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesClusterNodePool_spotSystemMode(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_node_pool", "test")
+	r := KubernetesClusterNodePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.spotModeConfig(data, "System"),
+			ExpectError: regexp.MustCompile("`mode` must be set to `User` when `priority` is set to `Spot`"),
+		},
+	})
+}
+
+func TestAccKubernetesClusterNodePool_spotUpdateToSystemMode(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_node_pool", "test")
+	r := KubernetesClusterNodePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.spotModeConfig(data, "User"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:      r.spotModeConfig(data, "System"),
+			ExpectError: regexp.MustCompile("`mode` must be set to `User` when `priority` is set to `Spot`"),
+		},
+	})
+}
+
 func TestAccKubernetesClusterNodePool_upgradeSettings(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_node_pool", "test")
 	r := KubernetesClusterNodePoolResource{}
@@ -1872,6 +1926,34 @@ resource "azurerm_kubernetes_cluster_node_pool" "test" {
 `, r.templateConfig(data), sku)
 }
 
+func (r KubernetesClusterNodePoolResource) rotationSettingsConfig(data acceptance.TestData, sku string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_kubernetes_cluster_node_pool" "test" {
+  name                        = "internal"
+  kubernetes_cluster_id       = azurerm_kubernetes_cluster.test.id
+  vm_size                     = "%s"
+  node_count                  = 2
+  temporary_name_for_rotation = "temporal"
+
+  rotation_settings {
+    max_surge                     = "50%%"
+    drain_timeout_in_minutes      = 30
+    node_soak_duration_in_minutes = 1
+  }
+
+  upgrade_settings {
+    max_surge = "10%%"
+  }
+}
+`, r.templateConfig(data), sku)
+}
+
 func (r KubernetesClusterNodePoolResource) modeSystemConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -2231,6 +2313,33 @@ resource "azurerm_kubernetes_cluster_node_pool" "test" {
 `, r.templateConfig(data))
 }
 
+func (r KubernetesClusterNodePoolResource) spotModeConfig(data acceptance.TestData, mode string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_kubernetes_cluster_node_pool" "test" {
+  name                  = "internal"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  vm_size               = "Standard_DS2_v2"
+  node_count            = 1
+  mode                  = %q
+  priority              = "Spot"
+  eviction_policy       = "Delete"
+  spot_max_price        = 0.5
+  node_labels = {
+    "kubernetes.azure.com/scalesetpriority" = "spot"
+  }
+  node_taints = [
+    "kubernetes.azure.com/scalesetpriority=spot:NoSchedule"
+  ]
+}
+`, r.templateConfig(data), mode)
+}
+
 func (r KubernetesClusterNodePoolResource) upgradeSettings(data acceptance.TestData, drainTimeout int, nodeSoakDuration int) string {
 	template := r.templateConfig(data)
 
@@ -2608,52 +2608,12 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 			}
 
 			temporaryNodePoolName := d.Get("default_node_pool.0.temporary_name_for_rotation").(string)
-			tempNodePoolId := agentpools.NewAgentPoolID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName, temporaryNodePoolName)
+			rotationSettings := expandNodePoolRotationSettings(d.Get("default_node_pool.0.rotation_settings").([]interface{}))
 
-			tempExisting, err := nodePoolsClient.Get(ctx, tempNodePoolId)
-			if !response.WasNotFound(tempExisting.HttpResponse) && err != nil {
-				return fmt.Errorf("checking for existing temporary %s: %+v", tempNodePoolId, err)
-			}
-
-			defaultExisting, err := nodePoolsClient.Get(ctx, defaultNodePoolId)
-			if !response.WasNotFound(defaultExisting.HttpResponse) && err != nil {
-				return fmt.Errorf("checking for existing default %s: %+v", defaultNodePoolId, err)
-			}
-
-			tempAgentProfile := agentProfile
-			tempAgentProfile.Name = &temporaryNodePoolName
-
-			if tempAgentProfile.Properties != nil {
-				tempAgentProfile.Properties.NodeImageVersion = nil
-			}
-			if agentProfile.Properties != nil {
-				agentProfile.Properties.NodeImageVersion = nil
-			}
-
-			// if the temp node pool already exists due to a previous failure, don't bother spinning it up
-			if tempExisting.Model == nil {
-				if err := retryNodePoolCreation(ctx, nodePoolsClient, tempNodePoolId, tempAgentProfile); err != nil {
-					return fmt.Errorf("creating temporary %s: %+v", tempNodePoolId, err)
-				}
-			}
-
-			// delete the old default node pool if it exists
-			if defaultExisting.Model != nil {
-				if err := nodePoolsClient.DeleteThenPoll(ctx, defaultNodePoolId, agentpools.DefaultDeleteOperationOptions()); err != nil {
-					return fmt.Errorf("deleting default %s: %+v", defaultNodePoolId, err)
-				}
-			}
-
-			// create the default node pool with the new vm size
-			if err := retryNodePoolCreation(ctx, nodePoolsClient, defaultNodePoolId, agentProfile); err != nil {
-				// if creation of the default node pool fails we automatically fall back to the temporary node pool
-				// in func findDefaultNodePool
-				log.Printf("[DEBUG] Creation of resized default node pool failed")
-				return fmt.Errorf("creating default %s: %+v", defaultNodePoolId, err)
-			}
-
-			if err := nodePoolsClient.DeleteThenPoll(ctx, tempNodePoolId, agentpools.DefaultDeleteOperationOptions()); err != nil {
-				return fmt.Errorf("deleting temporary %s: %+v", tempNodePoolId, err)
+			// if creation of the rotated default node pool fails we automatically fall back to the temporary node pool
+			// in func findDefaultNodePool
+			if err := rotateNodePool(ctx, nodePoolsClient, defaultNodePoolId, temporaryNodePoolName, agentProfile, rotationSettings); err != nil {
+				return err
 			}
 
 			log.Printf("[DEBUG] Cycled Default Node Pool..")
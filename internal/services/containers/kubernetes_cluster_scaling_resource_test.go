@@ -199,6 +199,28 @@ func TestAccKubernetesCluster_cycleSystemNodePool(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesCluster_cycleSystemNodePoolRotationSettings(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rotationSettings(data, "Standard_D2ads_v5"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("default_node_pool.0.temporary_name_for_rotation", "default_node_pool.0.rotation_settings.#", "default_node_pool.0.rotation_settings.0.%", "default_node_pool.0.rotation_settings.0.max_surge", "default_node_pool.0.rotation_settings.0.drain_timeout_in_minutes", "default_node_pool.0.rotation_settings.0.node_soak_duration_in_minutes", "default_node_pool.0.rotation_settings.0.ignore_pod_disruption_budget_enabled"),
+		{
+			Config: r.rotationSettings(data, "Standard_D4ads_v5"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("default_node_pool.0.temporary_name_for_rotation", "default_node_pool.0.rotation_settings.#", "default_node_pool.0.rotation_settings.0.%", "default_node_pool.0.rotation_settings.0.max_surge", "default_node_pool.0.rotation_settings.0.drain_timeout_in_minutes", "default_node_pool.0.rotation_settings.0.node_soak_duration_in_minutes", "default_node_pool.0.rotation_settings.0.ignore_pod_disruption_budget_enabled"),
+	})
+}
+
 func TestAccKubernetesCluster_cycleSystemNodePoolFipsEnabled(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
 	r := KubernetesClusterResource{}
@@ -836,6 +858,53 @@ resource "azurerm_kubernetes_cluster" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, osDiskType, osDiskSize)
 }
 
+func (KubernetesClusterResource) rotationSettings(data acceptance.TestData, vmSize string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-aks-%d"
+  location = "%s"
+}
+
+resource "azurerm_kubernetes_cluster" "test" {
+  name                = "acctestaks%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  dns_prefix          = "acctestaks%d"
+
+  default_node_pool {
+    name                        = "default"
+    temporary_name_for_rotation = "temp"
+    node_count                  = 2
+    vm_size                     = "%s"
+
+    rotation_settings {
+      max_surge                            = "1"
+      drain_timeout_in_minutes             = 30
+      node_soak_duration_in_minutes        = 1
+      ignore_pod_disruption_budget_enabled = true
+    }
+
+    upgrade_settings {
+      max_surge = "10%%"
+    }
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  network_profile {
+    network_plugin    = "kubenet"
+    load_balancer_sku = "standard"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, vmSize)
+}
+
 func (KubernetesClusterResource) addAgentConfig(data acceptance.TestData, numberOfAgents int) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
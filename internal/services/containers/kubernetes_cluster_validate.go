@@ -312,3 +312,13 @@ func validateNodePoolSupportsVersion(ctx context.Context, client *client.Client,
 
 	return nil
 }
+
+// validateNodePoolSpotMode checks that a Spot node pool isn't a System node pool, which AKS doesn't support - since
+// Spot Virtual Machines can be evicted at any time, System node pools must use Regular priority
+func validateNodePoolSpotMode(priority string, mode string) error {
+	if strings.EqualFold(priority, string(agentpools.ScaleSetPrioritySpot)) && strings.EqualFold(mode, string(agentpools.AgentPoolModeSystem)) {
+		return fmt.Errorf("`mode` must be set to `User` when `priority` is set to `Spot`, since AKS doesn't support Spot Virtual Machines for System node pools")
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"testing"
+)
+
+func TestValidateNodePoolSpotMode(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Priority string
+		Mode     string
+		Error    bool
+	}{
+		{
+			Name:     "Regular System",
+			Priority: "Regular",
+			Mode:     "System",
+		},
+		{
+			Name:     "Regular User",
+			Priority: "Regular",
+			Mode:     "User",
+		},
+		{
+			Name:     "Spot User",
+			Priority: "Spot",
+			Mode:     "User",
+		},
+		{
+			Name:     "Spot System",
+			Priority: "Spot",
+			Mode:     "System",
+			Error:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		err := validateNodePoolSpotMode(tc.Priority, tc.Mode)
+		if tc.Error && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+		if !tc.Error && err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+	}
+}
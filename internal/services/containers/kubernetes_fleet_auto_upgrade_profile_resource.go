@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2024-04-01/fleetupdatestrategies"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kubernetes_fleet_auto_upgrade_profile -service-package-name containers -properties "name" -known-values "subscription_id:data.Subscriptions.Primary"
+
+var (
+	_ sdk.ResourceWithUpdate   = KubernetesFleetAutoUpgradeProfileResource{}
+	_ sdk.ResourceWithIdentity = KubernetesFleetAutoUpgradeProfileResource{}
+)
+
+type KubernetesFleetAutoUpgradeProfileResource struct{}
+
+type KubernetesFleetAutoUpgradeProfileResourceModel struct {
+	Name                     string `tfschema:"name"`
+	KubernetesFleetManagerId string `tfschema:"kubernetes_fleet_manager_id"`
+	Channel                  string `tfschema:"channel"`
+	Enabled                  bool   `tfschema:"enabled"`
+	NodeImageSelectionType   string `tfschema:"node_image_selection_type"`
+	UpdateStrategyId         string `tfschema:"update_strategy_id"`
+}
+
+func (r KubernetesFleetAutoUpgradeProfileResource) ModelObject() interface{} {
+	return &KubernetesFleetAutoUpgradeProfileResourceModel{}
+}
+
+func (r KubernetesFleetAutoUpgradeProfileResource) Identity() resourceids.ResourceId {
+	return &sdkhacks.AutoUpgradeProfileId{}
+}
+
+func (r KubernetesFleetAutoUpgradeProfileResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return sdkhacks.ValidateAutoUpgradeProfileID
+}
+
+func (r KubernetesFleetAutoUpgradeProfileResource) ResourceType() string {
+	return "azurerm_kubernetes_fleet_auto_upgrade_profile"
+}
+
+func (r KubernetesFleetAutoUpgradeProfileResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.KubernetesFleetResourceName,
+		},
+
+		"kubernetes_fleet_manager_id": commonschema.ResourceIDReferenceRequiredForceNew(&commonids.KubernetesFleetId{}),
+
+		"channel": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(sdkhacks.PossibleValuesForUpgradeChannel(), false),
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"node_image_selection_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(sdkhacks.PossibleValuesForAutoUpgradeNodeImageSelectionType(), false),
+		},
+
+		"update_strategy_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: fleetupdatestrategies.ValidateUpdateStrategyID,
+		},
+	}
+}
+
+func (r KubernetesFleetAutoUpgradeProfileResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r KubernetesFleetAutoUpgradeProfileResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetAutoUpgradeProfilesClient
+
+			var config KubernetesFleetAutoUpgradeProfileResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			fleetId, err := commonids.ParseKubernetesFleetID(config.KubernetesFleetManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := sdkhacks.NewAutoUpgradeProfileID(fleetId.SubscriptionId, fleetId.ResourceGroupName, fleetId.FleetName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := sdkhacks.AutoUpgradeProfile{
+				Properties: expandKubernetesFleetAutoUpgradeProfileProperties(config),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetAutoUpgradeProfileResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetAutoUpgradeProfilesClient
+
+			id, err := sdkhacks.ParseAutoUpgradeProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KubernetesFleetAutoUpgradeProfileResourceModel{
+				Name:                     id.AutoUpgradeProfileName,
+				KubernetesFleetManagerId: commonids.NewKubernetesFleetID(id.SubscriptionId, id.ResourceGroupName, id.FleetName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Channel = string(props.Channel)
+					state.Enabled = !pointer.From(props.Disabled)
+
+					if props.NodeImageSelection != nil {
+						state.NodeImageSelectionType = string(props.NodeImageSelection.Type)
+					}
+
+					if v := pointer.From(props.UpdateStrategyId); v != "" {
+						strategyId, err := fleetupdatestrategies.ParseUpdateStrategyIDInsensitively(v)
+						if err != nil {
+							return err
+						}
+						state.UpdateStrategyId = strategyId.ID()
+					}
+				}
+			}
+
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r KubernetesFleetAutoUpgradeProfileResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetAutoUpgradeProfilesClient
+
+			id, err := sdkhacks.ParseAutoUpgradeProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config KubernetesFleetAutoUpgradeProfileResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := *existing.Model
+			payload.Properties = expandKubernetesFleetAutoUpgradeProfileProperties(config)
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r KubernetesFleetAutoUpgradeProfileResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetAutoUpgradeProfilesClient
+
+			id, err := sdkhacks.ParseAutoUpgradeProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandKubernetesFleetAutoUpgradeProfileProperties(input KubernetesFleetAutoUpgradeProfileResourceModel) *sdkhacks.AutoUpgradeProfileProperties {
+	output := &sdkhacks.AutoUpgradeProfileProperties{
+		Channel:  sdkhacks.UpgradeChannel(input.Channel),
+		Disabled: pointer.To(!input.Enabled),
+	}
+
+	if input.NodeImageSelectionType != "" {
+		output.NodeImageSelection = &sdkhacks.AutoUpgradeNodeImageSelection{
+			Type: sdkhacks.AutoUpgradeNodeImageSelectionType(input.NodeImageSelectionType),
+		}
+	}
+
+	if input.UpdateStrategyId != "" {
+		output.UpdateStrategyId = pointer.To(input.UpdateStrategyId)
+	}
+
+	return output
+}
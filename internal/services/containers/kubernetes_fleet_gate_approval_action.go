@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdkhacks"
+)
+
+type KubernetesFleetGateApprovalAction struct {
+	sdk.ActionMetadata
+}
+
+var _ sdk.Action = &KubernetesFleetGateApprovalAction{}
+
+func newKubernetesFleetGateApprovalAction() action.Action {
+	return &KubernetesFleetGateApprovalAction{}
+}
+
+type KubernetesFleetGateApprovalActionModel struct {
+	KubernetesFleetGateId types.String `tfsdk:"kubernetes_fleet_gate_id"`
+}
+
+func (a *KubernetesFleetGateApprovalAction) Schema(_ context.Context, _ action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"kubernetes_fleet_gate_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Kubernetes Fleet Gate to approve.",
+				MarkdownDescription: "The ID of the Kubernetes Fleet Gate to approve.",
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: sdkhacks.ValidateGateID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (a *KubernetesFleetGateApprovalAction) Metadata(_ context.Context, _ action.MetadataRequest, response *action.MetadataResponse) {
+	response.TypeName = "azurerm_kubernetes_fleet_gate_approval"
+}
+
+func (a *KubernetesFleetGateApprovalAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	client := a.Client.Containers.FleetGatesClient
+
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*15)
+	defer cancel()
+
+	model := KubernetesFleetGateApprovalActionModel{}
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &model)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := sdkhacks.ParseGateID(model.KubernetesFleetGateId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "parsing id", err)
+		return
+	}
+
+	existing, err := client.Get(ctx, *id)
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("retrieving %s: %+v", id, err))
+		return
+	}
+
+	if existing.Model != nil && existing.Model.Properties != nil {
+		switch existing.Model.Properties.State {
+		case sdkhacks.GateStateCompleted:
+			response.SendProgress(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("gate %s has already been approved", id.GateName),
+			})
+			return
+
+		case sdkhacks.GateStateSkipped:
+			sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("%s has been skipped and can no longer be approved", id))
+			return
+		}
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("approving gate %s", id.GateName),
+	})
+
+	payload := sdkhacks.GatePatch{
+		Properties: sdkhacks.GatePatchProperties{
+			State: sdkhacks.GateStateCompleted,
+		},
+	}
+
+	if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+		sdk.SetResponseErrorDiagnostic(response, "running action", fmt.Sprintf("approving %s: %+v", id, err))
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("gate %s approved", id.GateName),
+	})
+}
+
+func (a *KubernetesFleetGateApprovalAction) Configure(ctx context.Context, request action.ConfigureRequest, response *action.ConfigureResponse) {
+	a.Defaults(ctx, request, response)
+}
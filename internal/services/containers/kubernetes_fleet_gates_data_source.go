@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2024-04-01/updateruns"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.DataSource = KubernetesFleetGatesDataSource{}
+
+type KubernetesFleetGatesDataSource struct{}
+
+type KubernetesFleetGatesDataSourceModel struct {
+	KubernetesFleetManagerId string                     `tfschema:"kubernetes_fleet_manager_id"`
+	UpdateRunId              string                     `tfschema:"update_run_id"`
+	State                    string                     `tfschema:"state"`
+	Gates                    []KubernetesFleetGateModel `tfschema:"gates"`
+}
+
+type KubernetesFleetGateModel struct {
+	Id          string `tfschema:"id"`
+	Name        string `tfschema:"name"`
+	DisplayName string `tfschema:"display_name"`
+	State       string `tfschema:"state"`
+	UpdateRunId string `tfschema:"update_run_id"`
+	StageName   string `tfschema:"stage_name"`
+	GroupName   string `tfschema:"group_name"`
+	Timing      string `tfschema:"timing"`
+}
+
+func (KubernetesFleetGatesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"kubernetes_fleet_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateKubernetesFleetID,
+		},
+
+		"update_run_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: updateruns.ValidateUpdateRunID,
+		},
+
+		"state": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(sdkhacks.PossibleValuesForGateState(), false),
+		},
+	}
+}
+
+func (KubernetesFleetGatesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"gates": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"display_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"update_run_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"stage_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"group_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"timing": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (KubernetesFleetGatesDataSource) ModelObject() interface{} {
+	return &KubernetesFleetGatesDataSourceModel{}
+}
+
+func (KubernetesFleetGatesDataSource) ResourceType() string {
+	return "azurerm_kubernetes_fleet_gates"
+}
+
+func (KubernetesFleetGatesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.FleetGatesClient
+
+			var state KubernetesFleetGatesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			fleetId, err := commonids.ParseKubernetesFleetID(state.KubernetesFleetManagerId)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ListByFleet(ctx, *fleetId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", fleetId)
+				}
+				return fmt.Errorf("listing Gates for %s: %+v", fleetId, err)
+			}
+
+			gates, err := flattenKubernetesFleetGates(pointer.From(resp.Model), state.UpdateRunId, state.State)
+			if err != nil {
+				return err
+			}
+			state.Gates = gates
+
+			metadata.ResourceData.SetId(fleetId.ID())
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// flattenKubernetesFleetGates flattens the Gates targeting the Update Run `updateRunId` in the state `gateState`, where
+// either filter is omitted Gates aren't filtered on that field
+func flattenKubernetesFleetGates(input []sdkhacks.Gate, updateRunId string, gateState string) ([]KubernetesFleetGateModel, error) {
+	output := make([]KubernetesFleetGateModel, 0)
+
+	for _, gate := range input {
+		id, err := sdkhacks.ParseGateIDInsensitively(pointer.From(gate.Id))
+		if err != nil {
+			return nil, err
+		}
+
+		result := KubernetesFleetGateModel{
+			Id:   id.ID(),
+			Name: id.GateName,
+		}
+
+		if props := gate.Properties; props != nil {
+			result.DisplayName = pointer.From(props.DisplayName)
+			result.State = string(props.State)
+
+			if props.Target.Id != "" {
+				targetId, err := updateruns.ParseUpdateRunIDInsensitively(props.Target.Id)
+				if err != nil {
+					return nil, err
+				}
+				result.UpdateRunId = targetId.ID()
+			}
+
+			if target := props.Target.UpdateRunProperties; target != nil {
+				result.StageName = pointer.From(target.Stage)
+				result.GroupName = pointer.From(target.Group)
+				result.Timing = string(target.Timing)
+			}
+		}
+
+		if updateRunId != "" && !strings.EqualFold(result.UpdateRunId, updateRunId) {
+			continue
+		}
+
+		if gateState != "" && !strings.EqualFold(result.State, gateState) {
+			continue
+		}
+
+		output = append(output, result)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type KubernetesFleetGatesDataSource struct{}
+
+func TestAccKubernetesFleetGatesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_kubernetes_fleet_gates", "test")
+	d := KubernetesFleetGatesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			// Gates are only created by Update Runs whose Strategy defines them, so a new Fleet has none
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("gates.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (KubernetesFleetGatesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_kubernetes_fleet_gates" "test" {
+  kubernetes_fleet_manager_id = azurerm_kubernetes_fleet_manager.test.id
+  state                       = "Pending"
+}
+`, KubernetesFleetAutoUpgradeProfileResource{}.template(data))
+}
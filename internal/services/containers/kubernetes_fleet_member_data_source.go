@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2024-04-01/fleetmembers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.DataSource = KubernetesFleetMemberDataSource{}
+
+type KubernetesFleetMemberDataSource struct{}
+
+type KubernetesFleetMemberDataSourceModel struct {
+	Group               string `tfschema:"group"`
+	KubernetesClusterId string `tfschema:"kubernetes_cluster_id"`
+	KubernetesFleetId   string `tfschema:"kubernetes_fleet_id"`
+	Name                string `tfschema:"name"`
+}
+
+func (KubernetesFleetMemberDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"kubernetes_fleet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateKubernetesFleetID,
+		},
+	}
+}
+
+func (KubernetesFleetMemberDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"group": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"kubernetes_cluster_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (KubernetesFleetMemberDataSource) ModelObject() interface{} {
+	return &KubernetesFleetMemberDataSourceModel{}
+}
+
+func (KubernetesFleetMemberDataSource) ResourceType() string {
+	return "azurerm_kubernetes_fleet_member"
+}
+
+func (KubernetesFleetMemberDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerService.V20240401.FleetMembers
+
+			var state KubernetesFleetMemberDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			fleetId, err := commonids.ParseKubernetesFleetID(state.KubernetesFleetId)
+			if err != nil {
+				return err
+			}
+
+			id := fleetmembers.NewMemberID(fleetId.SubscriptionId, fleetId.ResourceGroupName, fleetId.FleetName, state.Name)
+
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Group = pointer.From(props.Group)
+					state.KubernetesClusterId = props.ClusterResourceId
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
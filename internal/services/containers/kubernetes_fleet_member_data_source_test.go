@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type KubernetesFleetMemberDataSource struct{}
+
+func TestAccKubernetesFleetMemberDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_kubernetes_fleet_member", "test")
+	d := KubernetesFleetMemberDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("kubernetes_cluster_id").IsNotEmpty(),
+				check.That(data.ResourceName).Key("group").HasValue(fmt.Sprintf("val-%s", data.RandomString)),
+			),
+		},
+	})
+}
+
+func (KubernetesFleetMemberDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_kubernetes_fleet_member" "test" {
+  name                = azurerm_kubernetes_fleet_member.test.name
+  kubernetes_fleet_id = azurerm_kubernetes_fleet_member.test.kubernetes_fleet_id
+}
+`, KubernetesFleetMemberTestResource{}.complete(data))
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2024-04-01/fleetmembers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name kubernetes_fleet_member -test-resource-type KubernetesFleetMemberTestResource -service-package-name containers -properties "name" -known-values "subscription_id:data.Subscriptions.Primary"
+
+// NOTE: `azurerm_kubernetes_fleet_member` is generated (see `kubernetes_fleet_member_resource_gen.go`), as such the
+// Resource Identity is defined here so that it's retained when the resource is regenerated. Since the generated Read
+// function doesn't set the Resource Identity data, the resource is registered as kubernetesFleetMemberResourceWithIdentity
+// which sets this once the generated Read function has run.
+
+var (
+	_ sdk.ResourceWithIdentity = KubernetesFleetMemberResource{}
+	_ sdk.ResourceWithUpdate   = kubernetesFleetMemberResourceWithIdentity{}
+	_ sdk.ResourceWithIdentity = kubernetesFleetMemberResourceWithIdentity{}
+)
+
+func (r KubernetesFleetMemberResource) Identity() resourceids.ResourceId {
+	return &fleetmembers.MemberId{}
+}
+
+// kubernetesFleetMemberResourceWithIdentity wraps the generated resource to set the Resource Identity data
+type kubernetesFleetMemberResourceWithIdentity struct {
+	KubernetesFleetMemberResource
+}
+
+func (r kubernetesFleetMemberResourceWithIdentity) Read() sdk.ResourceFunc {
+	return withKubernetesFleetMemberIdentity(r.KubernetesFleetMemberResource.Read())
+}
+
+// withKubernetesFleetMemberIdentity returns the Read function, setting the Resource Identity data from the Resource ID
+// once this has run - unless the Fleet Member was removed from the state
+func withKubernetesFleetMemberIdentity(read sdk.ResourceFunc) sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: read.Timeout,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if err := read.Func(ctx, metadata); err != nil {
+				return err
+			}
+
+			if metadata.ResourceData.Id() == "" {
+				return nil
+			}
+
+			id, err := fleetmembers.ParseMemberID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			return pluginsdk.SetResourceIdentityData(metadata.ResourceData, id)
+		},
+	}
+}
+
+// flatten sets the state and Resource Identity for the Fleet Member, using the mappings from the generated resource
+func (r KubernetesFleetMemberResource) flatten(metadata sdk.ResourceMetaData, id fleetmembers.MemberId, model *fleetmembers.FleetMember) error {
+	state := KubernetesFleetMemberResourceSchema{
+		Name:              id.MemberName,
+		KubernetesFleetId: commonids.NewKubernetesFleetID(id.SubscriptionId, id.ResourceGroupName, id.FleetName).ID(),
+	}
+
+	if model != nil {
+		if err := r.mapFleetMemberToKubernetesFleetMemberResourceSchema(*model, &state); err != nil {
+			return err
+		}
+	}
+
+	if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, &id); err != nil {
+		return err
+	}
+
+	return metadata.Encode(&state)
+}
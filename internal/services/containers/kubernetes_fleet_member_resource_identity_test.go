@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2024-04-01/fleetmembers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+func TestWithKubernetesFleetMemberIdentity(t *testing.T) {
+	id := fleetmembers.NewMemberID("00000000-0000-0000-0000-000000000000", "example-rg", "example-fleet", "example-member")
+
+	testCases := []struct {
+		Name     string
+		Gone     bool
+		Expected map[string]string
+	}{
+		{
+			Name: "read",
+			Expected: map[string]string{
+				"subscription_id":     id.SubscriptionId,
+				"resource_group_name": id.ResourceGroupName,
+				"fleet_name":          id.FleetName,
+				"name":                id.MemberName,
+			},
+		},
+		{
+			Name: "removed from state",
+			Gone: true,
+			Expected: map[string]string{
+				"subscription_id":     "",
+				"resource_group_name": "",
+				"fleet_name":          "",
+				"name":                "",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		wrapper := sdk.NewResourceWrapper(kubernetesFleetMemberResourceWithIdentity{})
+		resource, err := wrapper.Resource()
+		if err != nil {
+			t.Fatalf("building resource: %+v", err)
+		}
+
+		d := resource.Data(&terraform.InstanceState{})
+		d.SetId(id.ID())
+
+		// the generated Read function doesn't set the Resource Identity data
+		read := withKubernetesFleetMemberIdentity(sdk.ResourceFunc{
+			Timeout: 5 * time.Minute,
+			Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+				if tc.Gone {
+					metadata.ResourceData.SetId("")
+				}
+				return nil
+			},
+		})
+		if err := read.Func(context.Background(), sdk.ResourceMetaData{ResourceData: d}); err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		identity, err := d.Identity()
+		if err != nil {
+			t.Fatalf("getting identity: %+v", err)
+		}
+
+		for key, value := range tc.Expected {
+			if actual := identity.Get(key); actual != value {
+				t.Fatalf("expected the identity %q to be %q but got %q", key, value, actual)
+			}
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2024-04-01/fleetmembers"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.ListResourceWithRawV5Schemas = &KubernetesFleetMemberListResource{}
+
+type KubernetesFleetMemberListResource struct {
+	sdk.ListResourceMetadata
+}
+
+type KubernetesFleetMemberListModel struct {
+	KubernetesFleetId types.String `tfsdk:"kubernetes_fleet_id"`
+}
+
+func NewKubernetesFleetMemberListResource() list.ListResource {
+	return &KubernetesFleetMemberListResource{}
+}
+
+func (r *KubernetesFleetMemberListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = KubernetesFleetMemberResource{}.ResourceType()
+}
+
+func (r *KubernetesFleetMemberListResource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	res := kubernetesFleetMemberPluginSdkResource()
+	response.ProtoV5Schema = res.ProtoSchema(ctx)()
+	response.ProtoV5IdentitySchema = res.ProtoIdentitySchema(ctx)()
+}
+
+func (r *KubernetesFleetMemberListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"kubernetes_fleet_id": listschema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: commonids.ValidateKubernetesFleetID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *KubernetesFleetMemberListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	client := r.Client.ContainerService.V20240401.FleetMembers
+
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*60)
+	defer cancel()
+
+	var data KubernetesFleetMemberListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	fleetId, err := commonids.ParseKubernetesFleetID(data.KubernetesFleetId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, "parsing kubernetes fleet id", err)
+		return
+	}
+
+	resp, err := client.ListByFleetComplete(ctx, fleetmembers.NewFleetID(fleetId.SubscriptionId, fleetId.ResourceGroupName, fleetId.FleetName))
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing %s", KubernetesFleetMemberResource{}.ResourceType()), err)
+		return
+	}
+
+	res := kubernetesFleetMemberPluginSdkResource()
+	listResults := resp.Items
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
+		defer cancel()
+		for _, member := range listResults {
+			result := request.NewListResult(ctx)
+			result.DisplayName = pointer.From(member.Name)
+
+			id, err := fleetmembers.ParseMemberIDInsensitively(pointer.From(member.Id))
+			if err != nil {
+				sdk.SetResponseErrorDiagnostic(stream, "parsing kubernetes fleet member id", err)
+				return
+			}
+
+			rd := res.Data(&terraform.InstanceState{})
+
+			rd.SetId(id.ID())
+
+			if err := (KubernetesFleetMemberResource{}).flatten(r.ResourceMetaData(rd), *id, pointer.To(member)); err != nil {
+				sdk.SetResponseErrorDiagnostic(stream, "encoding resource data", err)
+				return
+			}
+
+			tfTypeIdentity, err := rd.TfTypeIdentityState()
+			if err != nil {
+				sdk.SetResponseErrorDiagnostic(stream, "converting Identity State", err)
+				return
+			}
+
+			if err := result.Identity.Set(ctx, *tfTypeIdentity); err != nil {
+				sdk.SetResponseErrorDiagnostic(stream, "setting identity data", err)
+				return
+			}
+
+			tfTypeResource, err := rd.TfTypeResourceState()
+			if err != nil {
+				sdk.SetResponseErrorDiagnostic(stream, "converting Resource State data", err)
+				return
+			}
+
+			if err := result.Resource.Set(ctx, *tfTypeResource); err != nil {
+				sdk.SetResponseErrorDiagnostic(stream, "setting resource data", err)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// kubernetesFleetMemberPluginSdkResource returns the Plugin SDK representation of the Typed Resource, the wrapper only
+// errors when the Resource is misconfigured, which would already have failed when the Provider was instantiated
+func kubernetesFleetMemberPluginSdkResource() *pluginsdk.Resource {
+	wrapper := sdk.NewResourceWrapper(KubernetesFleetMemberResource{})
+	res, err := wrapper.Resource()
+	if err != nil {
+		panic(fmt.Errorf("creating Wrapper for Resource %q: %+v", KubernetesFleetMemberResource{}.ResourceType(), err))
+	}
+
+	return res
+}
+
+func (r *KubernetesFleetMemberListResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	r.Defaults(request, response)
+}
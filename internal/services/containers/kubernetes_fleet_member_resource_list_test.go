@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestAccKubernetesFleetMember_list_basic(t *testing.T) {
+	r := KubernetesFleetMemberTestResource{}
+
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_fleet_member", "test")
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basic(data),
+			},
+			{
+				Query:             true,
+				Config:            r.basicList_query(data),
+				ConfigQueryChecks: []querycheck.QueryCheck{
+					// TODO - Testing not currently functional
+					// querycheck.ExpectIdentityValue("azurerm_kubernetes_fleet_member.test", tfjsonpath.New("subscription_id"), knownvalue.StringExact(data.Subscriptions.Primary)),
+					// querycheck.ExpectIdentityValue("azurerm_kubernetes_fleet_member.test", tfjsonpath.New("name"), knownvalue.StringExact(fmt.Sprintf("acctestkfm-%s", data.RandomString))),
+				},
+			},
+		},
+	})
+}
+
+func (r KubernetesFleetMemberTestResource) basicList_query(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+list "azurerm_kubernetes_fleet_member" "test" {
+  provider = azurerm
+
+  config {
+    kubernetes_fleet_id = "/subscriptions/%s/resourceGroups/acctestrg-%d/providers/Microsoft.ContainerService/fleets/acctestkfm%s"
+  }
+}
+`, data.Subscriptions.Primary, data.RandomInteger, data.RandomString)
+}
@@ -53,6 +53,8 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 						ValidateFunc: validate.KubernetesAgentPoolName,
 					},
 
+					"rotation_settings": schemaNodePoolRotationSettings(),
+
 					"type": {
 						Type:     pluginsdk.TypeString,
 						Optional: true,
@@ -855,9 +857,11 @@ func ExpandDefaultNodePool(d *pluginsdk.ResourceData) (*[]managedclusters.Manage
 
 		UpgradeSettings: expandClusterNodePoolUpgradeSettings(raw["upgrade_settings"].([]interface{})),
 
-		// // TODO: support these in time
-		// ScaleSetEvictionPolicy: "",
-		// ScaleSetPriority:       "",
+		// NOTE: `ScaleSetPriority`, `ScaleSetEvictionPolicy` and `SpotMaxPrice` aren't exposed for the default node pool
+		// due to a platform limitation: the default node pool is always a System node pool (see `Mode` above) and AKS
+		// doesn't support Spot Virtual Machines for System node pools, since these can be evicted at any time - see
+		// https://learn.microsoft.com/azure/aks/spot-node-pool#limitations. Spot capacity can instead be added using a
+		// User node pool via the `azurerm_kubernetes_cluster_node_pool` resource, see `validateNodePoolSpotMode`.
 	}
 
 	zones := zones.ExpandUntyped(raw["zones"].(*schema.Set).List())
@@ -1251,6 +1255,7 @@ func FlattenDefaultNodePool(input *[]managedclusters.ManagedClusterAgentPoolProf
 
 	// we pull this from the config, since the temporary node pool for cycling the system node pool won't exist if the operation is successful
 	temporaryName := d.Get("default_node_pool.0.temporary_name_for_rotation").(string)
+	rotationSettings := d.Get("default_node_pool.0.rotation_settings").([]interface{})
 
 	var nodeLabels map[string]string
 	if agentPool.NodeLabels != nil {
@@ -1387,6 +1392,7 @@ func FlattenDefaultNodePool(input *[]managedclusters.ManagedClusterAgentPoolProf
 		"snapshot_id":                   snapshotId,
 		"tags":                          tags.Flatten(agentPool.Tags),
 		"temporary_name_for_rotation":   temporaryName,
+		"rotation_settings":             rotationSettings,
 		"type":                          agentPoolType,
 		"ultra_ssd_enabled":             enableUltraSSD,
 		"vm_size":                       vmSize,
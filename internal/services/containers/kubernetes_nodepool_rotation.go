@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2025-05-01/agentpools"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type nodePoolRotationSettings struct {
+	MaxSurge                  string
+	DrainTimeoutInMinutes     int
+	NodeSoakDurationInMinutes int
+	IgnorePodDisruptionBudget bool
+}
+
+func schemaNodePoolRotationSettings() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"max_surge": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringMatch(
+						regexp.MustCompile(`^([1-9][0-9]*|([1-9][0-9]?|100)%)$`),
+						"`max_surge` must be either a positive number of nodes or a percentage between `1%` and `100%`",
+					),
+				},
+
+				"drain_timeout_in_minutes": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 1440),
+				},
+
+				"node_soak_duration_in_minutes": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntBetween(0, 30),
+				},
+
+				"ignore_pod_disruption_budget_enabled": {
+					Type:     pluginsdk.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+			},
+		},
+	}
+}
+
+func expandNodePoolRotationSettings(input []interface{}) nodePoolRotationSettings {
+	if len(input) == 0 || input[0] == nil {
+		return nodePoolRotationSettings{}
+	}
+
+	raw := input[0].(map[string]interface{})
+	return nodePoolRotationSettings{
+		MaxSurge:                  raw["max_surge"].(string),
+		DrainTimeoutInMinutes:     raw["drain_timeout_in_minutes"].(int),
+		NodeSoakDurationInMinutes: raw["node_soak_duration_in_minutes"].(int),
+		IgnorePodDisruptionBudget: raw["ignore_pod_disruption_budget_enabled"].(bool),
+	}
+}
+
+// rotateNodePool performs a blue/green rotation of the node pool `id` onto the configuration defined in `profile`.
+// A temporary node pool is provisioned with the new configuration, after which the existing node pool is cordoned,
+// drained and deleted by the AKS API. The node pool is then recreated with the new configuration and the temporary
+// node pool is drained and deleted in turn. Should a previous rotation have failed part way through, the existing
+// temporary node pool is reused.
+func rotateNodePool(ctx context.Context, client *agentpools.AgentPoolsClient, id agentpools.AgentPoolId, temporaryNodePoolName string, profile agentpools.AgentPool, settings nodePoolRotationSettings) error {
+	tempNodePoolId := agentpools.NewAgentPoolID(id.SubscriptionId, id.ResourceGroupName, id.ManagedClusterName, temporaryNodePoolName)
+
+	tempExisting, err := client.Get(ctx, tempNodePoolId)
+	if !response.WasNotFound(tempExisting.HttpResponse) && err != nil {
+		return fmt.Errorf("checking for existing temporary %s: %+v", tempNodePoolId, err)
+	}
+
+	existing, err := client.Get(ctx, id)
+	if !response.WasNotFound(existing.HttpResponse) && err != nil {
+		return fmt.Errorf("checking for existing %s: %+v", id, err)
+	}
+
+	if profile.Properties != nil {
+		profile.Properties.NodeImageVersion = nil
+	}
+
+	tempProfile, err := temporaryNodePoolProfile(temporaryNodePoolName, profile, settings)
+	if err != nil {
+		return err
+	}
+
+	// if the temp node pool already exists due to a previous failure, don't bother spinning it up
+	if tempExisting.Model == nil {
+		if err := retryNodePoolCreation(ctx, client, tempNodePoolId, tempProfile); err != nil {
+			return fmt.Errorf("creating temporary %s: %+v", tempNodePoolId, err)
+		}
+
+		if err := settings.soak(ctx, tempNodePoolId); err != nil {
+			return err
+		}
+	}
+
+	if existing.Model != nil {
+		if err := settings.drainAndDelete(ctx, client, id); err != nil {
+			return err
+		}
+	}
+
+	if err := retryNodePoolCreation(ctx, client, id, profile); err != nil {
+		// the temporary node pool is intentionally left in place so that workloads continue to be scheduled
+		log.Printf("[DEBUG] Creation of rotated %s failed", id)
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	if err := settings.soak(ctx, id); err != nil {
+		return err
+	}
+
+	return settings.drainAndDelete(ctx, client, tempNodePoolId)
+}
+
+// soak waits for the configured duration after a node pool has been provisioned, allowing workloads to be scheduled
+// onto it before the node pool it's replacing is drained
+func (s nodePoolRotationSettings) soak(ctx context.Context, id agentpools.AgentPoolId) error {
+	if s.NodeSoakDurationInMinutes == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Waiting %d minute(s) for workloads to be scheduled onto %s..", s.NodeSoakDurationInMinutes, id)
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("waiting for workloads to be scheduled onto %s: %+v", id, ctx.Err())
+	case <-time.After(time.Duration(s.NodeSoakDurationInMinutes) * time.Minute):
+		return nil
+	}
+}
+
+// drainAndDelete deletes the node pool `id`, during which the AKS API cordons and drains each node, honouring any Pod
+// Disruption Budgets unless configured otherwise. The AKS API doesn't support a drain timeout when deleting a node
+// pool, as such `drain_timeout_in_minutes` is enforced client-side by only waiting for the deletion for that long.
+func (s nodePoolRotationSettings) drainAndDelete(ctx context.Context, client *agentpools.AgentPoolsClient, id agentpools.AgentPoolId) error {
+	options := agentpools.DefaultDeleteOperationOptions()
+	if s.IgnorePodDisruptionBudget {
+		options.IgnorePodDisruptionBudget = pointer.To(true)
+	}
+
+	deleteCtx := ctx
+	if s.DrainTimeoutInMinutes > 0 {
+		var cancel context.CancelFunc
+		deleteCtx, cancel = context.WithTimeout(ctx, time.Duration(s.DrainTimeoutInMinutes)*time.Minute)
+		defer cancel()
+	}
+
+	if err := client.DeleteThenPoll(deleteCtx, id, options); err != nil {
+		if errors.Is(deleteCtx.Err(), context.DeadlineExceeded) && ctx.Err() == nil {
+			return fmt.Errorf("%s was not drained and deleted within %d minute(s), the deletion may still be in progress: %+v", id, s.DrainTimeoutInMinutes, err)
+		}
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// temporaryNodePoolProfile returns the profile for the temporary node pool used during a rotation. When `max_surge`
+// is specified the temporary node pool is provisioned with the surge capacity and auto-scaling is enabled up to the
+// size of the node pool being replaced, so that every workload evicted from it during the drain can be scheduled
+func temporaryNodePoolProfile(name string, profile agentpools.AgentPool, settings nodePoolRotationSettings) (agentpools.AgentPool, error) {
+	tempProfile := profile
+	tempProfile.Name = pointer.To(name)
+	if profile.Properties == nil {
+		return tempProfile, nil
+	}
+
+	tempProperties := *profile.Properties
+	tempProfile.Properties = &tempProperties
+
+	if settings.MaxSurge == "" {
+		return tempProfile, nil
+	}
+
+	nodeCount := pointer.From(profile.Properties.Count)
+	if pointer.From(profile.Properties.EnableAutoScaling) {
+		nodeCount = pointer.From(profile.Properties.MaxCount)
+	}
+
+	surgeCount, err := nodePoolSurgeCount(settings.MaxSurge, nodeCount)
+	if err != nil {
+		return tempProfile, err
+	}
+
+	tempProfile.Properties.Count = pointer.To(surgeCount)
+	tempProfile.Properties.EnableAutoScaling = pointer.To(true)
+	tempProfile.Properties.MinCount = pointer.To(surgeCount)
+	tempProfile.Properties.MaxCount = pointer.To(max(nodeCount, surgeCount))
+
+	return tempProfile, nil
+}
+
+func nodePoolSurgeCount(maxSurge string, nodeCount int64) (int64, error) {
+	if v, ok := strings.CutSuffix(maxSurge, "%"); ok {
+		percentage, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return 0, fmt.Errorf("parsing `max_surge` %q: %+v", maxSurge, err)
+		}
+
+		return max(int64(math.Ceil(float64(nodeCount)*percentage/100)), 1), nil
+	}
+
+	count, err := strconv.ParseInt(maxSurge, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parsing `max_surge` %q: %+v", maxSurge, err)
+	}
+
+	return count, nil
+}
@@ -4,6 +4,9 @@
 package containers
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
@@ -13,8 +16,9 @@ type Registration struct {
 }
 
 var (
-	_ sdk.TypedServiceRegistration   = Registration{}
-	_ sdk.UntypedServiceRegistration = Registration{}
+	_ sdk.TypedServiceRegistration     = Registration{}
+	_ sdk.UntypedServiceRegistration   = Registration{}
+	_ sdk.FrameworkServiceRegistration = Registration{}
 )
 
 // Name is the name of this Service
@@ -61,7 +65,9 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 func (r Registration) DataSources() []sdk.DataSource {
 	dataSources := []sdk.DataSource{
 		ContainerRegistryCacheRuleDataSource{},
+		KubernetesFleetGatesDataSource{},
 		KubernetesFleetManagerDataSource{},
+		KubernetesFleetMemberDataSource{},
 		KubernetesNodePoolSnapshotDataSource{},
 	}
 	dataSources = append(dataSources, r.autoRegistration.DataSources()...)
@@ -74,14 +80,48 @@ func (r Registration) Resources() []sdk.Resource {
 		ContainerRegistryCacheRule{},
 		ContainerRegistryTaskResource{},
 		ContainerRegistryCredentialSetResource{},
+		ContainerRegistryRetentionPolicyResource{},
 		ContainerRegistryTaskScheduleResource{},
 		ContainerRegistryTokenPasswordResource{},
 		KubernetesClusterExtensionResource{},
+		KubernetesFleetAutoUpgradeProfileResource{},
 		KubernetesFleetManagerResource{},
 		KubernetesFleetUpdateRunResource{},
 		KubernetesFleetUpdateStrategyResource{},
 		KubernetesFluxConfigurationResource{},
 	}
-	resources = append(resources, r.autoRegistration.Resources()...)
+	for _, resource := range r.autoRegistration.Resources() {
+		// the generated Fleet Member resource is wrapped so that the Resource Identity data is set when it's read
+		if v, ok := resource.(KubernetesFleetMemberResource); ok {
+			resource = kubernetesFleetMemberResourceWithIdentity{v}
+		}
+		resources = append(resources, resource)
+	}
 	return resources
 }
+
+func (r Registration) Actions() []func() action.Action {
+	return []func() action.Action{
+		newContainerRegistryImportImageAction,
+		newContainerRegistryTaskRunAction,
+		newKubernetesFleetGateApprovalAction,
+	}
+}
+
+func (r Registration) FrameworkResources() []sdk.FrameworkWrappedResource {
+	return []sdk.FrameworkWrappedResource{}
+}
+
+func (r Registration) FrameworkDataSources() []sdk.FrameworkWrappedDataSource {
+	return []sdk.FrameworkWrappedDataSource{}
+}
+
+func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{}
+}
+
+func (r Registration) ListResources() []func() list.ListResource {
+	return []func() list.ListResource{
+		NewKubernetesFleetMemberListResource,
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkhacks
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// Fleet Auto Upgrade Profiles were introduced in API Version `2025-03-01` and Fleet Gates in API Version
+// `2025-04-01-preview`, neither of which are available in the version of `hashicorp/go-azure-sdk` in use, as such
+// these clients are hand-written against the Swagger.
+// TODO: remove once these are available in the SDK.
+const (
+	autoUpgradeProfilesApiVersion = "2025-03-01"
+	gatesApiVersion               = "2025-04-01-preview"
+)
+
+type AutoUpgradeProfilesClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewAutoUpgradeProfilesClientWithBaseURI(sdkApi sdkEnv.Api) (*AutoUpgradeProfilesClient, error) {
+	client, err := resourcemanager.NewClient(sdkApi, "autoupgradeprofiles", autoUpgradeProfilesApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating AutoUpgradeProfilesClient: %+v", err)
+	}
+
+	return &AutoUpgradeProfilesClient{
+		Client: client,
+	}, nil
+}
+
+type GatesClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewGatesClientWithBaseURI(sdkApi sdkEnv.Api) (*GatesClient, error) {
+	client, err := resourcemanager.NewClient(sdkApi, "gates", gatesApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating GatesClient: %+v", err)
+	}
+
+	return &GatesClient{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkhacks
+
+type AutoUpgradeNodeImageSelectionType string
+
+const (
+	AutoUpgradeNodeImageSelectionTypeConsistent AutoUpgradeNodeImageSelectionType = "Consistent"
+	AutoUpgradeNodeImageSelectionTypeLatest     AutoUpgradeNodeImageSelectionType = "Latest"
+)
+
+func PossibleValuesForAutoUpgradeNodeImageSelectionType() []string {
+	return []string{
+		string(AutoUpgradeNodeImageSelectionTypeConsistent),
+		string(AutoUpgradeNodeImageSelectionTypeLatest),
+	}
+}
+
+type UpgradeChannel string
+
+const (
+	UpgradeChannelNodeImage UpgradeChannel = "NodeImage"
+	UpgradeChannelRapid     UpgradeChannel = "Rapid"
+	UpgradeChannelStable    UpgradeChannel = "Stable"
+)
+
+func PossibleValuesForUpgradeChannel() []string {
+	return []string{
+		string(UpgradeChannelNodeImage),
+		string(UpgradeChannelRapid),
+		string(UpgradeChannelStable),
+	}
+}
+
+type GateState string
+
+const (
+	GateStateCompleted GateState = "Completed"
+	GateStatePending   GateState = "Pending"
+	GateStateSkipped   GateState = "Skipped"
+)
+
+func PossibleValuesForGateState() []string {
+	return []string{
+		string(GateStateCompleted),
+		string(GateStatePending),
+		string(GateStateSkipped),
+	}
+}
+
+type GateType string
+
+const (
+	GateTypeApproval GateType = "Approval"
+)
+
+type Timing string
+
+const (
+	TimingAfter  Timing = "After"
+	TimingBefore Timing = "Before"
+)
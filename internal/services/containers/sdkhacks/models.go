@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkhacks
+
+type AutoUpgradeProfile struct {
+	ETag       *string                       `json:"eTag,omitempty"`
+	Id         *string                       `json:"id,omitempty"`
+	Name       *string                       `json:"name,omitempty"`
+	Properties *AutoUpgradeProfileProperties `json:"properties,omitempty"`
+	Type       *string                       `json:"type,omitempty"`
+}
+
+type AutoUpgradeProfileProperties struct {
+	Channel            UpgradeChannel                 `json:"channel"`
+	Disabled           *bool                          `json:"disabled,omitempty"`
+	NodeImageSelection *AutoUpgradeNodeImageSelection `json:"nodeImageSelection,omitempty"`
+	ProvisioningState  *string                        `json:"provisioningState,omitempty"`
+	UpdateStrategyId   *string                        `json:"updateStrategyId,omitempty"`
+}
+
+type AutoUpgradeNodeImageSelection struct {
+	Type AutoUpgradeNodeImageSelectionType `json:"type"`
+}
+
+type Gate struct {
+	ETag       *string         `json:"eTag,omitempty"`
+	Id         *string         `json:"id,omitempty"`
+	Name       *string         `json:"name,omitempty"`
+	Properties *GateProperties `json:"properties,omitempty"`
+	Type       *string         `json:"type,omitempty"`
+}
+
+type GateProperties struct {
+	DisplayName       *string    `json:"displayName,omitempty"`
+	GateType          GateType   `json:"gateType"`
+	ProvisioningState *string    `json:"provisioningState,omitempty"`
+	State             GateState  `json:"state"`
+	Target            GateTarget `json:"target"`
+}
+
+type GateTarget struct {
+	Id                  string                         `json:"id"`
+	UpdateRunProperties *UpdateRunGateTargetProperties `json:"updateRunProperties,omitempty"`
+}
+
+type UpdateRunGateTargetProperties struct {
+	Group  *string `json:"group,omitempty"`
+	Name   *string `json:"name,omitempty"`
+	Stage  *string `json:"stage,omitempty"`
+	Timing Timing  `json:"timing"`
+}
+
+type GatePatch struct {
+	Properties GatePatchProperties `json:"properties"`
+}
+
+type GatePatchProperties struct {
+	State GateState `json:"state"`
+}
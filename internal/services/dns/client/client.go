@@ -4,17 +4,45 @@
 package client
 
 import (
+	"fmt"
+
 	dns_v2018_05_01 "github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/sdkhacks"
 )
 
-func NewClient(o *common.ClientOptions) (*dns_v2018_05_01.Client, error) {
+type Client struct {
+	*dns_v2018_05_01.Client
+
+	// DNSSEC and the DS, NAPTR and TLSA Record Types are only available in the preview API `2023-07-01-preview`
+	DnssecConfigs     *sdkhacks.DnssecConfigsClient
+	PreviewRecordSets *sdkhacks.RecordSetsClient
+}
+
+func NewClient(o *common.ClientOptions) (*Client, error) {
 	client, err := dns_v2018_05_01.NewClientWithBaseURI(o.Environment.ResourceManager, func(c *resourcemanager.Client) {
 		o.Configure(c, o.Authorizers.ResourceManager)
 	})
 	if err != nil {
 		return nil, err
 	}
-	return client, nil
+
+	dnssecConfigsClient, err := sdkhacks.NewDnssecConfigsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building DnssecConfigs client: %+v", err)
+	}
+	o.Configure(dnssecConfigsClient.Client, o.Authorizers.ResourceManager)
+
+	previewRecordSetsClient, err := sdkhacks.NewRecordSetsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building RecordSets client: %+v", err)
+	}
+	o.Configure(previewRecordSetsClient.Client, o.Authorizers.ResourceManager)
+
+	return &Client{
+		Client:            client,
+		DnssecConfigs:     dnssecConfigsClient,
+		PreviewRecordSets: previewRecordSetsClient,
+	}, nil
 }
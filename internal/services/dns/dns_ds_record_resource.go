@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/sdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = DnsDsRecordResource{}
+
+type DnsDsRecordResource struct{}
+
+type DnsDsRecordResourceModel struct {
+	Name              string                   `tfschema:"name"`
+	ResourceGroupName string                   `tfschema:"resource_group_name"`
+	ZoneName          string                   `tfschema:"zone_name"`
+	Record            []DnsDsRecordRecordModel `tfschema:"record"`
+	Ttl               int64                    `tfschema:"ttl"`
+	Fqdn              string                   `tfschema:"fqdn"`
+	Tags              map[string]string        `tfschema:"tags"`
+}
+
+type DnsDsRecordRecordModel struct {
+	Algorithm   int64  `tfschema:"algorithm"`
+	DigestType  int64  `tfschema:"digest_type"`
+	DigestValue string `tfschema:"digest_value"`
+	KeyTag      int64  `tfschema:"key_tag"`
+}
+
+func (DnsDsRecordResource) ResourceType() string {
+	return "azurerm_dns_ds_record"
+}
+
+func (DnsDsRecordResource) ModelObject() interface{} {
+	return &DnsDsRecordResourceModel{}
+}
+
+func (DnsDsRecordResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return sdkhacks.ValidateRecordSetIDForRecordType(sdkhacks.RecordTypeDS)
+}
+
+func (DnsDsRecordResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"zone_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"record": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"algorithm": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 255),
+					},
+
+					"digest_type": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 255),
+					},
+
+					"digest_value": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"key_tag": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 65535),
+					},
+				},
+			},
+		},
+
+		"ttl": {
+			Type:     pluginsdk.TypeInt,
+			Required: true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (DnsDsRecordResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"fqdn": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r DnsDsRecordResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.PreviewRecordSets
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config DnsDsRecordResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := sdkhacks.NewRecordSetID(subscriptionId, config.ResourceGroupName, config.ZoneName, sdkhacks.RecordTypeDS, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := sdkhacks.RecordSet{
+				Name: pointer.To(config.Name),
+				Properties: &sdkhacks.RecordSetProperties{
+					DSRecords: expandDnsDsRecords(config.Record),
+					Metadata:  pointer.To(config.Tags),
+					TTL:       pointer.To(config.Ttl),
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r DnsDsRecordResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.PreviewRecordSets
+
+			id, err := sdkhacks.ParseRecordSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := DnsDsRecordResourceModel{
+				Name:              id.RelativeRecordSetName,
+				ResourceGroupName: id.ResourceGroupName,
+				ZoneName:          id.DnsZoneName,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Fqdn = pointer.From(props.Fqdn)
+					state.Record = flattenDnsDsRecords(props.DSRecords)
+					state.Tags = pointer.From(props.Metadata)
+					state.Ttl = pointer.From(props.TTL)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DnsDsRecordResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.PreviewRecordSets
+
+			id, err := sdkhacks.ParseRecordSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config DnsDsRecordResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("record") {
+				payload.Properties.DSRecords = expandDnsDsRecords(config.Record)
+			}
+
+			if metadata.ResourceData.HasChange("ttl") {
+				payload.Properties.TTL = pointer.To(config.Ttl)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Properties.Metadata = pointer.To(config.Tags)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DnsDsRecordResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.PreviewRecordSets
+
+			id, err := sdkhacks.ParseRecordSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDnsDsRecords(input []DnsDsRecordRecordModel) *[]sdkhacks.DsRecord {
+	output := make([]sdkhacks.DsRecord, 0)
+
+	for _, v := range input {
+		output = append(output, sdkhacks.DsRecord{
+			Algorithm: pointer.To(v.Algorithm),
+			Digest: &sdkhacks.Digest{
+				AlgorithmType: pointer.To(v.DigestType),
+				Value:         pointer.To(v.DigestValue),
+			},
+			KeyTag: pointer.To(v.KeyTag),
+		})
+	}
+
+	return &output
+}
+
+func flattenDnsDsRecords(input *[]sdkhacks.DsRecord) []DnsDsRecordRecordModel {
+	output := make([]DnsDsRecordRecordModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		record := DnsDsRecordRecordModel{
+			Algorithm: pointer.From(v.Algorithm),
+			KeyTag:    pointer.From(v.KeyTag),
+		}
+
+		if digest := v.Digest; digest != nil {
+			record.DigestType = pointer.From(digest.AlgorithmType)
+			record.DigestValue = pointer.From(digest.Value)
+		}
+
+		output = append(output, record)
+	}
+
+	return output
+}
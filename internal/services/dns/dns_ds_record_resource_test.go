@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/sdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DnsDsRecordResource struct{}
+
+func TestAccDnsDsRecord_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_ds_record", "test")
+	r := DnsDsRecordResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("fqdn").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDnsDsRecord_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_ds_record", "test")
+	r := DnsDsRecordResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDnsDsRecord_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_ds_record", "test")
+	r := DnsDsRecordResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (DnsDsRecordResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := sdkhacks.ParseRecordSetID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Dns.PreviewRecordSets.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r DnsDsRecordResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_ds_record" "test" {
+  name                = azurerm_dns_ns_record.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+  ttl                 = 300
+
+  record {
+    key_tag      = 12345
+    algorithm    = 13
+    digest_type  = 2
+    digest_value = "2BB183AF5F22588179A53B0A98631FAD1A292118BA6A3E6B0A5A3BEFAE8A0FAA"
+  }
+}
+`, r.template(data))
+}
+
+func (r DnsDsRecordResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_ds_record" "import" {
+  name                = azurerm_dns_ds_record.test.name
+  resource_group_name = azurerm_dns_ds_record.test.resource_group_name
+  zone_name           = azurerm_dns_ds_record.test.zone_name
+  ttl                 = 300
+
+  record {
+    key_tag      = 12345
+    algorithm    = 13
+    digest_type  = 2
+    digest_value = "2BB183AF5F22588179A53B0A98631FAD1A292118BA6A3E6B0A5A3BEFAE8A0FAA"
+  }
+}
+`, r.basic(data))
+}
+
+func (r DnsDsRecordResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_ds_record" "test" {
+  name                = azurerm_dns_ns_record.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+  ttl                 = 3600
+
+  record {
+    key_tag      = 12345
+    algorithm    = 13
+    digest_type  = 2
+    digest_value = "2BB183AF5F22588179A53B0A98631FAD1A292118BA6A3E6B0A5A3BEFAE8A0FAA"
+  }
+
+  record {
+    key_tag      = 54321
+    algorithm    = 8
+    digest_type  = 2
+    digest_value = "E06D44B80B8F1D39A95C0B0D7C65D08458E880409BBC683457104237C7F8EC8D"
+  }
+
+  tags = {
+    environment = "Test"
+  }
+}
+`, r.template(data))
+}
+
+func (DnsDsRecordResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%[1]d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+# a DS Record must be placed at a delegation point, which requires an NS Record with the same name
+resource "azurerm_dns_ns_record" "test" {
+  name                = "child%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+  ttl                 = 300
+  records             = ["ns1.contoso.com.", "ns2.contoso.com."]
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,323 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/sdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = DnsNaptrRecordResource{}
+
+type DnsNaptrRecordResource struct{}
+
+type DnsNaptrRecordResourceModel struct {
+	Name              string                      `tfschema:"name"`
+	ResourceGroupName string                      `tfschema:"resource_group_name"`
+	ZoneName          string                      `tfschema:"zone_name"`
+	Record            []DnsNaptrRecordRecordModel `tfschema:"record"`
+	Ttl               int64                       `tfschema:"ttl"`
+	Fqdn              string                      `tfschema:"fqdn"`
+	Tags              map[string]string           `tfschema:"tags"`
+}
+
+type DnsNaptrRecordRecordModel struct {
+	Flags       string `tfschema:"flags"`
+	Order       int64  `tfschema:"order"`
+	Preference  int64  `tfschema:"preference"`
+	Regexp      string `tfschema:"regexp"`
+	Replacement string `tfschema:"replacement"`
+	Services    string `tfschema:"services"`
+}
+
+func (DnsNaptrRecordResource) ResourceType() string {
+	return "azurerm_dns_naptr_record"
+}
+
+func (DnsNaptrRecordResource) ModelObject() interface{} {
+	return &DnsNaptrRecordResourceModel{}
+}
+
+func (DnsNaptrRecordResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return sdkhacks.ValidateRecordSetIDForRecordType(sdkhacks.RecordTypeNAPTR)
+}
+
+func (DnsNaptrRecordResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"zone_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"record": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"flags": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"A", "P", "S", "U"}, false),
+					},
+
+					"order": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 65535),
+					},
+
+					"preference": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 65535),
+					},
+
+					"regexp": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"replacement": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Default:      ".",
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"services": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"ttl": {
+			Type:     pluginsdk.TypeInt,
+			Required: true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (DnsNaptrRecordResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"fqdn": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r DnsNaptrRecordResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.PreviewRecordSets
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config DnsNaptrRecordResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := sdkhacks.NewRecordSetID(subscriptionId, config.ResourceGroupName, config.ZoneName, sdkhacks.RecordTypeNAPTR, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := sdkhacks.RecordSet{
+				Name: pointer.To(config.Name),
+				Properties: &sdkhacks.RecordSetProperties{
+					NAPTRRecords: expandDnsNaptrRecords(config.Record),
+					Metadata:     pointer.To(config.Tags),
+					TTL:          pointer.To(config.Ttl),
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r DnsNaptrRecordResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.PreviewRecordSets
+
+			id, err := sdkhacks.ParseRecordSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := DnsNaptrRecordResourceModel{
+				Name:              id.RelativeRecordSetName,
+				ResourceGroupName: id.ResourceGroupName,
+				ZoneName:          id.DnsZoneName,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Fqdn = pointer.From(props.Fqdn)
+					state.Record = flattenDnsNaptrRecords(props.NAPTRRecords)
+					state.Tags = pointer.From(props.Metadata)
+					state.Ttl = pointer.From(props.TTL)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DnsNaptrRecordResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.PreviewRecordSets
+
+			id, err := sdkhacks.ParseRecordSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config DnsNaptrRecordResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("record") {
+				payload.Properties.NAPTRRecords = expandDnsNaptrRecords(config.Record)
+			}
+
+			if metadata.ResourceData.HasChange("ttl") {
+				payload.Properties.TTL = pointer.To(config.Ttl)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Properties.Metadata = pointer.To(config.Tags)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DnsNaptrRecordResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.PreviewRecordSets
+
+			id, err := sdkhacks.ParseRecordSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDnsNaptrRecords(input []DnsNaptrRecordRecordModel) *[]sdkhacks.NaptrRecord {
+	output := make([]sdkhacks.NaptrRecord, 0)
+
+	for _, v := range input {
+		output = append(output, sdkhacks.NaptrRecord{
+			Flags:       pointer.To(v.Flags),
+			Order:       pointer.To(v.Order),
+			Preference:  pointer.To(v.Preference),
+			Regexp:      pointer.To(v.Regexp),
+			Replacement: pointer.To(v.Replacement),
+			Services:    pointer.To(v.Services),
+		})
+	}
+
+	return &output
+}
+
+func flattenDnsNaptrRecords(input *[]sdkhacks.NaptrRecord) []DnsNaptrRecordRecordModel {
+	output := make([]DnsNaptrRecordRecordModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, DnsNaptrRecordRecordModel{
+			Flags:       pointer.From(v.Flags),
+			Order:       pointer.From(v.Order),
+			Preference:  pointer.From(v.Preference),
+			Regexp:      pointer.From(v.Regexp),
+			Replacement: pointer.From(v.Replacement),
+			Services:    pointer.From(v.Services),
+		})
+	}
+
+	return output
+}
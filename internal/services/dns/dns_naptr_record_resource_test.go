@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/sdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type DnsNaptrRecordResource struct{}
+
+func TestAccDnsNaptrRecord_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_naptr_record", "test")
+	r := DnsNaptrRecordResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("fqdn").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDnsNaptrRecord_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_naptr_record", "test")
+	r := DnsNaptrRecordResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccDnsNaptrRecord_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dns_naptr_record", "test")
+	r := DnsNaptrRecordResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("record.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (DnsNaptrRecordResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := sdkhacks.ParseRecordSetID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Dns.PreviewRecordSets.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r DnsNaptrRecordResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_naptr_record" "test" {
+  name                = "sip"
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+  ttl                 = 300
+
+  record {
+    order       = 100
+    preference  = 10
+    flags       = "S"
+    services    = "SIP+D2U"
+    replacement = "_sip._udp.example.com."
+  }
+}
+`, r.template(data))
+}
+
+func (r DnsNaptrRecordResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_naptr_record" "import" {
+  name                = azurerm_dns_naptr_record.test.name
+  resource_group_name = azurerm_dns_naptr_record.test.resource_group_name
+  zone_name           = azurerm_dns_naptr_record.test.zone_name
+  ttl                 = 300
+
+  record {
+    order       = 100
+    preference  = 10
+    flags       = "S"
+    services    = "SIP+D2U"
+    replacement = "_sip._udp.example.com."
+  }
+}
+`, r.basic(data))
+}
+
+func (r DnsNaptrRecordResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dns_naptr_record" "test" {
+  name                = "sip"
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_dns_zone.test.name
+  ttl                 = 3600
+
+  record {
+    order       = 100
+    preference  = 10
+    flags       = "S"
+    services    = "SIP+D2U"
+    replacement = "_sip._udp.example.com."
+  }
+
+  record {
+    order      = 200
+    preference = 10
+    flags      = "U"
+    services   = "E2U+sip"
+    regexp     = "!^.*$!sip:info@example.com!"
+  }
+
+  tags = {
+    environment = "Test"
+  }
+}
+`, r.template(data))
+}
+
+func (DnsNaptrRecordResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%[1]d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,304 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/sdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = DnsTlsaRecordResource{}
+
+type DnsTlsaRecordResource struct{}
+
+type DnsTlsaRecordResourceModel struct {
+	Name              string                     `tfschema:"name"`
+	ResourceGroupName string                     `tfschema:"resource_group_name"`
+	ZoneName          string                     `tfschema:"zone_name"`
+	Record            []DnsTlsaRecordRecordModel `tfschema:"record"`
+	Ttl               int64                      `tfschema:"ttl"`
+	Fqdn              string                     `tfschema:"fqdn"`
+	Tags              map[string]string          `tfschema:"tags"`
+}
+
+type DnsTlsaRecordRecordModel struct {
+	CertificateAssociationData string `tfschema:"certificate_association_data"`
+	MatchingType               int64  `tfschema:"matching_type"`
+	Selector                   int64  `tfschema:"selector"`
+	Usage                      int64  `tfschema:"usage"`
+}
+
+func (DnsTlsaRecordResource) ResourceType() string {
+	return "azurerm_dns_tlsa_record"
+}
+
+func (DnsTlsaRecordResource) ModelObject() interface{} {
+	return &DnsTlsaRecordResourceModel{}
+}
+
+func (DnsTlsaRecordResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return sdkhacks.ValidateRecordSetIDForRecordType(sdkhacks.RecordTypeTLSA)
+}
+
+func (DnsTlsaRecordResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"zone_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"record": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"certificate_association_data": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"matching_type": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 255),
+					},
+
+					"selector": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 255),
+					},
+
+					"usage": {
+						Type:         pluginsdk.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(0, 255),
+					},
+				},
+			},
+		},
+
+		"ttl": {
+			Type:     pluginsdk.TypeInt,
+			Required: true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (DnsTlsaRecordResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"fqdn": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r DnsTlsaRecordResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.PreviewRecordSets
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config DnsTlsaRecordResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := sdkhacks.NewRecordSetID(subscriptionId, config.ResourceGroupName, config.ZoneName, sdkhacks.RecordTypeTLSA, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := sdkhacks.RecordSet{
+				Name: pointer.To(config.Name),
+				Properties: &sdkhacks.RecordSetProperties{
+					TLSARecords: expandDnsTlsaRecords(config.Record),
+					Metadata:    pointer.To(config.Tags),
+					TTL:         pointer.To(config.Ttl),
+				},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r DnsTlsaRecordResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.PreviewRecordSets
+
+			id, err := sdkhacks.ParseRecordSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := DnsTlsaRecordResourceModel{
+				Name:              id.RelativeRecordSetName,
+				ResourceGroupName: id.ResourceGroupName,
+				ZoneName:          id.DnsZoneName,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Fqdn = pointer.From(props.Fqdn)
+					state.Record = flattenDnsTlsaRecords(props.TLSARecords)
+					state.Tags = pointer.From(props.Metadata)
+					state.Ttl = pointer.From(props.TTL)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DnsTlsaRecordResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.PreviewRecordSets
+
+			id, err := sdkhacks.ParseRecordSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config DnsTlsaRecordResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("record") {
+				payload.Properties.TLSARecords = expandDnsTlsaRecords(config.Record)
+			}
+
+			if metadata.ResourceData.HasChange("ttl") {
+				payload.Properties.TTL = pointer.To(config.Ttl)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Properties.Metadata = pointer.To(config.Tags)
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r DnsTlsaRecordResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.PreviewRecordSets
+
+			id, err := sdkhacks.ParseRecordSetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandDnsTlsaRecords(input []DnsTlsaRecordRecordModel) *[]sdkhacks.TlsaRecord {
+	output := make([]sdkhacks.TlsaRecord, 0)
+
+	for _, v := range input {
+		output = append(output, sdkhacks.TlsaRecord{
+			CertAssociationData: pointer.To(v.CertificateAssociationData),
+			MatchingType:        pointer.To(v.MatchingType),
+			Selector:            pointer.To(v.Selector),
+			Usage:               pointer.To(v.Usage),
+		})
+	}
+
+	return &output
+}
+
+func flattenDnsTlsaRecords(input *[]sdkhacks.TlsaRecord) []DnsTlsaRecordRecordModel {
+	output := make([]DnsTlsaRecordRecordModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		output = append(output, DnsTlsaRecordRecordModel{
+			CertificateAssociationData: pointer.From(v.CertAssociationData),
+			MatchingType:               pointer.From(v.MatchingType),
+			Selector:                   pointer.From(v.Selector),
+			Usage:                      pointer.From(v.Usage),
+		})
+	}
+
+	return output
+}
@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/dns/2018-05-01/zones"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/dns/sdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.Resource = DnsZoneDnssecConfigResource{}
+
+type DnsZoneDnssecConfigResource struct{}
+
+type DnsZoneDnssecConfigResourceModel struct {
+	DnsZoneId  string                               `tfschema:"dns_zone_id"`
+	SigningKey []DnsZoneDnssecConfigSigningKeyModel `tfschema:"signing_key"`
+}
+
+type DnsZoneDnssecConfigSigningKeyModel struct {
+	DelegationSignerInfo  []DnsZoneDnssecConfigDelegationSignerInfoModel `tfschema:"delegation_signer_info"`
+	Flags                 int64                                          `tfschema:"flags"`
+	KeyTag                int64                                          `tfschema:"key_tag"`
+	Protocol              int64                                          `tfschema:"protocol"`
+	PublicKey             string                                         `tfschema:"public_key"`
+	SecurityAlgorithmType int64                                          `tfschema:"security_algorithm_type"`
+}
+
+type DnsZoneDnssecConfigDelegationSignerInfoModel struct {
+	DigestAlgorithmType int64  `tfschema:"digest_algorithm_type"`
+	DigestValue         string `tfschema:"digest_value"`
+	Record              string `tfschema:"record"`
+}
+
+func (DnsZoneDnssecConfigResource) ResourceType() string {
+	return "azurerm_dns_zone_dnssec_config"
+}
+
+func (DnsZoneDnssecConfigResource) ModelObject() interface{} {
+	return &DnsZoneDnssecConfigResourceModel{}
+}
+
+func (DnsZoneDnssecConfigResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return sdkhacks.ValidateDnssecConfigID
+}
+
+func (DnsZoneDnssecConfigResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"dns_zone_id": commonschema.ResourceIDReferenceRequiredForceNew(&zones.DnsZoneId{}),
+	}
+}
+
+func (DnsZoneDnssecConfigResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"signing_key": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"delegation_signer_info": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"digest_algorithm_type": {
+									Type:     pluginsdk.TypeInt,
+									Computed: true,
+								},
+
+								"digest_value": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"record": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+							},
+						},
+					},
+
+					"flags": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"key_tag": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"protocol": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"public_key": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"security_algorithm_type": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r DnsZoneDnssecConfigResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.DnssecConfigs
+
+			var config DnsZoneDnssecConfigResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			zoneId, err := zones.ParseDnsZoneID(config.DnsZoneId)
+			if err != nil {
+				return err
+			}
+
+			id := sdkhacks.NewDnssecConfigID(zoneId.SubscriptionId, zoneId.ResourceGroupName, zoneId.DnsZoneName)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r DnsZoneDnssecConfigResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.DnssecConfigs
+
+			id, err := sdkhacks.ParseDnssecConfigID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := DnsZoneDnssecConfigResourceModel{
+				DnsZoneId: zones.NewDnsZoneID(id.SubscriptionId, id.ResourceGroupName, id.DnsZoneName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.SigningKey = flattenDnsZoneDnssecConfigSigningKeys(props.SigningKeys)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r DnsZoneDnssecConfigResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dns.DnssecConfigs
+
+			id, err := sdkhacks.ParseDnssecConfigID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func flattenDnsZoneDnssecConfigSigningKeys(input *[]sdkhacks.SigningKey) []DnsZoneDnssecConfigSigningKeyModel {
+	output := make([]DnsZoneDnssecConfigSigningKeyModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, key := range *input {
+		signerInfo := make([]DnsZoneDnssecConfigDelegationSignerInfoModel, 0)
+		if key.DelegationSignerInfo != nil {
+			for _, info := range *key.DelegationSignerInfo {
+				signerInfo = append(signerInfo, DnsZoneDnssecConfigDelegationSignerInfoModel{
+					DigestAlgorithmType: pointer.From(info.DigestAlgorithmType),
+					DigestValue:         pointer.From(info.DigestValue),
+					Record:              pointer.From(info.Record),
+				})
+			}
+		}
+
+		output = append(output, DnsZoneDnssecConfigSigningKeyModel{
+			DelegationSignerInfo:  signerInfo,
+			Flags:                 pointer.From(key.Flags),
+			KeyTag:                pointer.From(key.KeyTag),
+			Protocol:              pointer.From(key.Protocol),
+			PublicKey:             pointer.From(key.PublicKey),
+			SecurityAlgorithmType: pointer.From(key.SecurityAlgorithmType),
+		})
+	}
+
+	return output
+}
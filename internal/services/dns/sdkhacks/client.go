@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkhacks
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// DNSSEC Configurations and the DS, TLSA and NAPTR Record Types are only available in API Version `2023-07-01-preview`
+// which isn't generated in `hashicorp/go-azure-sdk`, as such these clients are hand-written against the Swagger.
+// TODO: remove once a stable API Version containing these is available in the SDK.
+const defaultApiVersion = "2023-07-01-preview"
+
+type DnssecConfigsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewDnssecConfigsClientWithBaseURI(sdkApi sdkEnv.Api) (*DnssecConfigsClient, error) {
+	client, err := resourcemanager.NewClient(sdkApi, "dnssecconfigs", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating DnssecConfigsClient: %+v", err)
+	}
+
+	return &DnssecConfigsClient{
+		Client: client,
+	}, nil
+}
+
+type RecordSetsClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewRecordSetsClientWithBaseURI(sdkApi sdkEnv.Api) (*RecordSetsClient, error) {
+	client, err := resourcemanager.NewClient(sdkApi, "recordsets", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating RecordSetsClient: %+v", err)
+	}
+
+	return &RecordSetsClient{
+		Client: client,
+	}, nil
+}
@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkhacks
+
+import (
+	"fmt"
+	"strings"
+)
+
+type RecordType string
+
+const (
+	RecordTypeDS    RecordType = "DS"
+	RecordTypeNAPTR RecordType = "NAPTR"
+	RecordTypeTLSA  RecordType = "TLSA"
+)
+
+func PossibleValuesForRecordType() []string {
+	return []string{
+		string(RecordTypeDS),
+		string(RecordTypeNAPTR),
+		string(RecordTypeTLSA),
+	}
+}
+
+func parseRecordType(input string) (*RecordType, error) {
+	for _, v := range PossibleValuesForRecordType() {
+		if strings.EqualFold(v, input) {
+			out := RecordType(v)
+			return &out, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported Record Type %q", input)
+}
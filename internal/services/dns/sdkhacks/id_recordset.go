@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkhacks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+func init() {
+	recaser.RegisterResourceId(&RecordSetId{})
+}
+
+var _ resourceids.ResourceId = &RecordSetId{}
+
+// RecordSetId is a struct representing the Resource ID for a Record Set of one of the Record Types
+// which are only available in the preview API
+type RecordSetId struct {
+	SubscriptionId        string
+	ResourceGroupName     string
+	DnsZoneName           string
+	RecordType            RecordType
+	RelativeRecordSetName string
+}
+
+// NewRecordSetID returns a new RecordSetId struct
+func NewRecordSetID(subscriptionId string, resourceGroupName string, dnsZoneName string, recordType RecordType, relativeRecordSetName string) RecordSetId {
+	return RecordSetId{
+		SubscriptionId:        subscriptionId,
+		ResourceGroupName:     resourceGroupName,
+		DnsZoneName:           dnsZoneName,
+		RecordType:            recordType,
+		RelativeRecordSetName: relativeRecordSetName,
+	}
+}
+
+// ParseRecordSetID parses 'input' into a RecordSetId
+func ParseRecordSetID(input string) (*RecordSetId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&RecordSetId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := RecordSetId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseRecordSetIDInsensitively parses 'input' case-insensitively into a RecordSetId
+// note: this method should only be used for API response data and not user input
+func ParseRecordSetIDInsensitively(input string) (*RecordSetId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&RecordSetId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := RecordSetId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *RecordSetId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.DnsZoneName, ok = input.Parsed["dnsZoneName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "dnsZoneName", input)
+	}
+
+	v, ok := input.Parsed["recordType"]
+	if !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "recordType", input)
+	}
+	recordType, err := parseRecordType(v)
+	if err != nil {
+		return fmt.Errorf("parsing %q: %+v", v, err)
+	}
+	id.RecordType = *recordType
+
+	if id.RelativeRecordSetName, ok = input.Parsed["relativeRecordSetName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "relativeRecordSetName", input)
+	}
+
+	return nil
+}
+
+// ValidateRecordSetID checks that 'input' can be parsed as a Record Set ID
+func ValidateRecordSetID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseRecordSetID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Record Set ID
+func (id RecordSetId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/dnsZones/%s/%s/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.DnsZoneName, string(id.RecordType), id.RelativeRecordSetName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Record Set ID
+func (id RecordSetId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network", "Microsoft.Network"),
+		resourceids.StaticSegment("staticDnsZones", "dnsZones", "dnsZones"),
+		resourceids.UserSpecifiedSegment("dnsZoneName", "dnsZoneName"),
+		resourceids.ConstantSegment("recordType", PossibleValuesForRecordType(), "DS"),
+		resourceids.UserSpecifiedSegment("relativeRecordSetName", "relativeRecordSetName"),
+	}
+}
+
+// String returns a human-readable description of this Record Set ID
+func (id RecordSetId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Dns Zone Name: %q", id.DnsZoneName),
+		fmt.Sprintf("Record Type: %q", string(id.RecordType)),
+		fmt.Sprintf("Relative Record Set Name: %q", id.RelativeRecordSetName),
+	}
+	return fmt.Sprintf("Record Set (%s)", strings.Join(components, "\n"))
+}
+
+// ValidateRecordSetIDForRecordType returns a validation function which checks that 'input' can be parsed as a
+// Record Set ID of the specified Record Type
+func ValidateRecordSetIDForRecordType(recordType RecordType) func(interface{}, string) ([]string, []error) {
+	return func(input interface{}, key string) (warnings []string, errors []error) {
+		v, ok := input.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+			return
+		}
+
+		id, err := ParseRecordSetID(v)
+		if err != nil {
+			errors = append(errors, err)
+			return
+		}
+
+		if id.RecordType != recordType {
+			errors = append(errors, fmt.Errorf("expected a %q Record Set ID but got a %q Record Set ID", string(recordType), string(id.RecordType)))
+		}
+
+		return
+	}
+}
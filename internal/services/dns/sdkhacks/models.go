@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkhacks
+
+type DnssecConfig struct {
+	Etag       *string           `json:"etag,omitempty"`
+	Id         *string           `json:"id,omitempty"`
+	Name       *string           `json:"name,omitempty"`
+	Properties *DnssecProperties `json:"properties,omitempty"`
+	Type       *string           `json:"type,omitempty"`
+}
+
+type DnssecProperties struct {
+	ProvisioningState *string       `json:"provisioningState,omitempty"`
+	SigningKeys       *[]SigningKey `json:"signingKeys,omitempty"`
+}
+
+type SigningKey struct {
+	DelegationSignerInfo  *[]DelegationSignerInfo `json:"delegationSignerInfo,omitempty"`
+	Flags                 *int64                  `json:"flags,omitempty"`
+	KeyTag                *int64                  `json:"keyTag,omitempty"`
+	Protocol              *int64                  `json:"protocol,omitempty"`
+	PublicKey             *string                 `json:"publicKey,omitempty"`
+	SecurityAlgorithmType *int64                  `json:"securityAlgorithmType,omitempty"`
+}
+
+type DelegationSignerInfo struct {
+	DigestAlgorithmType *int64  `json:"digestAlgorithmType,omitempty"`
+	DigestValue         *string `json:"digestValue,omitempty"`
+	Record              *string `json:"record,omitempty"`
+}
+
+type RecordSet struct {
+	Etag       *string              `json:"etag,omitempty"`
+	Id         *string              `json:"id,omitempty"`
+	Name       *string              `json:"name,omitempty"`
+	Properties *RecordSetProperties `json:"properties,omitempty"`
+	Type       *string              `json:"type,omitempty"`
+}
+
+// RecordSetProperties only contains the fields of the Record Types which aren't available in the stable API
+type RecordSetProperties struct {
+	DSRecords         *[]DsRecord        `json:"DSRecords,omitempty"`
+	Fqdn              *string            `json:"fqdn,omitempty"`
+	Metadata          *map[string]string `json:"metadata,omitempty"`
+	NAPTRRecords      *[]NaptrRecord     `json:"NAPTRRecords,omitempty"`
+	ProvisioningState *string            `json:"provisioningState,omitempty"`
+	TLSARecords       *[]TlsaRecord      `json:"TLSARecords,omitempty"`
+	TTL               *int64             `json:"TTL,omitempty"`
+}
+
+type DsRecord struct {
+	Algorithm *int64  `json:"algorithm,omitempty"`
+	Digest    *Digest `json:"digest,omitempty"`
+	KeyTag    *int64  `json:"keyTag,omitempty"`
+}
+
+type Digest struct {
+	AlgorithmType *int64  `json:"algorithmType,omitempty"`
+	Value         *string `json:"value,omitempty"`
+}
+
+type NaptrRecord struct {
+	Flags       *string `json:"flags,omitempty"`
+	Order       *int64  `json:"order,omitempty"`
+	Preference  *int64  `json:"preference,omitempty"`
+	Regexp      *string `json:"regexp,omitempty"`
+	Replacement *string `json:"replacement,omitempty"`
+	Services    *string `json:"services,omitempty"`
+}
+
+type TlsaRecord struct {
+	CertAssociationData *string `json:"certAssociationData,omitempty"`
+	MatchingType        *int64  `json:"matchingType,omitempty"`
+	Selector            *int64  `json:"selector,omitempty"`
+	Usage               *int64  `json:"usage,omitempty"`
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/machineextensions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2022-11-10/machines"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -22,17 +23,18 @@ import (
 )
 
 type MachineExtensionModel struct {
-	Name                   string            `tfschema:"name"`
-	HybridComputeMachineId string            `tfschema:"arc_machine_id"`
-	EnableAutomaticUpgrade bool              `tfschema:"automatic_upgrade_enabled"`
-	ForceUpdateTag         string            `tfschema:"force_update_tag"`
-	Location               string            `tfschema:"location"`
-	ProtectedSettings      string            `tfschema:"protected_settings"`
-	Publisher              string            `tfschema:"publisher"`
-	Settings               string            `tfschema:"settings"`
-	Tags                   map[string]string `tfschema:"tags"`
-	Type                   string            `tfschema:"type"`
-	TypeHandlerVersion     string            `tfschema:"type_handler_version"`
+	Name                       string            `tfschema:"name"`
+	HybridComputeMachineId     string            `tfschema:"arc_machine_id"`
+	EnableAutomaticUpgrade     bool              `tfschema:"automatic_upgrade_enabled"`
+	ForceUpdateTag             string            `tfschema:"force_update_tag"`
+	Location                   string            `tfschema:"location"`
+	ProtectedSettings          string            `tfschema:"protected_settings"`
+	ProtectedSettingsWoVersion int64             `tfschema:"protected_settings_wo_version"`
+	Publisher                  string            `tfschema:"publisher"`
+	Settings                   string            `tfschema:"settings"`
+	Tags                       map[string]string `tfschema:"tags"`
+	Type                       string            `tfschema:"type"`
+	TypeHandlerVersion         string            `tfschema:"type_handler_version"`
 }
 
 type ArcMachineExtensionResource struct{}
@@ -90,6 +92,23 @@ func (r ArcMachineExtensionResource) Arguments() map[string]*pluginsdk.Schema {
 			Sensitive:        true,
 			ValidateFunc:     validation.StringIsJSON,
 			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+			ConflictsWith:    []string{"protected_settings_wo"},
+		},
+
+		"protected_settings_wo": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			WriteOnly:     true,
+			ValidateFunc:  validation.StringIsJSON,
+			ConflictsWith: []string{"protected_settings"},
+			RequiredWith:  []string{"protected_settings_wo_version"},
+		},
+
+		"protected_settings_wo_version": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			RequiredWith: []string{"protected_settings_wo"},
 		},
 
 		"publisher": {
@@ -184,6 +203,18 @@ func (r ArcMachineExtensionResource) Create() sdk.ResourceFunc {
 				properties.Properties.ProtectedSettings = &protectedSettingsValue
 			}
 
+			protectedSettingsWo, err := pluginsdk.GetWriteOnly(metadata.ResourceData, "protected_settings_wo", cty.String)
+			if err != nil {
+				return err
+			}
+			if !protectedSettingsWo.IsNull() {
+				protectedSettingsValue := make(map[string]interface{})
+				if err := json.Unmarshal([]byte(protectedSettingsWo.AsString()), &protectedSettingsValue); err != nil {
+					return err
+				}
+				properties.Properties.ProtectedSettings = &protectedSettingsValue
+			}
+
 			if model.Publisher != "" {
 				properties.Properties.Publisher = &model.Publisher
 			}
@@ -255,11 +286,28 @@ func (r ArcMachineExtensionResource) Update() sdk.ResourceFunc {
 
 			if metadata.ResourceData.HasChange("protected_settings") {
 				protectedSettingsValue := make(map[string]interface{})
-				err := json.Unmarshal([]byte(model.ProtectedSettings), &protectedSettingsValue)
+				if model.ProtectedSettings != "" {
+					if err := json.Unmarshal([]byte(model.ProtectedSettings), &protectedSettingsValue); err != nil {
+						return err
+					}
+				}
+
+				properties.Properties.ProtectedSettings = &protectedSettingsValue
+			}
+
+			if metadata.ResourceData.HasChange("protected_settings_wo_version") {
+				protectedSettingsWo, err := pluginsdk.GetWriteOnly(metadata.ResourceData, "protected_settings_wo", cty.String)
 				if err != nil {
 					return err
 				}
 
+				protectedSettingsValue := make(map[string]interface{})
+				if !protectedSettingsWo.IsNull() {
+					if err := json.Unmarshal([]byte(protectedSettingsWo.AsString()), &protectedSettingsValue); err != nil {
+						return err
+					}
+				}
+
 				properties.Properties.ProtectedSettings = &protectedSettingsValue
 			}
 
@@ -364,6 +412,7 @@ func (r ArcMachineExtensionResource) Read() sdk.ResourceFunc {
 					if extModel.ProtectedSettings != "" {
 						state.ProtectedSettings = extModel.ProtectedSettings
 					}
+					state.ProtectedSettingsWoVersion = extModel.ProtectedSettingsWoVersion
 
 					if properties.Settings != nil && *properties.Settings != nil {
 						settingsValue, err := json.Marshal(*properties.Settings)
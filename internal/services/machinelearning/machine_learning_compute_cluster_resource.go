@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/machinelearningcomputes"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2025-06-01/workspaces"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -122,16 +123,32 @@ func resourceComputeCluster() *pluginsdk.Resource {
 							ForceNew: true,
 						},
 						"admin_password": {
-							Type:         pluginsdk.TypeString,
+							Type:          pluginsdk.TypeString,
+							Optional:      true,
+							ForceNew:      true,
+							AtLeastOneOf:  []string{"ssh.0.admin_password", "ssh.0.admin_password_wo", "ssh.0.key_value"},
+							ConflictsWith: []string{"ssh.0.admin_password_wo"},
+						},
+						"admin_password_wo": {
+							Type:          pluginsdk.TypeString,
+							Optional:      true,
+							WriteOnly:     true,
+							AtLeastOneOf:  []string{"ssh.0.admin_password", "ssh.0.admin_password_wo", "ssh.0.key_value"},
+							ConflictsWith: []string{"ssh.0.admin_password"},
+							RequiredWith:  []string{"ssh.0.admin_password_wo_version"},
+						},
+						"admin_password_wo_version": {
+							Type:         pluginsdk.TypeInt,
 							Optional:     true,
 							ForceNew:     true,
-							AtLeastOneOf: []string{"ssh.0.admin_password", "ssh.0.key_value"},
+							ValidateFunc: validation.IntAtLeast(1),
+							RequiredWith: []string{"ssh.0.admin_password_wo"},
 						},
 						"key_value": {
 							Type:         pluginsdk.TypeString,
 							Optional:     true,
 							ForceNew:     true,
-							AtLeastOneOf: []string{"ssh.0.admin_password", "ssh.0.key_value"},
+							AtLeastOneOf: []string{"ssh.0.admin_password", "ssh.0.admin_password_wo", "ssh.0.key_value"},
 						},
 					},
 				},
@@ -219,6 +236,16 @@ func resourceComputeClusterCreate(d *pluginsdk.ResourceData, meta interface{}) e
 		EnableNodePublicIP:     pointer.To(d.Get("node_public_ip_enabled").(bool)),
 	}
 
+	if credentials := computeClusterAmlComputeProperties.UserAccountCredentials; credentials != nil {
+		adminPasswordWo, err := pluginsdk.GetWriteOnly(d, "ssh.0.admin_password_wo", cty.String)
+		if err != nil {
+			return err
+		}
+		if !adminPasswordWo.IsNull() {
+			credentials.AdminUserPassword = pointer.To(adminPasswordWo.AsString())
+		}
+	}
+
 	computeClusterAmlComputeProperties.RemoteLoginPortPublicAccess = pointer.To(machinelearningcomputes.RemoteLoginPortPublicAccessDisabled)
 	if d.Get("ssh_public_access_enabled").(bool) {
 		computeClusterAmlComputeProperties.RemoteLoginPortPublicAccess = pointer.To(machinelearningcomputes.RemoteLoginPortPublicAccessEnabled)
@@ -298,7 +325,7 @@ func resourceComputeClusterRead(d *pluginsdk.ResourceData, meta interface{}) err
 		d.Set("vm_size", props.VMSize)
 		d.Set("vm_priority", string(pointer.From(props.VMPriority)))
 		d.Set("scale_settings", flattenScaleSettings(props.ScaleSettings))
-		d.Set("ssh", flattenUserAccountCredentials(props.UserAccountCredentials))
+		d.Set("ssh", flattenUserAccountCredentials(props.UserAccountCredentials, d.Get("ssh.0.admin_password_wo_version").(int)))
 		enableNodePublicIP := true
 		if props.EnableNodePublicIP != nil {
 			enableNodePublicIP = *props.EnableNodePublicIP
@@ -445,7 +472,7 @@ func flattenScaleSettings(scaleSettings *machinelearningcomputes.ScaleSettings)
 	}
 }
 
-func flattenUserAccountCredentials(credentials *machinelearningcomputes.UserAccountCredentials) interface{} {
+func flattenUserAccountCredentials(credentials *machinelearningcomputes.UserAccountCredentials, adminPasswordWoVersion int) interface{} {
 	if credentials == nil {
 		return []interface{}{}
 	}
@@ -454,8 +481,9 @@ func flattenUserAccountCredentials(credentials *machinelearningcomputes.UserAcco
 		username = credentials.AdminUserName
 	}
 
+	// when `admin_password_wo` is used the password mustn't be persisted into the state
 	var adminPassword string
-	if credentials.AdminUserPassword != nil {
+	if credentials.AdminUserPassword != nil && adminPasswordWoVersion == 0 {
 		adminPassword = *credentials.AdminUserPassword
 	}
 
@@ -466,9 +494,10 @@ func flattenUserAccountCredentials(credentials *machinelearningcomputes.UserAcco
 
 	return []interface{}{
 		map[string]interface{}{
-			"admin_username": username,
-			"admin_password": adminPassword,
-			"key_value":      sshPublicKey,
+			"admin_username":            username,
+			"admin_password":            adminPassword,
+			"admin_password_wo_version": adminPasswordWoVersion,
+			"key_value":                 sshPublicKey,
 		},
 	}
 }
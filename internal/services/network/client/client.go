@@ -10,6 +10,10 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/vmsspublicipaddresses"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-01-01/bastionhosts"
 	network_2024_05_01 "github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-10-01/networksecurityperimeteraccessrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-10-01/networksecurityperimeterassociations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-10-01/networksecurityperimeterprofiles"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-10-01/networksecurityperimeters"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
@@ -21,6 +25,12 @@ type Client struct {
 	// VMSS Data Source requires the Network Interfaces and VMSSPublicIpAddresses client from `2023-09-01` for the `ListVirtualMachineScaleSetVMNetworkInterfacesComplete` method
 	NetworkInterfacesClient     *networkinterfaces.NetworkInterfacesClient
 	VMSSPublicIPAddressesClient *vmsspublicipaddresses.VMSSPublicIPAddressesClient
+
+	// Network Security Perimeters are only available from API Version `2024-10-01`
+	NetworkSecurityPerimetersClient            *networksecurityperimeters.NetworkSecurityPerimetersClient
+	NetworkSecurityPerimeterAccessRulesClient  *networksecurityperimeteraccessrules.NetworkSecurityPerimeterAccessRulesClient
+	NetworkSecurityPerimeterAssociationsClient *networksecurityperimeterassociations.NetworkSecurityPerimeterAssociationsClient
+	NetworkSecurityPerimeterProfilesClient     *networksecurityperimeterprofiles.NetworkSecurityPerimeterProfilesClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -42,6 +52,30 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(VMSSPublicIPAddressesClient.Client, o.Authorizers.ResourceManager)
 
+	NetworkSecurityPerimetersClient, err := networksecurityperimeters.NewNetworkSecurityPerimetersClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Network Security Perimeters Client: %+v", err)
+	}
+	o.Configure(NetworkSecurityPerimetersClient.Client, o.Authorizers.ResourceManager)
+
+	NetworkSecurityPerimeterAccessRulesClient, err := networksecurityperimeteraccessrules.NewNetworkSecurityPerimeterAccessRulesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Network Security Perimeter Access Rules Client: %+v", err)
+	}
+	o.Configure(NetworkSecurityPerimeterAccessRulesClient.Client, o.Authorizers.ResourceManager)
+
+	NetworkSecurityPerimeterAssociationsClient, err := networksecurityperimeterassociations.NewNetworkSecurityPerimeterAssociationsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Network Security Perimeter Associations Client: %+v", err)
+	}
+	o.Configure(NetworkSecurityPerimeterAssociationsClient.Client, o.Authorizers.ResourceManager)
+
+	NetworkSecurityPerimeterProfilesClient, err := networksecurityperimeterprofiles.NewNetworkSecurityPerimeterProfilesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Network Security Perimeter Profiles Client: %+v", err)
+	}
+	o.Configure(NetworkSecurityPerimeterProfilesClient.Client, o.Authorizers.ResourceManager)
+
 	client, err := network_2024_05_01.NewClientWithBaseURI(o.Environment.ResourceManager, func(c *resourcemanager.Client) {
 		o.Configure(c, o.Authorizers.ResourceManager)
 	})
@@ -54,5 +88,10 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		NetworkInterfacesClient:     NetworkInterfacesClient,
 		VMSSPublicIPAddressesClient: VMSSPublicIPAddressesClient,
 		Client:                      client,
+
+		NetworkSecurityPerimetersClient:            NetworkSecurityPerimetersClient,
+		NetworkSecurityPerimeterAccessRulesClient:  NetworkSecurityPerimeterAccessRulesClient,
+		NetworkSecurityPerimeterAssociationsClient: NetworkSecurityPerimeterAssociationsClient,
+		NetworkSecurityPerimeterProfilesClient:     NetworkSecurityPerimeterProfilesClient,
 	}, nil
 }
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/networkinterfaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/virtualnetworktap"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = NetworkInterfaceTapConfigurationResource{}
+
+type NetworkInterfaceTapConfigurationResource struct{}
+
+func (NetworkInterfaceTapConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return networkinterfaces.ValidateTapConfigurationID
+}
+
+func (NetworkInterfaceTapConfigurationResource) ResourceType() string {
+	return "azurerm_network_interface_tap_configuration"
+}
+
+func (NetworkInterfaceTapConfigurationResource) ModelObject() interface{} {
+	return &NetworkInterfaceTapConfigurationResourceModel{}
+}
+
+type NetworkInterfaceTapConfigurationResourceModel struct {
+	Name                string `tfschema:"name"`
+	NetworkInterfaceId  string `tfschema:"network_interface_id"`
+	VirtualNetworkTapId string `tfschema:"virtual_network_tap_id"`
+}
+
+func (NetworkInterfaceTapConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9_.-]{0,78}[a-zA-Z0-9_])?$`),
+				"`name` must be between 1 and 80 characters long, begin with a letter or number, end with a letter, number or underscore, and can only contain letters, numbers, underscores(_), periods(.), and hyphens(-).",
+			),
+		},
+
+		"network_interface_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateNetworkInterfaceID,
+		},
+
+		"virtual_network_tap_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: virtualnetworktap.ValidateVirtualNetworkTapID,
+		},
+	}
+}
+
+func (NetworkInterfaceTapConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r NetworkInterfaceTapConfigurationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkInterfaces
+
+			var config NetworkInterfaceTapConfigurationResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			nicId, err := commonids.ParseNetworkInterfaceID(config.NetworkInterfaceId)
+			if err != nil {
+				return err
+			}
+
+			id := networkinterfaces.NewTapConfigurationID(nicId.SubscriptionId, nicId.ResourceGroupName, nicId.NetworkInterfaceName, config.Name)
+
+			locks.ByName(id.NetworkInterfaceName, networkInterfaceResourceName)
+			defer locks.UnlockByName(id.NetworkInterfaceName, networkInterfaceResourceName)
+
+			existing, err := client.NetworkInterfaceTapConfigurationsGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := networkinterfaces.NetworkInterfaceTapConfiguration{
+				Name: pointer.To(config.Name),
+				Properties: &networkinterfaces.NetworkInterfaceTapConfigurationPropertiesFormat{
+					VirtualNetworkTap: &networkinterfaces.VirtualNetworkTap{
+						Id: pointer.To(config.VirtualNetworkTapId),
+					},
+				},
+			}
+
+			if err := client.NetworkInterfaceTapConfigurationsCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r NetworkInterfaceTapConfigurationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkInterfaces
+
+			id, err := networkinterfaces.ParseTapConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.NetworkInterfaceTapConfigurationsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := NetworkInterfaceTapConfigurationResourceModel{
+				Name:               id.TapConfigurationName,
+				NetworkInterfaceId: commonids.NewNetworkInterfaceID(id.SubscriptionId, id.ResourceGroupName, id.NetworkInterfaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil && props.VirtualNetworkTap != nil && props.VirtualNetworkTap.Id != nil {
+					tapId, err := virtualnetworktap.ParseVirtualNetworkTapIDInsensitively(*props.VirtualNetworkTap.Id)
+					if err != nil {
+						return err
+					}
+					state.VirtualNetworkTapId = tapId.ID()
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r NetworkInterfaceTapConfigurationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkInterfaces
+
+			id, err := networkinterfaces.ParseTapConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config NetworkInterfaceTapConfigurationResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByName(id.NetworkInterfaceName, networkInterfaceResourceName)
+			defer locks.UnlockByName(id.NetworkInterfaceName, networkInterfaceResourceName)
+
+			existing, err := client.NetworkInterfaceTapConfigurationsGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", id)
+			}
+
+			payload := *existing.Model
+			if payload.Properties == nil {
+				payload.Properties = &networkinterfaces.NetworkInterfaceTapConfigurationPropertiesFormat{}
+			}
+
+			if metadata.ResourceData.HasChange("virtual_network_tap_id") {
+				payload.Properties.VirtualNetworkTap = &networkinterfaces.VirtualNetworkTap{
+					Id: pointer.To(config.VirtualNetworkTapId),
+				}
+			}
+
+			if err := client.NetworkInterfaceTapConfigurationsCreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r NetworkInterfaceTapConfigurationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkInterfaces
+
+			id, err := networkinterfaces.ParseTapConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.NetworkInterfaceName, networkInterfaceResourceName)
+			defer locks.UnlockByName(id.NetworkInterfaceName, networkInterfaceResourceName)
+
+			if err := client.NetworkInterfaceTapConfigurationsDeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
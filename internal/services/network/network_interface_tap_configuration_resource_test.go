@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/networkinterfaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type NetworkInterfaceTapConfigurationResource struct{}
+
+func TestAccNetworkInterfaceTapConfiguration_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface_tap_configuration", "test")
+	r := NetworkInterfaceTapConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkInterfaceTapConfiguration_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface_tap_configuration", "test")
+	r := NetworkInterfaceTapConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccNetworkInterfaceTapConfiguration_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface_tap_configuration", "test")
+	r := NetworkInterfaceTapConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r NetworkInterfaceTapConfigurationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := networkinterfaces.ParseTapConfigurationID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Network.NetworkInterfaces.NetworkInterfaceTapConfigurationsGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r NetworkInterfaceTapConfigurationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface_tap_configuration" "test" {
+  name                   = "acctest-nictap-%d"
+  network_interface_id   = azurerm_network_interface.source.id
+  virtual_network_tap_id = azurerm_virtual_network_tap.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r NetworkInterfaceTapConfigurationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface_tap_configuration" "import" {
+  name                   = azurerm_network_interface_tap_configuration.test.name
+  network_interface_id   = azurerm_network_interface_tap_configuration.test.network_interface_id
+  virtual_network_tap_id = azurerm_network_interface_tap_configuration.test.virtual_network_tap_id
+}
+`, r.basic(data))
+}
+
+func (r NetworkInterfaceTapConfigurationResource) updated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_virtual_network_tap" "other" {
+  name                                              = "acctest-vtap-other-%[2]d"
+  resource_group_name                               = azurerm_resource_group.test.name
+  location                                          = azurerm_resource_group.test.location
+  destination_network_interface_ip_configuration_id = "${azurerm_network_interface.destination.id}/ipConfigurations/internal"
+  destination_port                                  = 4790
+}
+
+resource "azurerm_network_interface_tap_configuration" "test" {
+  name                   = "acctest-nictap-%[2]d"
+  network_interface_id   = azurerm_network_interface.source.id
+  virtual_network_tap_id = azurerm_virtual_network_tap.other.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (NetworkInterfaceTapConfigurationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-nictap-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+}
+
+resource "azurerm_network_interface" "destination" {
+  name                = "acctestni-dest-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_network_interface" "source" {
+  name                = "acctestni-src-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "internal"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_virtual_network_tap" "test" {
+  name                                              = "acctest-vtap-%[1]d"
+  resource_group_name                               = azurerm_resource_group.test.name
+  location                                          = azurerm_resource_group.test.location
+  destination_network_interface_ip_configuration_id = "${azurerm_network_interface.destination.id}/ipConfigurations/internal"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,336 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-10-01/networksecurityperimeteraccessrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.ResourceWithUpdate        = NetworkSecurityPerimeterAccessRuleResource{}
+	_ sdk.ResourceWithCustomizeDiff = NetworkSecurityPerimeterAccessRuleResource{}
+)
+
+type NetworkSecurityPerimeterAccessRuleResource struct{}
+
+type NetworkSecurityPerimeterAccessRuleResourceModel struct {
+	Name                              string   `tfschema:"name"`
+	NetworkSecurityPerimeterProfileId string   `tfschema:"network_security_perimeter_profile_id"`
+	Direction                         string   `tfschema:"direction"`
+	AddressPrefixes                   []string `tfschema:"address_prefixes"`
+	FullyQualifiedDomainNames         []string `tfschema:"fully_qualified_domain_names"`
+	ServiceTags                       []string `tfschema:"service_tags"`
+	SubscriptionIds                   []string `tfschema:"subscription_ids"`
+}
+
+func (NetworkSecurityPerimeterAccessRuleResource) ResourceType() string {
+	return "azurerm_network_security_perimeter_access_rule"
+}
+
+func (NetworkSecurityPerimeterAccessRuleResource) ModelObject() interface{} {
+	return &NetworkSecurityPerimeterAccessRuleResourceModel{}
+}
+
+func (NetworkSecurityPerimeterAccessRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return networksecurityperimeteraccessrules.ValidateAccessRuleID
+}
+
+func (NetworkSecurityPerimeterAccessRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	// an Access Rule can only specify a single type of source or destination
+	sources := []string{
+		"address_prefixes",
+		"fully_qualified_domain_names",
+		"service_tags",
+		"subscription_ids",
+	}
+
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NetworkSecurityPerimeterName,
+		},
+
+		"network_security_perimeter_profile_id": commonschema.ResourceIDReferenceRequiredForceNew(&networksecurityperimeteraccessrules.ProfileId{}),
+
+		"direction": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(networksecurityperimeteraccessrules.PossibleValuesForAccessRuleDirection(), false),
+		},
+
+		"address_prefixes": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsCIDR,
+			},
+			ExactlyOneOf: sources,
+		},
+
+		"fully_qualified_domain_names": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			ExactlyOneOf: sources,
+		},
+
+		"service_tags": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			ExactlyOneOf: sources,
+		},
+
+		"subscription_ids": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: commonids.ValidateSubscriptionID,
+			},
+			ExactlyOneOf: sources,
+		},
+	}
+}
+
+func (NetworkSecurityPerimeterAccessRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r NetworkSecurityPerimeterAccessRuleResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config NetworkSecurityPerimeterAccessRuleResourceModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			switch networksecurityperimeteraccessrules.AccessRuleDirection(config.Direction) {
+			case networksecurityperimeteraccessrules.AccessRuleDirectionInbound:
+				if len(config.FullyQualifiedDomainNames) > 0 {
+					return fmt.Errorf("`fully_qualified_domain_names` can only be specified when `direction` is `Outbound`")
+				}
+			case networksecurityperimeteraccessrules.AccessRuleDirectionOutbound:
+				if len(config.ServiceTags) > 0 {
+					return fmt.Errorf("`service_tags` can only be specified when `direction` is `Inbound`")
+				}
+				if len(config.SubscriptionIds) > 0 {
+					return fmt.Errorf("`subscription_ids` can only be specified when `direction` is `Inbound`")
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r NetworkSecurityPerimeterAccessRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkSecurityPerimeterAccessRulesClient
+
+			var config NetworkSecurityPerimeterAccessRuleResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			profileId, err := networksecurityperimeteraccessrules.ParseProfileID(config.NetworkSecurityPerimeterProfileId)
+			if err != nil {
+				return err
+			}
+
+			id := networksecurityperimeteraccessrules.NewAccessRuleID(profileId.SubscriptionId, profileId.ResourceGroupName, profileId.NetworkSecurityPerimeterName, profileId.ProfileName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := networksecurityperimeteraccessrules.NspAccessRule{
+				Properties: expandNetworkSecurityPerimeterAccessRuleProperties(config),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r NetworkSecurityPerimeterAccessRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkSecurityPerimeterAccessRulesClient
+
+			id, err := networksecurityperimeteraccessrules.ParseAccessRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := NetworkSecurityPerimeterAccessRuleResourceModel{
+				Name:                              id.AccessRuleName,
+				NetworkSecurityPerimeterProfileId: networksecurityperimeteraccessrules.NewProfileID(id.SubscriptionId, id.ResourceGroupName, id.NetworkSecurityPerimeterName, id.ProfileName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Direction = string(pointer.From(props.Direction))
+					state.AddressPrefixes = pointer.From(props.AddressPrefixes)
+					state.FullyQualifiedDomainNames = pointer.From(props.FullyQualifiedDomainNames)
+					state.ServiceTags = pointer.From(props.ServiceTags)
+
+					subscriptionIds, err := flattenNetworkSecurityPerimeterAccessRuleSubscriptions(props.Subscriptions)
+					if err != nil {
+						return err
+					}
+					state.SubscriptionIds = subscriptionIds
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r NetworkSecurityPerimeterAccessRuleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkSecurityPerimeterAccessRulesClient
+
+			id, err := networksecurityperimeteraccessrules.ParseAccessRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config NetworkSecurityPerimeterAccessRuleResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the source/destination properties are mutually exclusive, so the rule is sent in full rather than patched
+			payload := networksecurityperimeteraccessrules.NspAccessRule{
+				Properties: expandNetworkSecurityPerimeterAccessRuleProperties(config),
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r NetworkSecurityPerimeterAccessRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkSecurityPerimeterAccessRulesClient
+
+			id, err := networksecurityperimeteraccessrules.ParseAccessRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandNetworkSecurityPerimeterAccessRuleProperties(input NetworkSecurityPerimeterAccessRuleResourceModel) *networksecurityperimeteraccessrules.NspAccessRuleProperties {
+	properties := &networksecurityperimeteraccessrules.NspAccessRuleProperties{
+		Direction: pointer.To(networksecurityperimeteraccessrules.AccessRuleDirection(input.Direction)),
+	}
+
+	if len(input.AddressPrefixes) > 0 {
+		properties.AddressPrefixes = pointer.To(input.AddressPrefixes)
+	}
+
+	if len(input.FullyQualifiedDomainNames) > 0 {
+		properties.FullyQualifiedDomainNames = pointer.To(input.FullyQualifiedDomainNames)
+	}
+
+	if len(input.ServiceTags) > 0 {
+		properties.ServiceTags = pointer.To(input.ServiceTags)
+	}
+
+	if len(input.SubscriptionIds) > 0 {
+		subscriptions := make([]networksecurityperimeteraccessrules.SubscriptionId, 0)
+		for _, v := range input.SubscriptionIds {
+			subscriptions = append(subscriptions, networksecurityperimeteraccessrules.SubscriptionId{
+				Id: pointer.To(v),
+			})
+		}
+		properties.Subscriptions = &subscriptions
+	}
+
+	return properties
+}
+
+func flattenNetworkSecurityPerimeterAccessRuleSubscriptions(input *[]networksecurityperimeteraccessrules.SubscriptionId) ([]string, error) {
+	output := make([]string, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	for _, v := range *input {
+		if v.Id == nil {
+			continue
+		}
+
+		subscriptionId, err := commonids.ParseSubscriptionIDInsensitively(*v.Id)
+		if err != nil {
+			return nil, err
+		}
+		output = append(output, subscriptionId.ID())
+	}
+
+	return output, nil
+}
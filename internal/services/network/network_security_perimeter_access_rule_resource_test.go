@@ -0,0 +1,187 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-10-01/networksecurityperimeteraccessrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type NetworkSecurityPerimeterAccessRuleResource struct{}
+
+func TestAccNetworkSecurityPerimeterAccessRule_addressPrefixes(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_perimeter_access_rule", "test")
+	r := NetworkSecurityPerimeterAccessRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.addressPrefixes(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkSecurityPerimeterAccessRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_perimeter_access_rule", "test")
+	r := NetworkSecurityPerimeterAccessRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.addressPrefixes(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccNetworkSecurityPerimeterAccessRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_perimeter_access_rule", "test")
+	r := NetworkSecurityPerimeterAccessRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.addressPrefixes(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.subscriptionIds(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.addressPrefixes(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkSecurityPerimeterAccessRule_outboundFqdns(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_perimeter_access_rule", "test")
+	r := NetworkSecurityPerimeterAccessRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.outboundFqdns(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkSecurityPerimeterAccessRule_inboundFqdnsInvalid(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_security_perimeter_access_rule", "test")
+	r := NetworkSecurityPerimeterAccessRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.inboundFqdns(data),
+			ExpectError: regexp.MustCompile("`fully_qualified_domain_names` can only be specified when `direction` is `Outbound`"),
+		},
+	})
+}
+
+func (r NetworkSecurityPerimeterAccessRuleResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := networksecurityperimeteraccessrules.ParseAccessRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Network.NetworkSecurityPerimeterAccessRulesClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r NetworkSecurityPerimeterAccessRuleResource) addressPrefixes(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_security_perimeter_access_rule" "test" {
+  name                                  = "acctest-nsprule-%d"
+  network_security_perimeter_profile_id = azurerm_network_security_perimeter_profile.test.id
+  direction                             = "Inbound"
+  address_prefixes                      = ["10.0.0.0/16", "192.168.1.0/24"]
+}
+`, NetworkSecurityPerimeterProfileResource{}.basic(data), data.RandomInteger)
+}
+
+func (r NetworkSecurityPerimeterAccessRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_security_perimeter_access_rule" "import" {
+  name                                  = azurerm_network_security_perimeter_access_rule.test.name
+  network_security_perimeter_profile_id = azurerm_network_security_perimeter_access_rule.test.network_security_perimeter_profile_id
+  direction                             = azurerm_network_security_perimeter_access_rule.test.direction
+  address_prefixes                      = azurerm_network_security_perimeter_access_rule.test.address_prefixes
+}
+`, r.addressPrefixes(data))
+}
+
+func (r NetworkSecurityPerimeterAccessRuleResource) subscriptionIds(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_network_security_perimeter_access_rule" "test" {
+  name                                  = "acctest-nsprule-%d"
+  network_security_perimeter_profile_id = azurerm_network_security_perimeter_profile.test.id
+  direction                             = "Inbound"
+  subscription_ids                      = [data.azurerm_subscription.current.id]
+}
+`, NetworkSecurityPerimeterProfileResource{}.basic(data), data.RandomInteger)
+}
+
+func (r NetworkSecurityPerimeterAccessRuleResource) outboundFqdns(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_security_perimeter_access_rule" "test" {
+  name                                  = "acctest-nsprule-%d"
+  network_security_perimeter_profile_id = azurerm_network_security_perimeter_profile.test.id
+  direction                             = "Outbound"
+  fully_qualified_domain_names          = ["www.contoso.com", "login.contoso.com"]
+}
+`, NetworkSecurityPerimeterProfileResource{}.basic(data), data.RandomInteger)
+}
+
+func (r NetworkSecurityPerimeterAccessRuleResource) inboundFqdns(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_security_perimeter_access_rule" "test" {
+  name                                  = "acctest-nsprule-%d"
+  network_security_perimeter_profile_id = azurerm_network_security_perimeter_profile.test.id
+  direction                             = "Inbound"
+  fully_qualified_domain_names          = ["www.contoso.com"]
+}
+`, NetworkSecurityPerimeterProfileResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type NetworkSecurityPerimeterDataSource struct{}
+
+func TestAccNetworkSecurityPerimeterDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_security_perimeter", "test")
+	d := NetworkSecurityPerimeterDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("location").Exists(),
+				check.That(data.ResourceName).Key("perimeter_guid").IsNotEmpty(),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (d NetworkSecurityPerimeterDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_security_perimeter" "test" {
+  name                = azurerm_network_security_perimeter.test.name
+  resource_group_name = azurerm_network_security_perimeter.test.resource_group_name
+}
+`, NetworkSecurityPerimeterResource{}.complete(data))
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-10-01/networksecurityperimeterprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type NetworkSecurityPerimeterProfileDataSource struct{}
+
+var _ sdk.DataSource = NetworkSecurityPerimeterProfileDataSource{}
+
+func (NetworkSecurityPerimeterProfileDataSource) ResourceType() string {
+	return "azurerm_network_security_perimeter_profile"
+}
+
+func (NetworkSecurityPerimeterProfileDataSource) ModelObject() interface{} {
+	return &NetworkSecurityPerimeterProfileResourceModel{}
+}
+
+func (NetworkSecurityPerimeterProfileDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.NetworkSecurityPerimeterName,
+		},
+
+		"network_security_perimeter_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: networksecurityperimeterprofiles.ValidateNetworkSecurityPerimeterID,
+		},
+	}
+}
+
+func (NetworkSecurityPerimeterProfileDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"access_rules_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (NetworkSecurityPerimeterProfileDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkSecurityPerimeterProfilesClient
+
+			var config NetworkSecurityPerimeterProfileResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			perimeterId, err := networksecurityperimeterprofiles.ParseNetworkSecurityPerimeterID(config.NetworkSecurityPerimeterId)
+			if err != nil {
+				return err
+			}
+
+			id := networksecurityperimeterprofiles.NewProfileID(perimeterId.SubscriptionId, perimeterId.ResourceGroupName, perimeterId.NetworkSecurityPerimeterName, config.Name)
+
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := NetworkSecurityPerimeterProfileResourceModel{
+				Name:                       id.ProfileName,
+				NetworkSecurityPerimeterId: perimeterId.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.AccessRulesVersion = pointer.From(props.AccessRulesVersion)
+				}
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
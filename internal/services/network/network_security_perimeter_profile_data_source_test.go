@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type NetworkSecurityPerimeterProfileDataSource struct{}
+
+func TestAccNetworkSecurityPerimeterProfileDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_security_perimeter_profile", "test")
+	d := NetworkSecurityPerimeterProfileDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("network_security_perimeter_id").Exists(),
+			),
+		},
+	})
+}
+
+func (d NetworkSecurityPerimeterProfileDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_security_perimeter_profile" "test" {
+  name                          = azurerm_network_security_perimeter_profile.test.name
+  network_security_perimeter_id = azurerm_network_security_perimeter_profile.test.network_security_perimeter_id
+}
+`, NetworkSecurityPerimeterProfileResource{}.basic(data))
+}
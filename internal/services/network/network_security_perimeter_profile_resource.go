@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-10-01/networksecurityperimeterprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.Resource = NetworkSecurityPerimeterProfileResource{}
+
+type NetworkSecurityPerimeterProfileResource struct{}
+
+type NetworkSecurityPerimeterProfileResourceModel struct {
+	Name                       string `tfschema:"name"`
+	NetworkSecurityPerimeterId string `tfschema:"network_security_perimeter_id"`
+	AccessRulesVersion         string `tfschema:"access_rules_version"`
+}
+
+func (NetworkSecurityPerimeterProfileResource) ResourceType() string {
+	return "azurerm_network_security_perimeter_profile"
+}
+
+func (NetworkSecurityPerimeterProfileResource) ModelObject() interface{} {
+	return &NetworkSecurityPerimeterProfileResourceModel{}
+}
+
+func (NetworkSecurityPerimeterProfileResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return networksecurityperimeterprofiles.ValidateProfileID
+}
+
+func (NetworkSecurityPerimeterProfileResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NetworkSecurityPerimeterName,
+		},
+
+		"network_security_perimeter_id": commonschema.ResourceIDReferenceRequiredForceNew(&networksecurityperimeterprofiles.NetworkSecurityPerimeterId{}),
+	}
+}
+
+func (NetworkSecurityPerimeterProfileResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"access_rules_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r NetworkSecurityPerimeterProfileResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkSecurityPerimeterProfilesClient
+
+			var config NetworkSecurityPerimeterProfileResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			perimeterId, err := networksecurityperimeterprofiles.ParseNetworkSecurityPerimeterID(config.NetworkSecurityPerimeterId)
+			if err != nil {
+				return err
+			}
+
+			id := networksecurityperimeterprofiles.NewProfileID(perimeterId.SubscriptionId, perimeterId.ResourceGroupName, perimeterId.NetworkSecurityPerimeterName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := networksecurityperimeterprofiles.NspProfile{
+				Properties: &networksecurityperimeterprofiles.NspProfileProperties{},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r NetworkSecurityPerimeterProfileResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkSecurityPerimeterProfilesClient
+
+			id, err := networksecurityperimeterprofiles.ParseProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := NetworkSecurityPerimeterProfileResourceModel{
+				Name:                       id.ProfileName,
+				NetworkSecurityPerimeterId: networksecurityperimeterprofiles.NewNetworkSecurityPerimeterID(id.SubscriptionId, id.ResourceGroupName, id.NetworkSecurityPerimeterName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.AccessRulesVersion = pointer.From(props.AccessRulesVersion)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r NetworkSecurityPerimeterProfileResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkSecurityPerimeterProfilesClient
+
+			id, err := networksecurityperimeterprofiles.ParseProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
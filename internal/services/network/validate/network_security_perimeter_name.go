@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// NetworkSecurityPerimeterName validates the name of a Network Security Perimeter, as well as the names of its
+// Profiles, Access Rules and Resource Associations which share the same rules
+func NetworkSecurityPerimeterName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if matched := regexp.MustCompile(`^[0-9a-zA-Z]([0-9a-zA-Z_.-]{0,78}[0-9a-zA-Z_])?$`).MatchString(value); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 80 characters in length. It must begin with a letter or number, end with a letter, number or underscore, and may contain only letters, numbers, underscores, periods or hyphens", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNetworkSecurityPerimeterName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "a",
+			Valid: true,
+		},
+		{
+			Input: "-perimeter",
+			Valid: false,
+		},
+		{
+			Input: "perimeter-",
+			Valid: false,
+		},
+		{
+			Input: "perimeter_",
+			Valid: true,
+		},
+		{
+			Input: "my.perimeter-1_a",
+			Valid: true,
+		},
+		{
+			Input: strings.Repeat("s", 80),
+			Valid: true,
+		},
+		{
+			Input: strings.Repeat("s", 81),
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := NetworkSecurityPerimeterName(tc.Input, "name")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for %q", tc.Valid, valid, tc.Input)
+		}
+	}
+}
@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/loadbalancers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/virtualnetworktap"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name virtual_network_tap -service-package-name network -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
+var (
+	_ sdk.ResourceWithUpdate   = VirtualNetworkTapResource{}
+	_ sdk.ResourceWithIdentity = VirtualNetworkTapResource{}
+)
+
+type VirtualNetworkTapResource struct{}
+
+func (VirtualNetworkTapResource) Identity() resourceids.ResourceId {
+	return &virtualnetworktap.VirtualNetworkTapId{}
+}
+
+func (VirtualNetworkTapResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return virtualnetworktap.ValidateVirtualNetworkTapID
+}
+
+func (VirtualNetworkTapResource) ResourceType() string {
+	return "azurerm_virtual_network_tap"
+}
+
+func (VirtualNetworkTapResource) ModelObject() interface{} {
+	return &VirtualNetworkTapResourceModel{}
+}
+
+type VirtualNetworkTapResourceModel struct {
+	Name                                             string            `tfschema:"name"`
+	ResourceGroupName                                string            `tfschema:"resource_group_name"`
+	Location                                         string            `tfschema:"location"`
+	DestinationLoadBalancerFrontendIPConfigurationId string            `tfschema:"destination_load_balancer_frontend_ip_configuration_id"`
+	DestinationNetworkInterfaceIPConfigurationId     string            `tfschema:"destination_network_interface_ip_configuration_id"`
+	DestinationPort                                  int64             `tfschema:"destination_port"`
+	Tags                                             map[string]string `tfschema:"tags"`
+}
+
+func (VirtualNetworkTapResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9_.-]{0,78}[a-zA-Z0-9_])?$`),
+				"`name` must be between 1 and 80 characters long, begin with a letter or number, end with a letter, number or underscore, and can only contain letters, numbers, underscores(_), periods(.), and hyphens(-).",
+			),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"destination_load_balancer_frontend_ip_configuration_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: loadbalancers.ValidateFrontendIPConfigurationID,
+			ExactlyOneOf: []string{
+				"destination_load_balancer_frontend_ip_configuration_id",
+				"destination_network_interface_ip_configuration_id",
+			},
+		},
+
+		"destination_network_interface_ip_configuration_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: commonids.ValidateNetworkInterfaceIPConfigurationID,
+			ExactlyOneOf: []string{
+				"destination_load_balancer_frontend_ip_configuration_id",
+				"destination_network_interface_ip_configuration_id",
+			},
+		},
+
+		"destination_port": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      4789,
+			ValidateFunc: validation.IsPortNumber,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (VirtualNetworkTapResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r VirtualNetworkTapResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.VirtualNetworkTap
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var config VirtualNetworkTapResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := virtualnetworktap.NewVirtualNetworkTapID(subscriptionId, config.ResourceGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := virtualnetworktap.VirtualNetworkTap{
+				Location:   pointer.To(location.Normalize(config.Location)),
+				Properties: expandVirtualNetworkTapProperties(config),
+				Tags:       pointer.To(config.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r VirtualNetworkTapResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.VirtualNetworkTap
+
+			id, err := virtualnetworktap.ParseVirtualNetworkTapID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := VirtualNetworkTapResourceModel{
+				Name:              id.VirtualNetworkTapName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.NormalizeNilable(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.DestinationPort = pointer.From(props.DestinationPort)
+
+					if v := props.DestinationLoadBalancerFrontEndIPConfiguration; v != nil && v.Id != nil {
+						frontendId, err := loadbalancers.ParseFrontendIPConfigurationIDInsensitively(*v.Id)
+						if err != nil {
+							return err
+						}
+						state.DestinationLoadBalancerFrontendIPConfigurationId = frontendId.ID()
+					}
+
+					if v := props.DestinationNetworkInterfaceIPConfiguration; v != nil && v.Id != nil {
+						ipConfigurationId, err := commonids.ParseNetworkInterfaceIPConfigurationIDInsensitively(*v.Id)
+						if err != nil {
+							return err
+						}
+						state.DestinationNetworkInterfaceIPConfigurationId = ipConfigurationId.ID()
+					}
+				}
+			}
+
+			if err := pluginsdk.SetResourceIdentityData(metadata.ResourceData, id); err != nil {
+				return err
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r VirtualNetworkTapResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.VirtualNetworkTap
+
+			id, err := virtualnetworktap.ParseVirtualNetworkTapID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config VirtualNetworkTapResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChanges("destination_load_balancer_frontend_ip_configuration_id", "destination_network_interface_ip_configuration_id", "destination_port") {
+				// the Network Interface TAP Configurations referencing this TAP are managed separately, so are retained
+				properties := expandVirtualNetworkTapProperties(config)
+				properties.NetworkInterfaceTapConfigurations = payload.Properties.NetworkInterfaceTapConfigurations
+				payload.Properties = properties
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(config.Tags)
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r VirtualNetworkTapResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.VirtualNetworkTap
+
+			id, err := virtualnetworktap.ParseVirtualNetworkTapID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandVirtualNetworkTapProperties(input VirtualNetworkTapResourceModel) *virtualnetworktap.VirtualNetworkTapPropertiesFormat {
+	properties := &virtualnetworktap.VirtualNetworkTapPropertiesFormat{
+		DestinationPort: pointer.To(input.DestinationPort),
+	}
+
+	if input.DestinationLoadBalancerFrontendIPConfigurationId != "" {
+		properties.DestinationLoadBalancerFrontEndIPConfiguration = &virtualnetworktap.FrontendIPConfiguration{
+			Id: pointer.To(input.DestinationLoadBalancerFrontendIPConfigurationId),
+		}
+	}
+
+	if input.DestinationNetworkInterfaceIPConfigurationId != "" {
+		properties.DestinationNetworkInterfaceIPConfiguration = &virtualnetworktap.NetworkInterfaceIPConfiguration{
+			Id: pointer.To(input.DestinationNetworkInterfaceIPConfigurationId),
+		}
+	}
+
+	return properties
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2025-03-01/autonomousdatabases"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -51,7 +52,8 @@ type AutonomousDatabaseRegularResourceModel struct {
 	AllowedIps                   []string                        `tfschema:"allowed_ips"`
 
 	// Optional
-	CustomerContacts []string `tfschema:"customer_contacts"`
+	AdminPasswordWoVersion int64    `tfschema:"admin_password_wo_version"`
+	CustomerContacts       []string `tfschema:"customer_contacts"`
 }
 
 func (AutonomousDatabaseRegularResource) Arguments() map[string]*pluginsdk.Schema {
@@ -70,10 +72,28 @@ func (AutonomousDatabaseRegularResource) Arguments() map[string]*pluginsdk.Schem
 		// Required
 		"admin_password": {
 			Type:         pluginsdk.TypeString,
-			Required:     true,
+			Optional:     true,
 			Sensitive:    true,
 			ForceNew:     true,
 			ValidateFunc: validate.AutonomousDatabasePassword,
+			ExactlyOneOf: []string{"admin_password", "admin_password_wo"},
+		},
+
+		"admin_password_wo": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			WriteOnly:    true,
+			ValidateFunc: validate.AutonomousDatabasePassword,
+			ExactlyOneOf: []string{"admin_password", "admin_password_wo"},
+			RequiredWith: []string{"admin_password_wo_version"},
+		},
+
+		"admin_password_wo_version": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			RequiredWith: []string{"admin_password_wo"},
 		},
 
 		"backup_retention_period_in_days": {
@@ -268,8 +288,17 @@ func (r AutonomousDatabaseRegularResource) Create() sdk.ResourceFunc {
 			if !response.WasNotFound(existing.HttpResponse) {
 				return metadata.ResourceRequiresImport(r.ResourceType(), id)
 			}
+			adminPassword := model.AdminPassword
+			adminPasswordWo, err := pluginsdk.GetWriteOnly(metadata.ResourceData, "admin_password_wo", cty.String)
+			if err != nil {
+				return err
+			}
+			if !adminPasswordWo.IsNull() {
+				adminPassword = adminPasswordWo.AsString()
+			}
+
 			properties := &autonomousdatabases.AutonomousDatabaseProperties{
-				AdminPassword:                  pointer.To(model.AdminPassword),
+				AdminPassword:                  pointer.To(adminPassword),
 				BackupRetentionPeriodInDays:    pointer.To(model.BackupRetentionPeriodInDays),
 				CharacterSet:                   pointer.To(model.CharacterSet),
 				ComputeCount:                   pointer.To(model.ComputeCount),
@@ -430,6 +459,7 @@ func (AutonomousDatabaseRegularResource) Read() sdk.ResourceFunc {
 					return fmt.Errorf("%s was not of type `Regular`", id)
 				}
 				state.AdminPassword = metadata.ResourceData.Get("admin_password").(string)
+				state.AdminPasswordWoVersion = int64(metadata.ResourceData.Get("admin_password_wo_version").(int))
 				state.AutoScalingEnabled = pointer.From(props.IsAutoScalingEnabled)
 				state.BackupRetentionPeriodInDays = pointer.From(props.BackupRetentionPeriodInDays)
 				state.AutoScalingForStorageEnabled = pointer.From(props.IsAutoScalingForStorageEnabled)
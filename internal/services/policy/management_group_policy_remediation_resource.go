@@ -99,6 +99,12 @@ func resourceArmManagementGroupPolicyRemediation() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
 			},
+
+			"wait_for_completion_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 
@@ -159,6 +165,15 @@ func resourceArmManagementGroupPolicyRemediationCreateUpdate(d *pluginsdk.Resour
 
 	d.SetId(id.ID())
 
+	if d.Get("wait_for_completion_enabled").(bool) {
+		if err := waitForRemediationToComplete(ctx, id.ID(), func() (*remediations.Remediation, error) {
+			resp, err := client.GetAtManagementGroup(ctx, id)
+			return resp.Model, err
+		}); err != nil {
+			return err
+		}
+	}
+
 	return resourceArmManagementGroupPolicyRemediationRead(d, meta)
 }
 
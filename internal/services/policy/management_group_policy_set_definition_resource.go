@@ -378,7 +378,7 @@ func (r ManagementGroupPolicySetDefinitionResource) CustomizeDiff() sdk.Resource
 				}
 			}
 
-			return nil
+			return validatePolicySetDefinitionParametersDiff(metadata.ResourceDiff)
 		},
 	}
 }
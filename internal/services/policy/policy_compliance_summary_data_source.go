@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/policyinsights/2024-10-01/policystates"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type PolicyComplianceSummaryDataSource struct{}
+
+var _ sdk.DataSource = PolicyComplianceSummaryDataSource{}
+
+type PolicyComplianceSummaryDataSourceModel struct {
+	ScopeId                   string                                   `tfschema:"scope_id"`
+	PolicyAssignmentId        string                                   `tfschema:"policy_assignment_id"`
+	PolicyDefinitionId        string                                   `tfschema:"policy_definition_id"`
+	CompliantResourceCount    int64                                    `tfschema:"compliant_resource_count"`
+	NonCompliantResourceCount int64                                    `tfschema:"non_compliant_resource_count"`
+	NonCompliantPolicyCount   int64                                    `tfschema:"non_compliant_policy_count"`
+	PolicyAssignments         []PolicyComplianceSummaryAssignmentModel `tfschema:"policy_assignment"`
+}
+
+type PolicyComplianceSummaryAssignmentModel struct {
+	PolicyAssignmentId        string                                   `tfschema:"policy_assignment_id"`
+	PolicySetDefinitionId     string                                   `tfschema:"policy_set_definition_id"`
+	CompliantResourceCount    int64                                    `tfschema:"compliant_resource_count"`
+	NonCompliantResourceCount int64                                    `tfschema:"non_compliant_resource_count"`
+	NonCompliantPolicyCount   int64                                    `tfschema:"non_compliant_policy_count"`
+	PolicyDefinitions         []PolicyComplianceSummaryDefinitionModel `tfschema:"policy_definition"`
+}
+
+type PolicyComplianceSummaryDefinitionModel struct {
+	PolicyDefinitionId          string `tfschema:"policy_definition_id"`
+	PolicyDefinitionReferenceId string `tfschema:"policy_definition_reference_id"`
+	Effect                      string `tfschema:"effect"`
+	CompliantResourceCount      int64  `tfschema:"compliant_resource_count"`
+	NonCompliantResourceCount   int64  `tfschema:"non_compliant_resource_count"`
+}
+
+func (PolicyComplianceSummaryDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"scope_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.Any(
+				commonids.ValidateManagementGroupID,
+				commonids.ValidateSubscriptionID,
+				commonids.ValidateResourceGroupID,
+				azure.ValidateResourceID,
+			),
+		},
+
+		"policy_assignment_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.PolicyAssignmentID,
+		},
+
+		"policy_definition_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (PolicyComplianceSummaryDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"compliant_resource_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"non_compliant_resource_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"non_compliant_policy_count": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"policy_assignment": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"policy_assignment_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"policy_set_definition_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"compliant_resource_count": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"non_compliant_resource_count": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"non_compliant_policy_count": {
+						Type:     pluginsdk.TypeInt,
+						Computed: true,
+					},
+
+					"policy_definition": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"policy_definition_id": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"policy_definition_reference_id": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"effect": {
+									Type:     pluginsdk.TypeString,
+									Computed: true,
+								},
+
+								"compliant_resource_count": {
+									Type:     pluginsdk.TypeInt,
+									Computed: true,
+								},
+
+								"non_compliant_resource_count": {
+									Type:     pluginsdk.TypeInt,
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (PolicyComplianceSummaryDataSource) ModelObject() interface{} {
+	return &PolicyComplianceSummaryDataSourceModel{}
+}
+
+func (PolicyComplianceSummaryDataSource) ResourceType() string {
+	return "azurerm_policy_compliance_summary"
+}
+
+func (PolicyComplianceSummaryDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Policy.PolicyStatesClient
+
+			var state PolicyComplianceSummaryDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := policystates.NewScopedPolicyStatesResourceID(state.ScopeId, policystates.PolicyStatesResourceLatest)
+
+			filters := make([]string, 0)
+			if state.PolicyAssignmentId != "" {
+				filters = append(filters, fmt.Sprintf("policyAssignmentId eq '%s'", state.PolicyAssignmentId))
+			}
+			if state.PolicyDefinitionId != "" {
+				filters = append(filters, fmt.Sprintf("policyDefinitionId eq '%s'", state.PolicyDefinitionId))
+			}
+			var filter *string
+			if len(filters) > 0 {
+				filter = pointer.To(strings.Join(filters, " and "))
+			}
+
+			result, err := summarizePolicyStates(ctx, client, state.ScopeId, filter)
+			if err != nil {
+				return fmt.Errorf("summarizing %s: %+v", id, err)
+			}
+
+			// the summary for the scope is always returned as a single item
+			if result != nil && result.Value != nil && len(*result.Value) > 0 {
+				summary := (*result.Value)[0]
+
+				state.CompliantResourceCount, state.NonCompliantResourceCount, state.NonCompliantPolicyCount = flattenPolicyComplianceSummaryResults(summary.Results)
+				state.PolicyAssignments = flattenPolicyComplianceSummaryAssignments(summary.PolicyAssignments)
+			}
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+// summarizePolicyStates summarizes the latest Policy States for the Management Group, Subscription, Resource Group or
+// Resource specified in `scopeId`, since each scope is exposed as a separate operation
+func summarizePolicyStates(ctx context.Context, client *policystates.PolicyStatesClient, scopeId string, filter *string) (*policystates.SummarizeResults, error) {
+	if id, err := commonids.ParseManagementGroupIDInsensitively(scopeId); err == nil {
+		resp, err := client.SummarizeForManagementGroup(ctx, policystates.NewManagementGroupID(id.GroupId), policystates.SummarizeForManagementGroupOperationOptions{Filter: filter})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Model, nil
+	}
+
+	if id, err := commonids.ParseSubscriptionIDInsensitively(scopeId); err == nil {
+		resp, err := client.SummarizeForSubscription(ctx, *id, policystates.SummarizeForSubscriptionOperationOptions{Filter: filter})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Model, nil
+	}
+
+	if id, err := commonids.ParseResourceGroupIDInsensitively(scopeId); err == nil {
+		resp, err := client.SummarizeForResourceGroup(ctx, *id, policystates.SummarizeForResourceGroupOperationOptions{Filter: filter})
+		if err != nil {
+			return nil, err
+		}
+		return resp.Model, nil
+	}
+
+	resp, err := client.SummarizeForResource(ctx, commonids.NewScopeID(scopeId), policystates.SummarizeForResourceOperationOptions{Filter: filter})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Model, nil
+}
+
+func flattenPolicyComplianceSummaryResults(input *policystates.SummaryResults) (compliantResources int64, nonCompliantResources int64, nonCompliantPolicies int64) {
+	if input == nil {
+		return
+	}
+
+	nonCompliantResources = pointer.From(input.NonCompliantResources)
+	nonCompliantPolicies = pointer.From(input.NonCompliantPolicies)
+
+	if input.ResourceDetails != nil {
+		for _, v := range *input.ResourceDetails {
+			if strings.EqualFold(pointer.From(v.ComplianceState), "compliant") {
+				compliantResources += pointer.From(v.Count)
+			}
+		}
+	}
+
+	return
+}
+
+func flattenPolicyComplianceSummaryAssignments(input *[]policystates.PolicyAssignmentSummary) []PolicyComplianceSummaryAssignmentModel {
+	output := make([]PolicyComplianceSummaryAssignmentModel, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		assignment := PolicyComplianceSummaryAssignmentModel{
+			PolicyAssignmentId:    pointer.From(v.PolicyAssignmentId),
+			PolicySetDefinitionId: pointer.From(v.PolicySetDefinitionId),
+			PolicyDefinitions:     make([]PolicyComplianceSummaryDefinitionModel, 0),
+		}
+		assignment.CompliantResourceCount, assignment.NonCompliantResourceCount, assignment.NonCompliantPolicyCount = flattenPolicyComplianceSummaryResults(v.Results)
+
+		if v.PolicyDefinitions != nil {
+			for _, definition := range *v.PolicyDefinitions {
+				item := PolicyComplianceSummaryDefinitionModel{
+					PolicyDefinitionId:          pointer.From(definition.PolicyDefinitionId),
+					PolicyDefinitionReferenceId: pointer.From(definition.PolicyDefinitionReferenceId),
+					Effect:                      pointer.From(definition.Effect),
+				}
+				item.CompliantResourceCount, item.NonCompliantResourceCount, _ = flattenPolicyComplianceSummaryResults(definition.Results)
+
+				assignment.PolicyDefinitions = append(assignment.PolicyDefinitions, item)
+			}
+		}
+
+		output = append(output, assignment)
+	}
+
+	return output
+}
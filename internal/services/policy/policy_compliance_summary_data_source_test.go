@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type PolicyComplianceSummaryDataSource struct{}
+
+func TestAccDataSourcePolicyComplianceSummary_resourceGroup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_policy_compliance_summary", "test")
+	d := PolicyComplianceSummaryDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.resourceGroup(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("compliant_resource_count").Exists(),
+				check.That(data.ResourceName).Key("non_compliant_resource_count").Exists(),
+				check.That(data.ResourceName).Key("non_compliant_policy_count").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccDataSourcePolicyComplianceSummary_subscription(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_policy_compliance_summary", "test")
+	d := PolicyComplianceSummaryDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.subscription(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("compliant_resource_count").Exists(),
+				check.That(data.ResourceName).Key("non_compliant_resource_count").Exists(),
+			),
+		},
+	})
+}
+
+func (PolicyComplianceSummaryDataSource) resourceGroup(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-policy-%[1]d"
+  location = "%[2]s"
+}
+
+data "azurerm_policy_definition" "test" {
+  display_name = "Allowed locations"
+}
+
+resource "azurerm_resource_group_policy_assignment" "test" {
+  name                 = "acctestpa-%[1]d"
+  resource_group_id    = azurerm_resource_group.test.id
+  policy_definition_id = data.azurerm_policy_definition.test.id
+
+  parameters = jsonencode({
+    "listOfAllowedLocations" = {
+      "value" = [azurerm_resource_group.test.location]
+    }
+  })
+}
+
+data "azurerm_policy_compliance_summary" "test" {
+  scope_id             = azurerm_resource_group.test.id
+  policy_assignment_id = azurerm_resource_group_policy_assignment.test.id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (PolicyComplianceSummaryDataSource) subscription() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {}
+
+data "azurerm_policy_compliance_summary" "test" {
+  scope_id = data.azurerm_subscription.current.id
+}
+`
+}
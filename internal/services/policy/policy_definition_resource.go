@@ -69,7 +69,7 @@ func resourceArmPolicyDefinition() *pluginsdk.Resource {
 				}
 			}
 
-			return nil
+			return validatePolicyRuleDiff(ctx, d, v.(*clients.Client).Resource.ResourceProvidersClient)
 		}),
 	}
 }
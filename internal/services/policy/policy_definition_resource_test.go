@@ -6,6 +6,7 @@ package policy_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2021-06-01-preview/policy" // nolint: staticcheck
@@ -175,6 +176,26 @@ func TestAccAzureRMPolicyDefinition_removeParameter(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMPolicyDefinition_invalidPolicyRule(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_policy_definition", "test")
+	r := PolicyDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.invalidEffect(data),
+			ExpectError: regexp.MustCompile("the effect \"block\" is not supported"),
+		},
+		{
+			Config:      r.undeclaredParameter(data),
+			ExpectError: regexp.MustCompile("the Parameter \"allowedLocations\" is referenced but is not declared"),
+		},
+		{
+			Config:      r.unknownAlias(data),
+			ExpectError: regexp.MustCompile("is not a known alias for the Resource Provider"),
+		},
+	})
+}
+
 func (r PolicyDefinitionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	definitionsClient := client.Policy.DefinitionsClient
 	id, err := parse.PolicyDefinitionID(state.ID)
@@ -550,3 +571,66 @@ PARAMETERS
 }
 `, data.RandomInteger, data.RandomInteger)
 }
+
+func (r PolicyDefinitionResource) invalidEffect(data acceptance.TestData) string {
+	return r.withPolicyRule(data, `
+  {
+    "if": {
+      "field": "type",
+      "equals": "Microsoft.Storage/storageAccounts"
+    },
+    "then": {
+      "effect": "block"
+    }
+  }
+`)
+}
+
+func (r PolicyDefinitionResource) undeclaredParameter(data acceptance.TestData) string {
+	return r.withPolicyRule(data, `
+  {
+    "if": {
+      "not": {
+        "field": "location",
+        "in": "[parameters('allowedLocations')]"
+      }
+    },
+    "then": {
+      "effect": "audit"
+    }
+  }
+`)
+}
+
+func (r PolicyDefinitionResource) unknownAlias(data acceptance.TestData) string {
+	return r.withPolicyRule(data, `
+  {
+    "if": {
+      "field": "Microsoft.Storage/storageAccounts/doesNotExist",
+      "equals": "true"
+    },
+    "then": {
+      "effect": "audit"
+    }
+  }
+`)
+}
+
+func (r PolicyDefinitionResource) withPolicyRule(data acceptance.TestData, policyRule string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_policy_definition" "test" {
+  name         = "acctestpol-%[1]d"
+  policy_type  = "Custom"
+  mode         = "All"
+  display_name = "acctestpol-%[1]d"
+
+  policy_rule = <<POLICY_RULE
+%[2]s
+POLICY_RULE
+}
+`, data.RandomInteger, policyRule)
+}
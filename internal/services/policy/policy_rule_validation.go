@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policy
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2022-09-01/providers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/policy/policyrule"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// policyAliasCache caches the aliases for each Resource Provider, since these are retrieved when planning and a
+// configuration can contain many Policy Definitions referencing the same Resource Providers. Resource Providers
+// which don't exist are cached with a nil value.
+var policyAliasCache = struct {
+	sync.Mutex
+	namespaces map[string]map[string]struct{}
+}{
+	namespaces: make(map[string]map[string]struct{}),
+}
+
+// validatePolicyRuleDiff validates the `policy_rule` of a Policy Definition at plan time - checking the structure of
+// the rule, that the effect is supported, that the fields are known aliases and that any referenced Parameters are
+// declared in `parameters`
+func validatePolicyRuleDiff(ctx context.Context, d *pluginsdk.ResourceDiff, client *providers.ProvidersClient) error {
+	if !d.HasChanges("policy_rule", "parameters", "mode") {
+		return nil
+	}
+	if !d.NewValueKnown("policy_rule") || !d.NewValueKnown("parameters") || !d.NewValueKnown("mode") {
+		return nil
+	}
+
+	ruleRaw := d.Get("policy_rule").(string)
+	if ruleRaw == "" {
+		return nil
+	}
+
+	// invalid JSON is reported by the validation on the fields themselves
+	var rule interface{}
+	if err := json.Unmarshal([]byte(ruleRaw), &rule); err != nil {
+		return nil
+	}
+	parameters := make(map[string]interface{})
+	if v := d.Get("parameters").(string); v != "" {
+		if err := json.Unmarshal([]byte(v), &parameters); err != nil {
+			return nil
+		}
+	}
+
+	errs := policyrule.Validate(rule, parameters)
+
+	// the fields within the data plane modes refer to data plane aliases, which aren't exposed by the Resource Providers
+	if mode := d.Get("mode").(string); strings.EqualFold(mode, "All") || strings.EqualFold(mode, "Indexed") {
+		errs = append(errs, validatePolicyRuleAliases(ctx, client, policyrule.Aliases(rule))...)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("validating `policy_rule`: %+v", errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// validatePolicyRuleAliases checks that each alias is exposed by its Resource Provider. Aliases for Resource Providers
+// which can't be retrieved (e.g. due to permissions) are skipped, since these are validated when the Policy Definition
+// is created.
+func validatePolicyRuleAliases(ctx context.Context, client *providers.ProvidersClient, aliases []string) []error {
+	errs := make([]error, 0)
+
+	for _, alias := range aliases {
+		namespace := strings.Split(alias, "/")[0]
+
+		known, exists, err := policyAliasesForResourceProvider(ctx, client, namespace)
+		if err != nil {
+			log.Printf("[DEBUG] unable to retrieve the aliases for the Resource Provider %q, skipping validation of %q: %+v", namespace, alias, err)
+			continue
+		}
+		if !exists {
+			errs = append(errs, fmt.Errorf("the field %q refers to the Resource Provider %q which was not found", alias, namespace))
+			continue
+		}
+		if _, ok := known[strings.ToLower(alias)]; !ok {
+			errs = append(errs, fmt.Errorf("the field %q is not a known alias for the Resource Provider %q", alias, namespace))
+		}
+	}
+
+	return errs
+}
+
+// policyAliasesForResourceProvider returns the lower-cased aliases exposed by the Resource Provider and whether the
+// Resource Provider exists
+func policyAliasesForResourceProvider(ctx context.Context, client *providers.ProvidersClient, namespace string) (map[string]struct{}, bool, error) {
+	key := strings.ToLower(namespace)
+
+	// the lock is only held whilst reading from/writing to the cache so that a slow request doesn't block the other
+	// lookups - concurrent lookups for the same Resource Provider may both retrieve it, which is fine since the
+	// aliases are the same
+	policyAliasCache.Lock()
+	aliases, ok := policyAliasCache.namespaces[key]
+	policyAliasCache.Unlock()
+	if ok {
+		return aliases, aliases != nil, nil
+	}
+
+	id := providers.NewProviderID(namespace)
+	resp, err := client.GetAtTenantScope(ctx, id, providers.GetAtTenantScopeOperationOptions{
+		Expand: pointer.To("resourceTypes/aliases"),
+	})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			policyAliasCache.Lock()
+			policyAliasCache.namespaces[key] = nil
+			policyAliasCache.Unlock()
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	aliases = make(map[string]struct{})
+	if model := resp.Model; model != nil && model.ResourceTypes != nil {
+		for _, resourceType := range *model.ResourceTypes {
+			if resourceType.Aliases == nil {
+				continue
+			}
+			for _, alias := range *resourceType.Aliases {
+				if alias.Name != nil {
+					aliases[strings.ToLower(*alias.Name)] = struct{}{}
+				}
+			}
+		}
+	}
+	policyAliasCache.Lock()
+	policyAliasCache.namespaces[key] = aliases
+	policyAliasCache.Unlock()
+
+	return aliases, true, nil
+}
+
+// validatePolicySetDefinitionParametersDiff validates at plan time that the Parameters referenced by the
+// `parameter_values` of each `policy_definition_reference` are declared in the `parameters` of the Policy Set Definition
+func validatePolicySetDefinitionParametersDiff(d *pluginsdk.ResourceDiff) error {
+	if !d.HasChanges("parameters", "policy_definition_reference") {
+		return nil
+	}
+	if !d.NewValueKnown("parameters") || !d.NewValueKnown("policy_definition_reference") {
+		return nil
+	}
+
+	// invalid JSON is reported by the validation on the fields themselves
+	parameters := make(map[string]interface{})
+	if v := d.Get("parameters").(string); v != "" {
+		if err := json.Unmarshal([]byte(v), &parameters); err != nil {
+			return nil
+		}
+	}
+
+	declared := make(map[string]struct{}, len(parameters))
+	for name := range parameters {
+		declared[strings.ToLower(name)] = struct{}{}
+	}
+
+	errs := make([]error, 0)
+	for i, raw := range d.Get("policy_definition_reference").([]interface{}) {
+		reference, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		parameterValues, ok := reference["parameter_values"].(string)
+		if !ok || parameterValues == "" {
+			continue
+		}
+
+		var values interface{}
+		if err := json.Unmarshal([]byte(parameterValues), &values); err != nil {
+			continue
+		}
+		for _, name := range policyrule.ParameterReferences(values) {
+			if _, ok := declared[strings.ToLower(name)]; !ok {
+				errs = append(errs, fmt.Errorf("`policy_definition_reference.%d.parameter_values` references the Parameter %q which is not declared in `parameters`", i, name))
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("validating `policy_definition_reference`: %+v", errors.Join(errs...))
+	}
+
+	return nil
+}
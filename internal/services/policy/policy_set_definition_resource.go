@@ -77,7 +77,7 @@ func resourceArmPolicySetDefinition() *pluginsdk.Resource {
 				}
 			}
 
-			return nil
+			return validatePolicySetDefinitionParametersDiff(d)
 		}),
 	}
 }
@@ -1266,7 +1266,7 @@ func (r PolicySetDefinitionResource) CustomizeDiff() sdk.ResourceFunc {
 				}
 			}
 
-			return nil
+			return validatePolicySetDefinitionParametersDiff(metadata.ResourceDiff)
 		},
 	}
 }
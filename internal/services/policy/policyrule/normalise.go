@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policyrule
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// conditionKeys are the logical operators, conditions and value sources which can be used within the conditions of a
+// Policy Rule, in their documented casing
+var conditionKeys = []string{
+	"allOf",
+	"anyOf",
+	"contains",
+	"containsKey",
+	"count",
+	"equals",
+	"exists",
+	"field",
+	"greater",
+	"greaterOrEquals",
+	"in",
+	"less",
+	"lessOrEquals",
+	"like",
+	"match",
+	"matchInsensitively",
+	"name",
+	"not",
+	"notContains",
+	"notContainsKey",
+	"notEquals",
+	"notIn",
+	"notLike",
+	"notMatch",
+	"notMatchInsensitively",
+	"source",
+	"value",
+	"where",
+}
+
+// NormaliseJSON parses the Policy Rule, Parameters or Policy Definition in `input` and returns it as compact JSON with
+// sorted keys, with the keywords of the Policy Rule and any literal effect in their documented casing
+func NormaliseJSON(input string) (string, error) {
+	decoder := json.NewDecoder(strings.NewReader(input))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return "", fmt.Errorf("parsing JSON: %+v", err)
+	}
+	if decoder.More() {
+		return "", fmt.Errorf("parsing JSON: unexpected content after the top-level value")
+	}
+
+	return Render(value)
+}
+
+// Render returns `input` as compact JSON with sorted keys, with the keywords of any Policy Rule within it and any
+// literal effect in their documented casing
+func Render(input interface{}) (string, error) {
+	buf := bytes.Buffer{}
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(Normalise(input)); err != nil {
+		return "", fmt.Errorf("encoding JSON: %+v", err)
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// Normalise returns a copy of `input` with the keywords of the Policy Rule and any literal effect in their documented
+// casing. `input` can either be a Policy Rule, or a Policy Definition containing a `policyRule` either at the top level
+// or within its `properties` - any other values (such as Parameters) are returned unchanged.
+func Normalise(input interface{}) interface{} {
+	v, ok := input.(map[string]interface{})
+	if !ok {
+		return input
+	}
+
+	if _, ok := lookup(v, "if"); ok {
+		return normaliseRule(v)
+	}
+
+	output := make(map[string]interface{}, len(v))
+	for key, value := range v {
+		switch {
+		case strings.EqualFold(key, "policyRule"):
+			output["policyRule"] = normaliseRule(value)
+		case strings.EqualFold(key, "properties"):
+			output["properties"] = Normalise(value)
+		default:
+			output[key] = value
+		}
+	}
+	return output
+}
+
+func normaliseRule(input interface{}) interface{} {
+	v, ok := input.(map[string]interface{})
+	if !ok {
+		return input
+	}
+
+	output := make(map[string]interface{}, len(v))
+	for key, value := range v {
+		switch {
+		case strings.EqualFold(key, "if"):
+			output["if"] = normaliseCondition(value)
+		case strings.EqualFold(key, "then"):
+			output["then"] = normaliseThen(value)
+		default:
+			output[key] = value
+		}
+	}
+	return output
+}
+
+func normaliseCondition(input interface{}) interface{} {
+	v, ok := input.(map[string]interface{})
+	if !ok {
+		return input
+	}
+
+	output := make(map[string]interface{}, len(v))
+	for key, value := range v {
+		key = canonicalKey(key, conditionKeys)
+
+		switch key {
+		case "allOf", "anyOf":
+			if items, ok := value.([]interface{}); ok {
+				conditions := make([]interface{}, 0, len(items))
+				for _, item := range items {
+					conditions = append(conditions, normaliseCondition(item))
+				}
+				value = conditions
+			}
+		case "count", "not", "where":
+			value = normaliseCondition(value)
+		}
+
+		output[key] = value
+	}
+	return output
+}
+
+func normaliseThen(input interface{}) interface{} {
+	v, ok := input.(map[string]interface{})
+	if !ok {
+		return input
+	}
+
+	output := make(map[string]interface{}, len(v))
+	for key, value := range v {
+		switch {
+		case strings.EqualFold(key, "effect"):
+			if effect, ok := value.(string); ok && !isExpression(effect) {
+				value = canonicalKey(effect, effects)
+			}
+			output["effect"] = value
+		case strings.EqualFold(key, "details"):
+			output["details"] = normaliseDetails(value)
+		default:
+			output[key] = value
+		}
+	}
+	return output
+}
+
+func normaliseDetails(input interface{}) interface{} {
+	v, ok := input.(map[string]interface{})
+	if !ok {
+		return input
+	}
+
+	output := make(map[string]interface{}, len(v))
+	for key, value := range v {
+		if strings.EqualFold(key, "existenceCondition") {
+			output["existenceCondition"] = normaliseCondition(value)
+			continue
+		}
+		output[key] = value
+	}
+	return output
+}
+
+// canonicalKey returns the value from `possibleValues` matching `input` case-insensitively, or `input` if none match
+func canonicalKey(input string, possibleValues []string) string {
+	for _, v := range possibleValues {
+		if strings.EqualFold(v, input) {
+			return v
+		}
+	}
+	return input
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policyrule
+
+import (
+	"testing"
+)
+
+func TestNormaliseJSON(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Name:  "invalid",
+			Input: `{"if":`,
+			Error: true,
+		},
+		{
+			Name:  "trailing content",
+			Input: `{} {}`,
+			Error: true,
+		},
+		{
+			Name: "policy rule",
+			Input: `{
+  "Then": { "Effect": "AUDITIFNOTEXISTS", "Details": { "type": "Microsoft.Insights/diagnosticSettings", "ExistenceCondition": { "Field": "Microsoft.Insights/diagnosticSettings/logs.enabled", "Equals": "true" } } },
+  "IF": { "AllOf": [ { "FIELD": "type", "EQUALS": "Microsoft.KeyVault/vaults" }, { "NOT": { "field": "location", "In": ["westeurope", "northeurope"] } } ] }
+}`,
+			Expected: `{"if":{"allOf":[{"equals":"Microsoft.KeyVault/vaults","field":"type"},{"not":{"field":"location","in":["westeurope","northeurope"]}}]},"then":{"details":{"existenceCondition":{"equals":"true","field":"Microsoft.Insights/diagnosticSettings/logs.enabled"},"type":"Microsoft.Insights/diagnosticSettings"},"effect":"auditIfNotExists"}}`,
+		},
+		{
+			Name:     "parameter effect",
+			Input:    `{"if":{"field":"type","equals":"Microsoft.Web/sites"},"then":{"effect":"[parameters('Effect')]"}}`,
+			Expected: `{"if":{"equals":"Microsoft.Web/sites","field":"type"},"then":{"effect":"[parameters('Effect')]"}}`,
+		},
+		{
+			Name:     "policy definition",
+			Input:    `{"properties":{"mode":"Indexed","parameters":{"Field":{"type":"String"}},"policyRule":{"If":{"Field":"type","Equals":"Microsoft.Web/sites"},"Then":{"Effect":"Deny"}}}}`,
+			Expected: `{"properties":{"mode":"Indexed","parameters":{"Field":{"type":"String"}},"policyRule":{"if":{"equals":"Microsoft.Web/sites","field":"type"},"then":{"effect":"deny"}}}}`,
+		},
+		{
+			Name:     "parameters",
+			Input:    ` { "Effect": { "type": "String", "defaultValue": 1.50, "allowedValues": ["a", "<b>"] } } `,
+			Expected: `{"Effect":{"allowedValues":["a","<b>"],"defaultValue":1.50,"type":"String"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		actual, err := NormaliseJSON(tc.Input)
+		if err != nil {
+			if tc.Error {
+				continue
+			}
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if tc.Error {
+			t.Fatalf("expected an error but got %q", actual)
+		}
+
+		if actual != tc.Expected {
+			t.Fatalf("expected %q but got %q", tc.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,290 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policyrule
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var effects = []string{
+	"addToNetworkGroup",
+	"append",
+	"audit",
+	"auditIfNotExists",
+	"deny",
+	"denyAction",
+	"deployIfNotExists",
+	"disabled",
+	"manual",
+	"modify",
+	"mutate",
+}
+
+// nonAliasFields are the fields which can be used within a Policy Rule without being a Resource Provider alias
+var nonAliasFields = []string{
+	"fullName",
+	"id",
+	"identity.type",
+	"identity.userAssignedIdentities",
+	"kind",
+	"location",
+	"name",
+	"tags",
+	"type",
+}
+
+var parameterReferenceRegex = regexp.MustCompile(`(?i)parameters\(\s*'([^']*)'\s*\)`)
+
+// aliasRegex matches fields which are prefixed with a Resource Provider namespace, e.g. `Microsoft.Storage/...`
+var aliasRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*(\.[A-Za-z0-9]+)+/`)
+
+func PossibleValuesForEffect() []string {
+	return effects
+}
+
+// Validate checks the structure of the Policy Rule in `rule` and that any Parameters it references are declared in
+// `parameters`, returning an error for each problem found
+func Validate(rule interface{}, parameters map[string]interface{}) []error {
+	errs := make([]error, 0)
+
+	ruleMap, ok := rule.(map[string]interface{})
+	if !ok {
+		return append(errs, fmt.Errorf("the Policy Rule must be a JSON object"))
+	}
+
+	if _, ok := lookup(ruleMap, "if"); !ok {
+		errs = append(errs, fmt.Errorf("the Policy Rule must contain an `if` condition"))
+	}
+
+	then, ok := lookup(ruleMap, "then")
+	if !ok {
+		errs = append(errs, fmt.Errorf("the Policy Rule must contain a `then` block"))
+	} else {
+		errs = append(errs, validateThen(then, parameters)...)
+	}
+
+	for _, field := range Fields(rule) {
+		if !isExpression(field) && !strings.Contains(field, "/") && !isNonAliasField(field) {
+			errs = append(errs, fmt.Errorf("the field %q is not a supported field or a Resource Provider alias", field))
+		}
+	}
+
+	for _, name := range ParameterReferences(rule) {
+		if _, ok := lookup(parameters, name); !ok {
+			errs = append(errs, fmt.Errorf("the Parameter %q is referenced but is not declared in the Parameters", name))
+		}
+	}
+
+	return errs
+}
+
+func validateThen(input interface{}, parameters map[string]interface{}) []error {
+	then, ok := input.(map[string]interface{})
+	if !ok {
+		return []error{fmt.Errorf("the `then` block must be a JSON object")}
+	}
+
+	raw, ok := lookup(then, "effect")
+	if !ok {
+		return []error{fmt.Errorf("the `then` block must contain an `effect`")}
+	}
+
+	effect, ok := raw.(string)
+	if !ok {
+		return []error{fmt.Errorf("the `effect` must be a string")}
+	}
+
+	if !isExpression(effect) {
+		if !isEffect(effect) {
+			return []error{fmt.Errorf("the effect %q is not supported, possible values are %s", effect, strings.Join(effects, ", "))}
+		}
+		return nil
+	}
+
+	// when the effect is a single Parameter, the values which it can take must also be supported effects
+	names := ParameterReferences(effect)
+	if len(names) != 1 {
+		return nil
+	}
+	raw, ok = lookup(parameters, names[0])
+	if !ok {
+		// the missing declaration is reported when validating the Parameter references
+		return nil
+	}
+	parameter, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	errs := make([]error, 0)
+	if v, ok := lookup(parameter, "allowedValues"); ok {
+		if allowedValues, ok := v.([]interface{}); ok {
+			for _, allowedValue := range allowedValues {
+				if s, ok := allowedValue.(string); ok && !isEffect(s) {
+					errs = append(errs, fmt.Errorf("the allowed value %q for the Parameter %q is not a supported effect, possible values are %s", s, names[0], strings.Join(effects, ", ")))
+				}
+			}
+		}
+	}
+	if v, ok := lookup(parameter, "defaultValue"); ok {
+		if s, ok := v.(string); ok && !isEffect(s) {
+			errs = append(errs, fmt.Errorf("the default value %q for the Parameter %q is not a supported effect, possible values are %s", s, names[0], strings.Join(effects, ", ")))
+		}
+	}
+
+	return errs
+}
+
+// Fields returns the distinct values of the `field` conditions, `count` expressions and `modify` operations within the
+// Policy Rule, excluding any Deployment Templates used by the `deployIfNotExists` effect
+func Fields(rule interface{}) []string {
+	output := make([]string, 0)
+	seen := make(map[string]struct{})
+
+	walk(rule, func(key string, value interface{}) {
+		field, ok := value.(string)
+		if !ok || !strings.EqualFold(key, "field") {
+			return
+		}
+		if _, ok := seen[strings.ToLower(field)]; ok {
+			return
+		}
+		seen[strings.ToLower(field)] = struct{}{}
+		output = append(output, field)
+	})
+
+	sort.Strings(output)
+	return output
+}
+
+// Aliases returns the Resource Provider aliases used by the `field` conditions within the `if` condition of the Policy
+// Rule. Fields which are template expressions or which aren't prefixed with a Resource Provider namespace (such as
+// `tags['a/b']`) are ignored, as are the fields within the `details` of the effect (such as the `existenceCondition`)
+// since these refer to related resources - all of which are validated by Azure when the Policy Definition is created.
+func Aliases(rule interface{}) []string {
+	output := make([]string, 0)
+
+	ruleMap, ok := rule.(map[string]interface{})
+	if !ok {
+		return output
+	}
+	condition, ok := lookup(ruleMap, "if")
+	if !ok {
+		return output
+	}
+
+	for _, field := range Fields(condition) {
+		if !isExpression(field) && !isNonAliasField(field) && aliasRegex.MatchString(field) {
+			output = append(output, field)
+		}
+	}
+	return output
+}
+
+// ParameterReferences returns the distinct names of the Parameters referenced by the template expressions within
+// `input`, excluding any Deployment Templates used by the `deployIfNotExists` effect which declare their own Parameters
+func ParameterReferences(input interface{}) []string {
+	output := make([]string, 0)
+	seen := make(map[string]struct{})
+
+	add := func(value interface{}) {
+		s, ok := value.(string)
+		if !ok || !isExpression(s) {
+			return
+		}
+		for _, match := range parameterReferenceRegex.FindAllStringSubmatch(s, -1) {
+			if _, ok := seen[strings.ToLower(match[1])]; ok {
+				continue
+			}
+			seen[strings.ToLower(match[1])] = struct{}{}
+			output = append(output, match[1])
+		}
+	}
+
+	add(input)
+	walk(input, func(key string, value interface{}) {
+		add(value)
+	})
+
+	sort.Strings(output)
+	return output
+}
+
+// walk calls `fn` with every key and value within `input`, passing an empty key for the items within an array
+func walk(input interface{}, fn func(key string, value interface{})) {
+	walkPath(input, nil, fn)
+}
+
+func walkPath(input interface{}, path []string, fn func(key string, value interface{})) {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			fn(key, value)
+
+			nested := append(append(make([]string, 0, len(path)+1), path...), key)
+			if isDeploymentTemplate(nested) {
+				continue
+			}
+			walkPath(value, nested, fn)
+		}
+	case []interface{}:
+		for _, value := range v {
+			fn("", value)
+			walkPath(value, append(append(make([]string, 0, len(path)+1), path...), ""), fn)
+		}
+	}
+}
+
+// isDeploymentTemplate returns whether the path is the Deployment Template within the `details` of a
+// `deployIfNotExists` effect, whose expressions refer to the Template rather than the Policy Definition
+func isDeploymentTemplate(path []string) bool {
+	if len(path) < 3 {
+		return false
+	}
+
+	path = path[len(path)-3:]
+	return strings.EqualFold(path[0], "deployment") && strings.EqualFold(path[1], "properties") && strings.EqualFold(path[2], "template")
+}
+
+func isEffect(input string) bool {
+	for _, v := range effects {
+		if strings.EqualFold(v, input) {
+			return true
+		}
+	}
+	return false
+}
+
+func isNonAliasField(input string) bool {
+	for _, v := range nonAliasFields {
+		if strings.EqualFold(v, input) {
+			return true
+		}
+	}
+
+	lower := strings.ToLower(input)
+	return strings.HasPrefix(lower, "tags[") || strings.HasPrefix(lower, "tags.")
+}
+
+// isExpression returns whether the input is a template expression, which is a string wrapped in square brackets that
+// doesn't start with `[[` (which is used to escape a literal value starting with a square bracket)
+func isExpression(input string) bool {
+	return strings.HasPrefix(input, "[") && !strings.HasPrefix(input, "[[") && strings.HasSuffix(input, "]")
+}
+
+// lookup returns the value for the key within `input`, since the keys within a Policy Definition are case-insensitive
+func lookup(input map[string]interface{}, key string) (interface{}, bool) {
+	if v, ok := input[key]; ok {
+		return v, true
+	}
+	for k, v := range input {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package policyrule
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		Name       string
+		Rule       string
+		Parameters string
+		Errors     int
+	}{
+		{
+			Name: "valid",
+			Rule: `{
+  "if": {
+    "allOf": [
+      { "field": "type", "equals": "Microsoft.Storage/storageAccounts" },
+      { "field": "tags['environment']", "exists": "false" },
+      { "field": "Microsoft.Storage/storageAccounts/minimumTlsVersion", "notIn": "[parameters('allowedVersions')]" }
+    ]
+  },
+  "then": { "effect": "[parameters('effect')]" }
+}`,
+			Parameters: `{
+  "allowedVersions": { "type": "Array" },
+  "Effect": { "type": "String", "allowedValues": ["Audit", "Deny", "Disabled"], "defaultValue": "Audit" }
+}`,
+		},
+		{
+			Name:   "missing if",
+			Rule:   `{ "then": { "effect": "audit" } }`,
+			Errors: 1,
+		},
+		{
+			Name:   "missing then",
+			Rule:   `{ "if": { "field": "type", "equals": "Microsoft.Storage/storageAccounts" } }`,
+			Errors: 1,
+		},
+		{
+			Name:   "missing effect",
+			Rule:   `{ "if": { "field": "type", "equals": "Microsoft.Storage/storageAccounts" }, "then": {} }`,
+			Errors: 1,
+		},
+		{
+			Name:   "unknown effect",
+			Rule:   `{ "if": { "field": "type", "equals": "Microsoft.Storage/storageAccounts" }, "then": { "effect": "block" } }`,
+			Errors: 1,
+		},
+		{
+			Name:   "effect is case-insensitive",
+			Rule:   `{ "If": { "field": "type", "equals": "Microsoft.Storage/storageAccounts" }, "Then": { "Effect": "DeployIfNotExists" } }`,
+			Errors: 0,
+		},
+		{
+			Name:       "unknown allowed effect",
+			Rule:       `{ "if": { "field": "type", "equals": "Microsoft.Storage/storageAccounts" }, "then": { "effect": "[parameters('effect')]" } }`,
+			Parameters: `{ "effect": { "type": "String", "allowedValues": ["Audit", "Block"], "defaultValue": "Block" } }`,
+			Errors:     2,
+		},
+		{
+			Name:   "undeclared parameter",
+			Rule:   `{ "if": { "field": "location", "notIn": "[parameters('allowedLocations')]" }, "then": { "effect": "[parameters('effect')]" } }`,
+			Errors: 2,
+		},
+		{
+			Name:   "unsupported field",
+			Rule:   `{ "if": { "field": "sku.name", "equals": "Standard" }, "then": { "effect": "audit" } }`,
+			Errors: 1,
+		},
+		{
+			Name:   "field expression",
+			Rule:   `{ "if": { "field": "[concat('tags[', parameters('tagName'), ']')]", "exists": "false" }, "then": { "effect": "audit" } }`,
+			Errors: 1,
+		},
+		{
+			Name: "deployment template parameters",
+			Rule: `{
+  "if": { "field": "type", "equals": "Microsoft.Storage/storageAccounts" },
+  "then": {
+    "effect": "deployIfNotExists",
+    "details": {
+      "type": "Microsoft.Insights/diagnosticSettings",
+      "roleDefinitionIds": [],
+      "deployment": {
+        "properties": {
+          "mode": "incremental",
+          "parameters": { "workspaceId": { "value": "[parameters('workspaceId')]" } },
+          "template": { "resources": [ { "name": "[parameters('storageAccountName')]" } ] }
+        }
+      }
+    }
+  }
+}`,
+			Parameters: `{ "workspaceId": { "type": "String" } }`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		var rule interface{}
+		if err := json.Unmarshal([]byte(tc.Rule), &rule); err != nil {
+			t.Fatalf("unmarshalling rule: %+v", err)
+		}
+
+		var parameters map[string]interface{}
+		if tc.Parameters != "" {
+			if err := json.Unmarshal([]byte(tc.Parameters), &parameters); err != nil {
+				t.Fatalf("unmarshalling parameters: %+v", err)
+			}
+		}
+
+		errs := Validate(rule, parameters)
+		if len(errs) != tc.Errors {
+			t.Fatalf("expected %d errors but got %d: %+v", tc.Errors, len(errs), errs)
+		}
+	}
+}
+
+func TestAliases(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    string
+		Expected []string
+	}{
+		{
+			Name: "Fields And Count Expressions",
+			Input: `{
+  "if": {
+    "allOf": [
+      { "field": "type", "equals": "Microsoft.Network/networkSecurityGroups" },
+      {
+        "count": {
+          "field": "Microsoft.Network/networkSecurityGroups/securityRules[*]",
+          "where": { "field": "Microsoft.Network/networkSecurityGroups/securityRules[*].access", "equals": "Allow" }
+        },
+        "greater": 0
+      },
+      { "field": "[concat('tags[', parameters('tagName'), ']')]", "exists": "false" }
+    ]
+  },
+  "then": {
+    "effect": "modify",
+    "details": {
+      "operations": [
+        { "operation": "addOrReplace", "field": "Microsoft.Network/networkSecurityGroups/securityRules[*].access", "value": "Deny" }
+      ]
+    }
+  }
+}`,
+			Expected: []string{
+				"Microsoft.Network/networkSecurityGroups/securityRules[*]",
+				"Microsoft.Network/networkSecurityGroups/securityRules[*].access",
+			},
+		},
+		{
+			Name: "Tags Containing A Slash",
+			Input: `{
+  "if": {
+    "anyOf": [
+      { "field": "tags['cost/center']", "exists": "false" },
+      { "field": "tags[team/name]", "exists": "false" },
+      { "field": "tags.environment", "exists": "false" },
+      { "field": "Microsoft.Storage/storageAccounts/minimumTlsVersion", "notEquals": "TLS1_2" }
+    ]
+  },
+  "then": {
+    "effect": "audit"
+  }
+}`,
+			Expected: []string{
+				"Microsoft.Storage/storageAccounts/minimumTlsVersion",
+			},
+		},
+		{
+			Name: "Existence Condition",
+			Input: `{
+  "if": {
+    "field": "type",
+    "equals": "Microsoft.Compute/virtualMachines"
+  },
+  "then": {
+    "effect": "auditIfNotExists",
+    "details": {
+      "type": "Microsoft.Compute/virtualMachines/extensions",
+      "existenceCondition": {
+        "allOf": [
+          { "field": "Microsoft.Compute/virtualMachines/extensions/publisher", "equals": "Microsoft.Azure.Monitor" },
+          { "field": "Microsoft.Compute/virtualMachines/extensions/type", "equals": "AzureMonitorLinuxAgent" }
+        ]
+      }
+    }
+  }
+}`,
+			Expected: []string{},
+		},
+		{
+			Name: "Fields Without A Resource Provider Namespace",
+			Input: `{
+  "if": {
+    "anyOf": [
+      { "field": "identity.userAssignedIdentities", "exists": "true" },
+      { "field": "location", "notIn": ["westeurope"] },
+      { "field": "example/field", "exists": "true" }
+    ]
+  },
+  "then": {
+    "effect": "deny"
+  }
+}`,
+			Expected: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		var rule interface{}
+		if err := json.Unmarshal([]byte(tc.Input), &rule); err != nil {
+			t.Fatalf("unmarshalling rule: %+v", err)
+		}
+
+		if actual := Aliases(rule); !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
+
+func TestParameterReferences(t *testing.T) {
+	input := `{
+  "a": "[parameters('first')]",
+  "b": ["[concat(parameters('second'), parameters( 'First' ))]"],
+  "c": "[[parameters('escaped')]",
+  "d": "parameters('literal')"
+}`
+	var value interface{}
+	if err := json.Unmarshal([]byte(input), &value); err != nil {
+		t.Fatalf("unmarshalling: %+v", err)
+	}
+
+	expected := []string{"first", "second"}
+	if actual := ParameterReferences(value); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
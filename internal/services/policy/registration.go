@@ -23,6 +23,7 @@ type Registration struct{}
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		AssignmentDataSource{},
+		PolicyComplianceSummaryDataSource{},
 	}
 }
 
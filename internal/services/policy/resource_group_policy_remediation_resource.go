@@ -107,6 +107,12 @@ func resourceArmResourceGroupPolicyRemediation() *pluginsdk.Resource {
 					string(remediations.ResourceDiscoveryModeReEvaluateCompliance),
 				}, false),
 			},
+
+			"wait_for_completion_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 
@@ -147,6 +153,15 @@ func resourceArmResourceGroupPolicyRemediationCreateUpdate(d *pluginsdk.Resource
 
 	d.SetId(id.ID())
 
+	if d.Get("wait_for_completion_enabled").(bool) {
+		if err := waitForRemediationToComplete(ctx, id.ID(), func() (*remediations.Remediation, error) {
+			resp, err := client.GetAtResourceGroup(ctx, id)
+			return resp.Model, err
+		}); err != nil {
+			return err
+		}
+	}
+
 	return resourceArmResourceGroupPolicyRemediationRead(d, meta)
 }
 
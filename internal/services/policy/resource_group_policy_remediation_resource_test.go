@@ -49,6 +49,21 @@ func TestAccAzureRMResourceGroupPolicyRemediation_complete(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMResourceGroupPolicyRemediation_waitForCompletion(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_policy_remediation", "test")
+	r := ResourceGroupPolicyRemediationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.waitForCompletion(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("wait_for_completion_enabled"),
+	})
+}
+
 func (r ResourceGroupPolicyRemediationResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := remediations.ParseProviderRemediationID(state.ID)
 	if err != nil {
@@ -157,3 +172,16 @@ resource "azurerm_resource_group_policy_remediation" "test" {
 }
 `, r.template(data), data.RandomString)
 }
+
+func (r ResourceGroupPolicyRemediationResource) waitForCompletion(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group_policy_remediation" "test" {
+  name                        = "acctestremediation-%[2]s"
+  resource_group_id           = azurerm_resource_group_policy_assignment.test.resource_group_id
+  policy_assignment_id        = azurerm_resource_group_policy_assignment.test.id
+  wait_for_completion_enabled = true
+}
+`, r.template(data), data.RandomString)
+}
@@ -108,6 +108,12 @@ func resourceArmResourcePolicyRemediation() *pluginsdk.Resource {
 					string(remediations.ResourceDiscoveryModeReEvaluateCompliance),
 				}, false),
 			},
+
+			"wait_for_completion_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 
@@ -145,6 +151,15 @@ func resourceArmResourcePolicyRemediationCreateUpdate(d *pluginsdk.ResourceData,
 
 	d.SetId(id.ID())
 
+	if d.Get("wait_for_completion_enabled").(bool) {
+		if err := waitForRemediationToComplete(ctx, id.ID(), func() (*remediations.Remediation, error) {
+			resp, err := client.GetAtResource(ctx, id)
+			return resp.Model, err
+		}); err != nil {
+			return err
+		}
+	}
+
 	return resourceArmResourcePolicyRemediationRead(d, meta)
 }
 
@@ -266,6 +281,51 @@ func waitForRemediationToDelete(ctx context.Context,
 	return nil
 }
 
+// waitForRemediationToComplete waits for the remediation to finish, returning an error if the remediation or any of
+// the deployments it started have failed
+func waitForRemediationToComplete(ctx context.Context, id string, get func() (*remediations.Remediation, error)) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context was missing a deadline")
+	}
+
+	log.Printf("[DEBUG] waiting for %s to complete", id)
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{"Accepted", "Cancelling", "Evaluating", "Running"},
+		Target:  []string{"Canceled", "Complete", "Failed", "Succeeded"},
+		Refresh: func() (interface{}, string, error) {
+			model, err := get()
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if model == nil || model.Properties == nil || model.Properties.ProvisioningState == nil {
+				return nil, "", fmt.Errorf("`properties.ProvisioningState` was nil for %s", id)
+			}
+
+			return *model.Properties, *model.Properties.ProvisioningState, nil
+		},
+		MinTimeout: 30 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("waiting for %s to complete: %+v", id, err)
+	}
+
+	props := result.(remediations.RemediationProperties)
+	if state := pointer.From(props.ProvisioningState); state != "Succeeded" && state != "Complete" {
+		return fmt.Errorf("%s finished with the state %q", id, state)
+	}
+
+	if status := props.DeploymentStatus; status != nil && pointer.From(status.FailedDeployments) > 0 {
+		return fmt.Errorf("%d of the %d deployments started by %s failed", pointer.From(status.FailedDeployments), pointer.From(status.TotalDeployments), id)
+	}
+
+	return nil
+}
+
 // readRemediationProperties sets the properties of the remediation, useful when add new properties to the model
 func readRemediationProperties(d *pluginsdk.ResourceData) (prop *remediations.RemediationProperties) {
 	prop = &remediations.RemediationProperties{
@@ -313,5 +373,8 @@ func setRemediationProperties(d *pluginsdk.ResourceData, prop *remediations.Reme
 	if prop.FailureThreshold != nil {
 		d.Set("failure_percentage", prop.FailureThreshold.Percentage)
 	}
+
+	// `wait_for_completion_enabled` isn't returned by the API, so the existing value is retained (defaulting on import)
+	d.Set("wait_for_completion_enabled", d.Get("wait_for_completion_enabled").(bool))
 	return nil
 }
@@ -106,6 +106,12 @@ func resourceArmSubscriptionPolicyRemediation() *pluginsdk.Resource {
 					string(remediations.ResourceDiscoveryModeReEvaluateCompliance),
 				}, false),
 			},
+
+			"wait_for_completion_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 
@@ -146,6 +152,15 @@ func resourceArmSubscriptionPolicyRemediationCreateUpdate(d *pluginsdk.ResourceD
 
 	d.SetId(id.ID())
 
+	if d.Get("wait_for_completion_enabled").(bool) {
+		if err := waitForRemediationToComplete(ctx, id.ID(), func() (*remediations.Remediation, error) {
+			resp, err := client.GetAtSubscription(ctx, id)
+			return resp.Model, err
+		}); err != nil {
+			return err
+		}
+	}
+
 	return resourceArmSubscriptionPolicyRemediationRead(d, meta)
 }
 
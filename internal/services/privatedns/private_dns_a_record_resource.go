@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2024-06-01/privatedns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -21,22 +22,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name private_dns_a_record -service-package-name privatedns -properties "name,resource_group_name,private_dns_zone_name:zone_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourcePrivateDnsARecord() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourcePrivateDnsARecordCreateUpdate,
-		Read:   resourcePrivateDnsARecordRead,
-		Update: resourcePrivateDnsARecordCreateUpdate,
-		Delete: resourcePrivateDnsARecordDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			resourceId, err := privatedns.ParseRecordTypeID(id)
-			if err != nil {
-				return err
-			}
-			if resourceId.RecordType != privatedns.RecordTypeA {
-				return fmt.Errorf("importing %s wrong type received: expected %s received %s", id, privatedns.RecordTypeA, resourceId.RecordType)
-			}
-			return nil
-		}),
+		Create:   resourcePrivateDnsARecordCreateUpdate,
+		Read:     resourcePrivateDnsARecordRead,
+		Update:   resourcePrivateDnsARecordCreateUpdate,
+		Delete:   resourcePrivateDnsARecordDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(newPrivateDnsRecordSetIdentityId(privatedns.RecordTypeA)),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(newPrivateDnsRecordSetIdentityId(privatedns.RecordTypeA)),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -149,11 +147,19 @@ func resourcePrivateDnsARecordRead(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
+	return resourcePrivateDnsARecordFlatten(d, *id, resp.Model)
+}
+
+func resourcePrivateDnsARecordFlatten(d *pluginsdk.ResourceData, id privatedns.RecordTypeId, model *privatedns.RecordSet) error {
 	d.Set("name", id.RelativeRecordSetName)
 	d.Set("zone_name", id.PrivateDnsZoneName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if model := resp.Model; model != nil {
+	if err := pluginsdk.SetResourceIdentityData(d, &privateDnsRecordSetIdentityId{RecordTypeId: id}); err != nil {
+		return err
+	}
+
+	if model != nil {
 		if props := model.Properties; props != nil {
 			d.Set("ttl", props.Ttl)
 			d.Set("fqdn", props.Fqdn)
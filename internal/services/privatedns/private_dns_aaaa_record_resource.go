@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2024-06-01/privatedns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -20,22 +21,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name private_dns_aaaa_record -test-resource-type PrivateDnsAAAARecordResource -service-package-name privatedns -properties "name,resource_group_name,private_dns_zone_name:zone_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourcePrivateDnsAaaaRecord() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourcePrivateDnsAaaaRecordCreateUpdate,
-		Read:   resourcePrivateDnsAaaaRecordRead,
-		Update: resourcePrivateDnsAaaaRecordCreateUpdate,
-		Delete: resourcePrivateDnsAaaaRecordDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			resourceId, err := privatedns.ParseRecordTypeID(id)
-			if err != nil {
-				return err
-			}
-			if resourceId.RecordType != privatedns.RecordTypeAAAA {
-				return fmt.Errorf("importing %s wrong type received: expected %s received %s", id, privatedns.RecordTypeAAAA, resourceId.RecordType)
-			}
-			return nil
-		}),
+		Create:   resourcePrivateDnsAaaaRecordCreateUpdate,
+		Read:     resourcePrivateDnsAaaaRecordRead,
+		Update:   resourcePrivateDnsAaaaRecordCreateUpdate,
+		Delete:   resourcePrivateDnsAaaaRecordDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(newPrivateDnsRecordSetIdentityId(privatedns.RecordTypeAAAA)),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(newPrivateDnsRecordSetIdentityId(privatedns.RecordTypeAAAA)),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -145,11 +143,19 @@ func resourcePrivateDnsAaaaRecordRead(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
+	return resourcePrivateDnsAaaaRecordFlatten(d, *id, resp.Model)
+}
+
+func resourcePrivateDnsAaaaRecordFlatten(d *pluginsdk.ResourceData, id privatedns.RecordTypeId, model *privatedns.RecordSet) error {
 	d.Set("name", id.RelativeRecordSetName)
 	d.Set("zone_name", id.PrivateDnsZoneName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if model := resp.Model; model != nil {
+	if err := pluginsdk.SetResourceIdentityData(d, &privateDnsRecordSetIdentityId{RecordTypeId: id}); err != nil {
+		return err
+	}
+
+	if model != nil {
 		if props := model.Properties; props != nil {
 			d.Set("ttl", props.Ttl)
 			d.Set("fqdn", props.Fqdn)
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2024-06-01/privatedns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -22,22 +23,19 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+//go:generate go run ../../tools/generator-tests resourceidentity -resource-name private_dns_cname_record -test-resource-type PrivateDnsCNameRecordResource -service-package-name privatedns -properties "name,resource_group_name,private_dns_zone_name:zone_name" -known-values "subscription_id:data.Subscriptions.Primary"
+
 func resourcePrivateDnsCNameRecord() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourcePrivateDnsCNameRecordCreateUpdate,
-		Read:   resourcePrivateDnsCNameRecordRead,
-		Update: resourcePrivateDnsCNameRecordCreateUpdate,
-		Delete: resourcePrivateDnsCNameRecordDelete,
-		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
-			resourceId, err := privatedns.ParseRecordTypeID(id)
-			if err != nil {
-				return err
-			}
-			if resourceId.RecordType != privatedns.RecordTypeCNAME {
-				return fmt.Errorf("importing %s wrong type received: expected %s received %s", id, privatedns.RecordTypeCNAME, resourceId.RecordType)
-			}
-			return nil
-		}),
+		Create:   resourcePrivateDnsCNameRecordCreateUpdate,
+		Read:     resourcePrivateDnsCNameRecordRead,
+		Update:   resourcePrivateDnsCNameRecordCreateUpdate,
+		Delete:   resourcePrivateDnsCNameRecordDelete,
+		Importer: pluginsdk.ImporterValidatingIdentity(newPrivateDnsRecordSetIdentityId(privatedns.RecordTypeCNAME)),
+
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: pluginsdk.GenerateIdentitySchema(newPrivateDnsRecordSetIdentityId(privatedns.RecordTypeCNAME)),
+		},
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -152,11 +150,19 @@ func resourcePrivateDnsCNameRecordRead(d *pluginsdk.ResourceData, meta interface
 		return fmt.Errorf("retrieving %s: %+v", id, err)
 	}
 
+	return resourcePrivateDnsCNameRecordFlatten(d, *id, resp.Model)
+}
+
+func resourcePrivateDnsCNameRecordFlatten(d *pluginsdk.ResourceData, id privatedns.RecordTypeId, model *privatedns.RecordSet) error {
 	d.Set("name", id.RelativeRecordSetName)
 	d.Set("zone_name", id.PrivateDnsZoneName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if model := resp.Model; model != nil {
+	if err := pluginsdk.SetResourceIdentityData(d, &privateDnsRecordSetIdentityId{RecordTypeId: id}); err != nil {
+		return err
+	}
+
+	if model != nil {
 		if props := model.Properties; props != nil {
 			d.Set("ttl", props.Ttl)
 			d.Set("fqdn", props.Fqdn)
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privatedns
+
+import (
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2024-06-01/privatedns"
+)
+
+var _ resourceids.ResourceId = &privateDnsRecordSetIdentityId{}
+
+// privateDnsRecordSetIdentityId wraps a privatedns.RecordTypeId for use with Resource Identity. Each Private DNS Record
+// resource only manages a single Record Type, so the `recordType` constant segment is exposed as a static segment which
+// keeps it out of the identity schema and rejects IDs for other Record Types at import time.
+type privateDnsRecordSetIdentityId struct {
+	privatedns.RecordTypeId
+}
+
+func newPrivateDnsRecordSetIdentityId(recordType privatedns.RecordType) *privateDnsRecordSetIdentityId {
+	return &privateDnsRecordSetIdentityId{
+		RecordTypeId: privatedns.RecordTypeId{
+			RecordType: recordType,
+		},
+	}
+}
+
+func (id *privateDnsRecordSetIdentityId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.PrivateDnsZoneName, ok = input.Parsed["privateDnsZoneName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "privateDnsZoneName", input)
+	}
+
+	if id.RelativeRecordSetName, ok = input.Parsed["relativeRecordSetName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "relativeRecordSetName", input)
+	}
+
+	return nil
+}
+
+func (id privateDnsRecordSetIdentityId) Segments() []resourceids.Segment {
+	segments := id.RecordTypeId.Segments()
+	for i, segment := range segments {
+		if segment.Name == "recordType" {
+			segments[i] = resourceids.StaticSegment("staticRecordType", string(id.RecordType), string(id.RecordType))
+		}
+	}
+
+	return segments
+}
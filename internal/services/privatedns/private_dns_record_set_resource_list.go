@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privatedns
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/framework/typehelpers"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2024-06-01/privatedns"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2024-06-01/privatezones"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.ListResourceWithRawV5Schemas = &PrivateDnsRecordSetListResource{}
+
+// PrivateDnsRecordSetListResource lists the Record Sets of a single Record Type within a Private DNS Zone, each Private
+// DNS Record resource registers its own instance of this List Resource.
+type PrivateDnsRecordSetListResource struct {
+	sdk.ListResourceMetadata
+
+	resourceName string
+	recordType   privatedns.RecordType
+	resource     func() *pluginsdk.Resource
+	flatten      func(d *pluginsdk.ResourceData, id privatedns.RecordTypeId, model *privatedns.RecordSet) error
+}
+
+type PrivateDnsRecordSetListModel struct {
+	PrivateDnsZoneId types.String `tfsdk:"private_dns_zone_id"`
+}
+
+func NewPrivateDnsARecordListResource() list.ListResource {
+	return &PrivateDnsRecordSetListResource{
+		resourceName: "azurerm_private_dns_a_record",
+		recordType:   privatedns.RecordTypeA,
+		resource:     resourcePrivateDnsARecord,
+		flatten:      resourcePrivateDnsARecordFlatten,
+	}
+}
+
+func NewPrivateDnsAaaaRecordListResource() list.ListResource {
+	return &PrivateDnsRecordSetListResource{
+		resourceName: "azurerm_private_dns_aaaa_record",
+		recordType:   privatedns.RecordTypeAAAA,
+		resource:     resourcePrivateDnsAaaaRecord,
+		flatten:      resourcePrivateDnsAaaaRecordFlatten,
+	}
+}
+
+func NewPrivateDnsCNameRecordListResource() list.ListResource {
+	return &PrivateDnsRecordSetListResource{
+		resourceName: "azurerm_private_dns_cname_record",
+		recordType:   privatedns.RecordTypeCNAME,
+		resource:     resourcePrivateDnsCNameRecord,
+		flatten:      resourcePrivateDnsCNameRecordFlatten,
+	}
+}
+
+func NewPrivateDnsMxRecordListResource() list.ListResource {
+	return &PrivateDnsRecordSetListResource{
+		resourceName: "azurerm_private_dns_mx_record",
+		recordType:   privatedns.RecordTypeMX,
+		resource:     resourcePrivateDnsMxRecord,
+		flatten:      resourcePrivateDnsMxRecordFlatten,
+	}
+}
+
+func NewPrivateDnsPtrRecordListResource() list.ListResource {
+	return &PrivateDnsRecordSetListResource{
+		resourceName: "azurerm_private_dns_ptr_record",
+		recordType:   privatedns.RecordTypePTR,
+		resource:     resourcePrivateDnsPtrRecord,
+		flatten:      resourcePrivateDnsPtrRecordFlatten,
+	}
+}
+
+func NewPrivateDnsSrvRecordListResource() list.ListResource {
+	return &PrivateDnsRecordSetListResource{
+		resourceName: "azurerm_private_dns_srv_record",
+		recordType:   privatedns.RecordTypeSRV,
+		resource:     resourcePrivateDnsSrvRecord,
+		flatten:      resourcePrivateDnsSrvRecordFlatten,
+	}
+}
+
+func NewPrivateDnsTxtRecordListResource() list.ListResource {
+	return &PrivateDnsRecordSetListResource{
+		resourceName: "azurerm_private_dns_txt_record",
+		recordType:   privatedns.RecordTypeTXT,
+		resource:     resourcePrivateDnsTxtRecord,
+		flatten:      resourcePrivateDnsTxtRecordFlatten,
+	}
+}
+
+func (r *PrivateDnsRecordSetListResource) Metadata(_ context.Context, _ resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = r.resourceName
+}
+
+func (r *PrivateDnsRecordSetListResource) RawV5Schemas(ctx context.Context, _ list.RawV5SchemaRequest, response *list.RawV5SchemaResponse) {
+	res := r.resource()
+	response.ProtoV5Schema = res.ProtoSchema(ctx)()
+	response.ProtoV5IdentitySchema = res.ProtoIdentitySchema(ctx)()
+}
+
+func (r *PrivateDnsRecordSetListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, response *list.ListResourceSchemaResponse) {
+	response.Schema = listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"private_dns_zone_id": listschema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					typehelpers.WrappedStringValidator{
+						Func: privatezones.ValidatePrivateDnsZoneID,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *PrivateDnsRecordSetListResource) List(ctx context.Context, request list.ListRequest, stream *list.ListResultsStream) {
+	client := r.Client.PrivateDns.RecordSetsClient
+
+	ctx, cancel := context.WithTimeout(ctx, time.Minute*60)
+	defer cancel()
+
+	var data PrivateDnsRecordSetListModel
+	diags := request.Config.Get(ctx, &data)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	zoneId, err := privatezones.ParsePrivateDnsZoneID(data.PrivateDnsZoneId.ValueString())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, "parsing private dns zone id", err)
+		return
+	}
+
+	listId := privatedns.NewPrivateZoneID(zoneId.SubscriptionId, zoneId.ResourceGroupName, zoneId.PrivateDnsZoneName, r.recordType)
+	resp, err := client.RecordSetsListByTypeComplete(ctx, listId, privatedns.DefaultRecordSetsListByTypeOperationOptions())
+	if err != nil {
+		sdk.SetResponseErrorDiagnostic(stream, fmt.Sprintf("listing %s", r.resourceName), err)
+		return
+	}
+
+	listResults := resp.Items
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
+		defer cancel()
+		for _, recordSet := range listResults {
+			result := request.NewListResult(ctx)
+			result.DisplayName = pointer.From(recordSet.Name)
+
+			id, err := privatedns.ParseRecordTypeIDInsensitively(pointer.From(recordSet.Id))
+			if err != nil {
+				sdk.SetResponseErrorDiagnostic(stream, "parsing private dns record set id", err)
+				return
+			}
+
+			rd := r.resource().Data(&terraform.InstanceState{})
+
+			rd.SetId(id.ID())
+
+			if err := r.flatten(rd, *id, pointer.To(recordSet)); err != nil {
+				sdk.SetResponseErrorDiagnostic(stream, "encoding resource data", err)
+				return
+			}
+
+			tfTypeIdentity, err := rd.TfTypeIdentityState()
+			if err != nil {
+				sdk.SetResponseErrorDiagnostic(stream, "converting Identity State", err)
+				return
+			}
+
+			if err := result.Identity.Set(ctx, *tfTypeIdentity); err != nil {
+				sdk.SetResponseErrorDiagnostic(stream, "setting identity data", err)
+				return
+			}
+
+			tfTypeResource, err := rd.TfTypeResourceState()
+			if err != nil {
+				sdk.SetResponseErrorDiagnostic(stream, "converting Resource State data", err)
+				return
+			}
+
+			if err := result.Resource.Set(ctx, *tfTypeResource); err != nil {
+				sdk.SetResponseErrorDiagnostic(stream, "setting resource data", err)
+				return
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+func (r *PrivateDnsRecordSetListResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	r.Defaults(request, response)
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privatedns_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
+)
+
+func TestAccPrivateDnsARecord_list_basic(t *testing.T) {
+	r := PrivateDnsARecordResource{}
+
+	data := acceptance.BuildTestData(t, "azurerm_private_dns_a_record", "test1")
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []resource.TestStep{
+			{
+				Config: r.basicList(data),
+			},
+			{
+				Query:             true,
+				Config:            r.basicList_query(data), // TODO - Testing not currently functional
+				ConfigQueryChecks: []querycheck.QueryCheck{
+					// querycheck.ExpectIdentityValue("azurerm_private_dns_a_record.test1", tfjsonpath.New("subscription_id"), knownvalue.StringExact(data.Subscriptions.Primary)),
+					// querycheck.ExpectIdentityValue("azurerm_private_dns_a_record.test1", tfjsonpath.New("name"), knownvalue.StringExact(fmt.Sprintf("myarecord1%d", data.RandomInteger))),
+					// querycheck.ExpectIdentityValue("azurerm_private_dns_a_record.test1", tfjsonpath.New("private_dns_zone_name"), knownvalue.StringExact(fmt.Sprintf("acctestzone%d.com", data.RandomInteger))),
+					// querycheck.ExpectIdentityValue("azurerm_private_dns_a_record.test1", tfjsonpath.New("resource_group_name"), knownvalue.StringExact(fmt.Sprintf("acctestRG-%d", data.RandomInteger))),
+				},
+			},
+		},
+	})
+}
+
+func (r PrivateDnsARecordResource) basicList(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_private_dns_zone" "test" {
+  name                = "acctestzone%[1]d.com"
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_private_dns_a_record" "test1" {
+  name                = "myarecord1%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_private_dns_zone.test.name
+  ttl                 = 300
+  records             = ["10.0.180.17"]
+}
+
+resource "azurerm_private_dns_a_record" "test2" {
+  name                = "myarecord2%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_private_dns_zone.test.name
+  ttl                 = 300
+  records             = ["10.0.180.18"]
+}
+
+resource "azurerm_private_dns_cname_record" "test" {
+  name                = "mycnamerecord%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  zone_name           = azurerm_private_dns_zone.test.name
+  ttl                 = 300
+  record              = "contoso.com"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r PrivateDnsARecordResource) basicList_query(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+list "azurerm_private_dns_a_record" "test" {
+  provider = azurerm
+
+  config {
+    private_dns_zone_id = "/subscriptions/%s/resourceGroups/acctestRG-%d/providers/Microsoft.Network/privateDnsZones/acctestzone%d.com"
+  }
+}
+`, data.Subscriptions.Primary, data.RandomInteger, data.RandomInteger)
+}
@@ -4,6 +4,7 @@
 package privatedns
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"strings"
@@ -27,6 +28,8 @@ import (
 
 //go:generate go run ../../tools/generator-tests resourceidentity -resource-name private_dns_zone -service-package-name privatedns -properties "name,resource_group_name" -known-values "subscription_id:data.Subscriptions.Primary"
 
+const privateDnsZoneResourceName = "azurerm_private_dns_zone"
+
 func resourcePrivateDnsZone() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create:   resourcePrivateDnsZoneCreateUpdate,
@@ -173,7 +176,7 @@ func resourcePrivateDnsZoneCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		}
 
 		if !response.WasNotFound(existing.HttpResponse) {
-			return tf.ImportAsExistsError("azurerm_private_dns_zone", id.ID())
+			return tf.ImportAsExistsError(privateDnsZoneResourceName, id.ID())
 		}
 	}
 
@@ -225,7 +228,6 @@ func resourcePrivateDnsZoneCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 
 func resourcePrivateDnsZoneRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).PrivateDns.PrivateZonesClient
-	recordSetsClient := meta.(*clients.Client).PrivateDns.RecordSetsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -243,16 +245,14 @@ func resourcePrivateDnsZoneRead(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
-	recordId := privatedns.NewRecordTypeID(id.SubscriptionId, id.ResourceGroupName, id.PrivateDnsZoneName, privatedns.RecordTypeSOA, "@")
-	recordSetResp, err := recordSetsClient.RecordSetsGet(ctx, recordId)
-	if err != nil {
-		return fmt.Errorf("reading DNS SOA record @: %v", err)
-	}
+	return resourcePrivateDnsZoneFlatten(ctx, d, *id, resp.Model, meta.(*clients.Client))
+}
 
+func resourcePrivateDnsZoneFlatten(ctx context.Context, d *pluginsdk.ResourceData, id privatezones.PrivateDnsZoneId, model *privatezones.PrivateZone, client *clients.Client) error {
 	d.Set("name", id.PrivateDnsZoneName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
-	if model := resp.Model; model != nil {
+	if model != nil {
 		if props := model.Properties; props != nil {
 			d.Set("number_of_record_sets", props.NumberOfRecordSets)
 			d.Set("max_number_of_record_sets", props.MaxNumberOfRecordSets)
@@ -260,16 +260,26 @@ func resourcePrivateDnsZoneRead(d *pluginsdk.ResourceData, meta interface{}) err
 			d.Set("max_number_of_virtual_network_links_with_registration", props.MaxNumberOfVirtualNetworkLinksWithRegistration)
 		}
 
-		if err = tags.FlattenAndSet(d, model.Tags); err != nil {
+		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
 			return err
 		}
 	}
 
-	if err = d.Set("soa_record", flattenPrivateDNSZoneSOARecord(recordSetResp.Model)); err != nil {
+	if err := pluginsdk.SetResourceIdentityData(d, &id); err != nil {
+		return err
+	}
+
+	recordId := privatedns.NewRecordTypeID(id.SubscriptionId, id.ResourceGroupName, id.PrivateDnsZoneName, privatedns.RecordTypeSOA, "@")
+	recordSetResp, err := client.PrivateDns.RecordSetsClient.RecordSetsGet(ctx, recordId)
+	if err != nil {
+		return fmt.Errorf("reading DNS SOA record @: %v", err)
+	}
+
+	if err := d.Set("soa_record", flattenPrivateDNSZoneSOARecord(recordSetResp.Model)); err != nil {
 		return fmt.Errorf("setting `soa_record`: %+v", err)
 	}
 
-	return pluginsdk.SetResourceIdentityData(d, id)
+	return nil
 }
 
 func resourcePrivateDnsZoneDelete(d *pluginsdk.ResourceData, meta interface{}) error {
@@ -4,11 +4,20 @@
 package privatedns
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
 type Registration struct{}
 
+var (
+	_ sdk.UntypedServiceRegistrationWithAGitHubLabel = Registration{}
+	_ sdk.FrameworkServiceRegistration               = Registration{}
+)
+
 func (r Registration) AssociatedGitHubLabel() string {
 	return "service/dns"
 }
@@ -55,3 +64,32 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_private_dns_zone_virtual_network_link": resourcePrivateDnsZoneVirtualNetworkLink(),
 	}
 }
+
+func (r Registration) Actions() []func() action.Action {
+	return []func() action.Action{}
+}
+
+func (r Registration) FrameworkResources() []sdk.FrameworkWrappedResource {
+	return []sdk.FrameworkWrappedResource{}
+}
+
+func (r Registration) FrameworkDataSources() []sdk.FrameworkWrappedDataSource {
+	return []sdk.FrameworkWrappedDataSource{}
+}
+
+func (r Registration) EphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{}
+}
+
+func (r Registration) ListResources() []func() list.ListResource {
+	return []func() list.ListResource{
+		NewPrivateDnsZoneListResource,
+		NewPrivateDnsARecordListResource,
+		NewPrivateDnsAaaaRecordListResource,
+		NewPrivateDnsCNameRecordListResource,
+		NewPrivateDnsMxRecordListResource,
+		NewPrivateDnsPtrRecordListResource,
+		NewPrivateDnsSrvRecordListResource,
+		NewPrivateDnsTxtRecordListResource,
+	}
+}
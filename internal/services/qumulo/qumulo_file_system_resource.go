@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/subnets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/qumulostorage/2024-06-19/filesystems"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/qumulo/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -31,18 +32,19 @@ func (r FileSystemResource) ModelObject() interface{} {
 }
 
 type FileSystemResourceSchema struct {
-	AdminPassword     string            `tfschema:"admin_password"`
-	Location          string            `tfschema:"location"`
-	Name              string            `tfschema:"name"`
-	OfferId           string            `tfschema:"offer_id"`
-	PlanId            string            `tfschema:"plan_id"`
-	PublisherId       string            `tfschema:"publisher_id"`
-	ResourceGroupName string            `tfschema:"resource_group_name"`
-	StorageSku        string            `tfschema:"storage_sku"`
-	SubnetId          string            `tfschema:"subnet_id"`
-	Tags              map[string]string `tfschema:"tags"`
-	Email             string            `tfschema:"email"`
-	Zone              string            `tfschema:"zone"`
+	AdminPassword          string            `tfschema:"admin_password"`
+	AdminPasswordWoVersion int64             `tfschema:"admin_password_wo_version"`
+	Location               string            `tfschema:"location"`
+	Name                   string            `tfschema:"name"`
+	OfferId                string            `tfschema:"offer_id"`
+	PlanId                 string            `tfschema:"plan_id"`
+	PublisherId            string            `tfschema:"publisher_id"`
+	ResourceGroupName      string            `tfschema:"resource_group_name"`
+	StorageSku             string            `tfschema:"storage_sku"`
+	SubnetId               string            `tfschema:"subnet_id"`
+	Tags                   map[string]string `tfschema:"tags"`
+	Email                  string            `tfschema:"email"`
+	Zone                   string            `tfschema:"zone"`
 }
 
 func (r FileSystemResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
@@ -68,10 +70,28 @@ func (r FileSystemResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"admin_password": {
 			Type:         pluginsdk.TypeString,
-			Required:     true,
+			Optional:     true,
 			ForceNew:     true,
 			Sensitive:    true,
 			ValidateFunc: validate.ValidatePasswordComplexity,
+			ExactlyOneOf: []string{"admin_password", "admin_password_wo"},
+		},
+
+		"admin_password_wo": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			WriteOnly:    true,
+			ValidateFunc: validate.ValidatePasswordComplexity,
+			ExactlyOneOf: []string{"admin_password", "admin_password_wo"},
+			RequiredWith: []string{"admin_password_wo_version"},
+		},
+
+		"admin_password_wo_version": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			RequiredWith: []string{"admin_password_wo"},
 		},
 
 		"email": {
@@ -161,11 +181,20 @@ func (r FileSystemResource) Create() sdk.ResourceFunc {
 				return err
 			}
 
+			adminPassword := config.AdminPassword
+			adminPasswordWo, err := pluginsdk.GetWriteOnly(metadata.ResourceData, "admin_password_wo", cty.String)
+			if err != nil {
+				return err
+			}
+			if !adminPasswordWo.IsNull() {
+				adminPassword = adminPasswordWo.AsString()
+			}
+
 			payload := filesystems.LiftrBaseStorageFileSystemResource{
 				Location: location.Normalize(config.Location),
 				Tags:     pointer.To(config.Tags),
 				Properties: &filesystems.LiftrBaseStorageFileSystemResourceProperties{
-					AdminPassword:     config.AdminPassword,
+					AdminPassword:     adminPassword,
 					AvailabilityZone:  pointer.To(config.Zone),
 					DelegatedSubnetId: config.SubnetId,
 					StorageSku:        config.StorageSku,
@@ -218,8 +247,9 @@ func (r FileSystemResource) Read() sdk.ResourceFunc {
 				Name:              id.FileSystemName,
 				ResourceGroupName: id.ResourceGroupName,
 
-				AdminPassword: config.AdminPassword,
-				Email:         config.Email,
+				AdminPassword:          config.AdminPassword,
+				AdminPasswordWoVersion: config.AdminPasswordWoVersion,
+				Email:                  config.Email,
 			}
 
 			if model := resp.Model; model != nil {
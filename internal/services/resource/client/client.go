@@ -19,11 +19,13 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/resources"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2023-07-01/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/sdkhacks"
 )
 
 type Client struct {
 	DeploymentsClient                   *deployments.DeploymentsClient
 	DeploymentScriptsClient             *deploymentscripts.DeploymentScriptsClient
+	DeploymentStacksClient              *sdkhacks.DeploymentStacksClient
 	FeaturesClient                      *features.FeaturesClient
 	LocksClient                         *managementlocks.ManagementLocksClient
 	PrivateLinkAssociationClient        *privatelinkassociation.PrivateLinkAssociationClient
@@ -57,6 +59,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(deploymentScriptsClient.Client, o.Authorizers.ResourceManager)
 
+	deploymentStacksClient, err := sdkhacks.NewDeploymentStacksClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building DeploymentStacks client: %+v", err)
+	}
+	o.Configure(deploymentStacksClient.Client, o.Authorizers.ResourceManager)
+
 	featuresClient, err := features.NewFeaturesClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Features client: %+v", err)
@@ -125,6 +133,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		// These come from `hashicorp/go-azure-sdk`
 		DeploymentsClient:                   deploymentsClient,
 		DeploymentScriptsClient:             deploymentScriptsClient,
+		DeploymentStacksClient:              deploymentStacksClient,
 		FeaturesClient:                      featuresClient,
 		LocksClient:                         locksClient,
 		PrivateLinkAssociationClient:        privateLinkAssociationClient,
@@ -0,0 +1,330 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/sdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type deploymentStackActionOnUnmanage string
+
+const (
+	actionOnUnmanageDeleteAll       deploymentStackActionOnUnmanage = "DeleteAll"
+	actionOnUnmanageDeleteResources deploymentStackActionOnUnmanage = "DeleteResources"
+	actionOnUnmanageDetachAll       deploymentStackActionOnUnmanage = "DetachAll"
+)
+
+var deploymentStackActionsOnUnmanage = []string{
+	string(actionOnUnmanageDeleteAll),
+	string(actionOnUnmanageDeleteResources),
+	string(actionOnUnmanageDetachAll),
+}
+
+// deploymentStackCommonArguments returns the arguments which are common to the Deployment Stack resources at
+// every scope, which each resource then adds its scope-specific arguments to
+func deploymentStackCommonArguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DeploymentStackName,
+		},
+
+		"action_on_unmanage": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(deploymentStackActionsOnUnmanage, false),
+		},
+
+		"deny_settings_mode": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(sdkhacks.PossibleValuesForDenySettingsMode(), false),
+		},
+
+		"template_content": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ExactlyOneOf: []string{
+				"template_content",
+				"template_spec_version_id",
+			},
+			StateFunc: utils.NormalizeJson,
+		},
+
+		"template_spec_version_id": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ExactlyOneOf: []string{
+				"template_content",
+				"template_spec_version_id",
+			},
+			ValidateFunc: validate.TemplateSpecVersionID,
+		},
+
+		"deny_settings_apply_to_child_scopes": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"deny_settings_excluded_actions": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 200,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"deny_settings_excluded_principals": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 5,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 4096),
+		},
+
+		"parameters_content": {
+			Type:      pluginsdk.TypeString,
+			Optional:  true,
+			Computed:  true,
+			StateFunc: utils.NormalizeJson,
+		},
+	}
+}
+
+func deploymentStackCommonAttributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"managed_resource_ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"output_content": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+// expandDeploymentStackTemplate returns either the Template or the Template Link for the Deployment Stack, depending
+// on whether `template_content` or `template_spec_version_id` was specified
+func expandDeploymentStackTemplate(templateContent string, templateSpecVersionId string) (*map[string]interface{}, *sdkhacks.DeploymentStacksTemplateLink, error) {
+	if templateSpecVersionId != "" {
+		return nil, &sdkhacks.DeploymentStacksTemplateLink{
+			Id: pointer.To(templateSpecVersionId),
+		}, nil
+	}
+
+	template, err := expandTemplateDeploymentBody(templateContent)
+	if err != nil {
+		return nil, nil, fmt.Errorf("expanding `template_content`: %+v", err)
+	}
+
+	return template, nil, nil
+}
+
+func expandDeploymentStackParameters(input string) (*map[string]interface{}, error) {
+	if input == "" {
+		return nil, nil
+	}
+
+	parameters, err := expandTemplateDeploymentBody(input)
+	if err != nil {
+		return nil, fmt.Errorf("expanding `parameters_content`: %+v", err)
+	}
+
+	return parameters, nil
+}
+
+// expandDeploymentStackActionOnUnmanage maps `action_on_unmanage` onto the behaviour for each type of resource managed
+// by the Deployment Stack, which matches the `--action-on-unmanage` flag in the Azure CLI
+func expandDeploymentStackActionOnUnmanage(input string) sdkhacks.ActionOnUnmanage {
+	resources := sdkhacks.UnmanageActionModeDetach
+	groups := sdkhacks.UnmanageActionModeDetach
+
+	switch deploymentStackActionOnUnmanage(input) {
+	case actionOnUnmanageDeleteAll:
+		resources = sdkhacks.UnmanageActionModeDelete
+		groups = sdkhacks.UnmanageActionModeDelete
+	case actionOnUnmanageDeleteResources:
+		resources = sdkhacks.UnmanageActionModeDelete
+	}
+
+	return sdkhacks.ActionOnUnmanage{
+		ManagementGroups: pointer.To(groups),
+		ResourceGroups:   pointer.To(groups),
+		Resources:        resources,
+	}
+}
+
+func flattenDeploymentStackActionOnUnmanage(input sdkhacks.ActionOnUnmanage) string {
+	if !strings.EqualFold(string(input.Resources), string(sdkhacks.UnmanageActionModeDelete)) {
+		return string(actionOnUnmanageDetachAll)
+	}
+
+	if input.ResourceGroups != nil && strings.EqualFold(string(*input.ResourceGroups), string(sdkhacks.UnmanageActionModeDelete)) {
+		return string(actionOnUnmanageDeleteAll)
+	}
+
+	return string(actionOnUnmanageDeleteResources)
+}
+
+// expandDeploymentStackDeleteOptions returns the options used when deleting the Deployment Stack, since the API
+// requires the unmanage actions to be specified again when deleting
+func expandDeploymentStackDeleteOptions(actionOnUnmanage string) sdkhacks.DeploymentStackDeleteOperationOptions {
+	actions := expandDeploymentStackActionOnUnmanage(actionOnUnmanage)
+
+	options := sdkhacks.DefaultDeploymentStackDeleteOperationOptions()
+	options.UnmanageActionManagementGroups = actions.ManagementGroups
+	options.UnmanageActionResourceGroups = actions.ResourceGroups
+	options.UnmanageActionResources = pointer.To(actions.Resources)
+	return options
+}
+
+func expandDeploymentStackDenySettings(mode string, excludedActions []string, excludedPrincipals []string, applyToChildScopes bool) sdkhacks.DenySettings {
+	output := sdkhacks.DenySettings{
+		Mode: sdkhacks.DenySettingsMode(mode),
+	}
+
+	// these can only be specified when the Deny Settings are enabled
+	if output.Mode != sdkhacks.DenySettingsModeNone {
+		output.ApplyToChildScopes = pointer.To(applyToChildScopes)
+		output.ExcludedActions = pointer.To(excludedActions)
+		output.ExcludedPrincipals = pointer.To(excludedPrincipals)
+	}
+
+	return output
+}
+
+func flattenDeploymentStackDenySettingsMode(input sdkhacks.DenySettingsMode) string {
+	for _, v := range sdkhacks.PossibleValuesForDenySettingsMode() {
+		if strings.EqualFold(v, string(input)) {
+			return v
+		}
+	}
+
+	return string(input)
+}
+
+// flattenDeploymentStackManagedResourceIds returns the sorted IDs of the resources which are currently managed by the
+// Deployment Stack, omitting any which have been detached or deleted
+func flattenDeploymentStackManagedResourceIds(input *[]sdkhacks.ManagedResourceReference) []string {
+	output := make([]string, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		if v.Id == nil {
+			continue
+		}
+		if v.Status != nil && !strings.EqualFold(*v.Status, "managed") {
+			continue
+		}
+
+		output = append(output, *v.Id)
+	}
+	sort.Strings(output)
+
+	return output
+}
+
+// flattenDeploymentStackTemplateContent retrieves the Template used by the Deployment Stack, since this isn't
+// returned when retrieving the Deployment Stack itself
+func flattenDeploymentStackTemplateContent(ctx context.Context, client *sdkhacks.DeploymentStacksClient, id resourceids.Id) (string, error) {
+	resp, err := client.ExportTemplate(ctx, id)
+	if err != nil {
+		return "", fmt.Errorf("retrieving Template Content for %s: %+v", id, err)
+	}
+
+	var template interface{}
+	if model := resp.Model; model != nil && model.Template != nil {
+		template = *model.Template
+	}
+
+	flattened, err := flattenTemplateDeploymentBody(template)
+	if err != nil {
+		return "", fmt.Errorf("flattening `template_content`: %+v", err)
+	}
+
+	return pointer.From(flattened), nil
+}
+
+func flattenDeploymentStackParameters(input *map[string]interface{}) (string, error) {
+	var parameters interface{}
+	if input != nil {
+		parameters = *input
+	}
+
+	flattened, err := flattenTemplateDeploymentBody(filterOutTemplateDeploymentParameters(parameters))
+	if err != nil {
+		return "", fmt.Errorf("flattening `parameters_content`: %+v", err)
+	}
+
+	return pointer.From(flattened), nil
+}
+
+func flattenDeploymentStackOutputs(input *map[string]interface{}) (string, error) {
+	var outputs interface{}
+	if input != nil {
+		outputs = *input
+	}
+
+	flattened, err := flattenTemplateDeploymentBody(outputs)
+	if err != nil {
+		return "", fmt.Errorf("flattening `output_content`: %+v", err)
+	}
+
+	return pointer.From(flattened), nil
+}
+
+// deploymentStackError returns an error when the Deployment Stack has failed to provision, since the API returns the
+// details of the failure within the Deployment Stack rather than failing the long-running operation
+func deploymentStackError(id resourceids.Id, model *sdkhacks.DeploymentStack) error {
+	if model == nil || model.Properties == nil || model.Properties.ProvisioningState == nil {
+		return nil
+	}
+	if !strings.EqualFold(string(*model.Properties.ProvisioningState), string(sdkhacks.DeploymentStackProvisioningStateFailed)) {
+		return nil
+	}
+
+	message := "unknown error"
+	if e := model.Properties.Error; e != nil {
+		message = fmt.Sprintf("%s: %s", pointer.From(e.Code), pointer.From(e.Message))
+		if e.Details != nil {
+			for _, detail := range *e.Details {
+				message += fmt.Sprintf("\n%s: %s", pointer.From(detail.Code), pointer.From(detail.Message))
+			}
+		}
+	}
+
+	return fmt.Errorf("%s failed to provision: %s", id, message)
+}
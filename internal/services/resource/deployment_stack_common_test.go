@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/sdkhacks"
+)
+
+func TestDeploymentStackActionOnUnmanage(t *testing.T) {
+	testcases := []struct {
+		Input            string
+		Resources        sdkhacks.UnmanageActionMode
+		ResourceGroups   sdkhacks.UnmanageActionMode
+		ManagementGroups sdkhacks.UnmanageActionMode
+	}{
+		{
+			Input:            string(actionOnUnmanageDeleteAll),
+			Resources:        sdkhacks.UnmanageActionModeDelete,
+			ResourceGroups:   sdkhacks.UnmanageActionModeDelete,
+			ManagementGroups: sdkhacks.UnmanageActionModeDelete,
+		},
+		{
+			Input:            string(actionOnUnmanageDeleteResources),
+			Resources:        sdkhacks.UnmanageActionModeDelete,
+			ResourceGroups:   sdkhacks.UnmanageActionModeDetach,
+			ManagementGroups: sdkhacks.UnmanageActionModeDetach,
+		},
+		{
+			Input:            string(actionOnUnmanageDetachAll),
+			Resources:        sdkhacks.UnmanageActionModeDetach,
+			ResourceGroups:   sdkhacks.UnmanageActionModeDetach,
+			ManagementGroups: sdkhacks.UnmanageActionModeDetach,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Logf("[DEBUG] Testing %q..", tc.Input)
+
+		actual := expandDeploymentStackActionOnUnmanage(tc.Input)
+		if actual.Resources != tc.Resources {
+			t.Fatalf("expected `resources` to be %q but got %q", tc.Resources, actual.Resources)
+		}
+		if pointer.From(actual.ResourceGroups) != tc.ResourceGroups {
+			t.Fatalf("expected `resourceGroups` to be %q but got %q", tc.ResourceGroups, pointer.From(actual.ResourceGroups))
+		}
+		if pointer.From(actual.ManagementGroups) != tc.ManagementGroups {
+			t.Fatalf("expected `managementGroups` to be %q but got %q", tc.ManagementGroups, pointer.From(actual.ManagementGroups))
+		}
+
+		if flattened := flattenDeploymentStackActionOnUnmanage(actual); flattened != tc.Input {
+			t.Fatalf("expected the flattened value to be %q but got %q", tc.Input, flattened)
+		}
+	}
+}
+
+func TestFlattenDeploymentStackManagedResourceIds(t *testing.T) {
+	input := []sdkhacks.ManagedResourceReference{
+		{
+			Id:     pointer.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/b"),
+			Status: pointer.To("managed"),
+		},
+		{
+			Id:     pointer.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/a"),
+			Status: pointer.To("Managed"),
+		},
+		{
+			Id:     pointer.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/c"),
+			Status: pointer.To("deleteFailed"),
+		},
+		{
+			Status: pointer.To("managed"),
+		},
+	}
+	expected := []string{
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/a",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/b",
+	}
+
+	actual := flattenDeploymentStackManagedResourceIds(&input)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+
+	if actual := flattenDeploymentStackManagedResourceIds(nil); len(actual) != 0 {
+		t.Fatalf("expected no Resource IDs but got %+v", actual)
+	}
+}
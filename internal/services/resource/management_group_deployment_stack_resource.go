@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/sdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = ManagementGroupDeploymentStackResource{}
+
+type ManagementGroupDeploymentStackResource struct{}
+
+type ManagementGroupDeploymentStackResourceModel struct {
+	Name                           string            `tfschema:"name"`
+	ManagementGroupId              string            `tfschema:"management_group_id"`
+	Location                       string            `tfschema:"location"`
+	DeploymentSubscriptionId       string            `tfschema:"deployment_subscription_id"`
+	ActionOnUnmanage               string            `tfschema:"action_on_unmanage"`
+	DenySettingsMode               string            `tfschema:"deny_settings_mode"`
+	TemplateContent                string            `tfschema:"template_content"`
+	TemplateSpecVersionId          string            `tfschema:"template_spec_version_id"`
+	DenySettingsApplyToChildScopes bool              `tfschema:"deny_settings_apply_to_child_scopes"`
+	DenySettingsExcludedActions    []string          `tfschema:"deny_settings_excluded_actions"`
+	DenySettingsExcludedPrincipals []string          `tfschema:"deny_settings_excluded_principals"`
+	Description                    string            `tfschema:"description"`
+	ParametersContent              string            `tfschema:"parameters_content"`
+	Tags                           map[string]string `tfschema:"tags"`
+	ManagedResourceIds             []string          `tfschema:"managed_resource_ids"`
+	OutputContent                  string            `tfschema:"output_content"`
+}
+
+func (ManagementGroupDeploymentStackResource) ResourceType() string {
+	return "azurerm_management_group_deployment_stack"
+}
+
+func (ManagementGroupDeploymentStackResource) ModelObject() interface{} {
+	return &ManagementGroupDeploymentStackResourceModel{}
+}
+
+func (ManagementGroupDeploymentStackResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return sdkhacks.ValidateManagementGroupDeploymentStackID
+}
+
+func (ManagementGroupDeploymentStackResource) Arguments() map[string]*pluginsdk.Schema {
+	arguments := deploymentStackCommonArguments()
+	arguments["management_group_id"] = commonschema.ResourceIDReferenceRequiredForceNew(&commonids.ManagementGroupId{})
+	arguments["location"] = commonschema.Location()
+	arguments["deployment_subscription_id"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.IsUUID,
+	}
+	arguments["tags"] = commonschema.Tags()
+	return arguments
+}
+
+func (ManagementGroupDeploymentStackResource) Attributes() map[string]*pluginsdk.Schema {
+	return deploymentStackCommonAttributes()
+}
+
+func (r ManagementGroupDeploymentStackResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.DeploymentStacksClient
+
+			var config ManagementGroupDeploymentStackResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			managementGroupId, err := commonids.ParseManagementGroupID(config.ManagementGroupId)
+			if err != nil {
+				return err
+			}
+
+			id := sdkhacks.NewManagementGroupDeploymentStackID(managementGroupId.GroupId, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload, err := r.expand(config)
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, *payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			return deploymentStackError(id, resp.Model)
+		},
+	}
+}
+
+func (r ManagementGroupDeploymentStackResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.DeploymentStacksClient
+
+			id, err := sdkhacks.ParseManagementGroupDeploymentStackID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := ManagementGroupDeploymentStackResourceModel{
+				Name:              id.DeploymentStackName,
+				ManagementGroupId: commonids.NewManagementGroupID(id.ManagementGroupId).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.NormalizeNilable(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.ActionOnUnmanage = flattenDeploymentStackActionOnUnmanage(props.ActionOnUnmanage)
+					state.DenySettingsMode = flattenDeploymentStackDenySettingsMode(props.DenySettings.Mode)
+					state.DenySettingsApplyToChildScopes = pointer.From(props.DenySettings.ApplyToChildScopes)
+					state.DenySettingsExcludedActions = pointer.From(props.DenySettings.ExcludedActions)
+					state.DenySettingsExcludedPrincipals = pointer.From(props.DenySettings.ExcludedPrincipals)
+					state.Description = pointer.From(props.Description)
+					state.ManagedResourceIds = flattenDeploymentStackManagedResourceIds(props.Resources)
+
+					if props.DeploymentScope != nil {
+						if scope, err := commonids.ParseSubscriptionIDInsensitively(*props.DeploymentScope); err == nil {
+							state.DeploymentSubscriptionId = scope.SubscriptionId
+						}
+					}
+
+					if props.TemplateLink != nil {
+						state.TemplateSpecVersionId = pointer.From(props.TemplateLink.Id)
+					}
+
+					if state.ParametersContent, err = flattenDeploymentStackParameters(props.Parameters); err != nil {
+						return err
+					}
+
+					if state.OutputContent, err = flattenDeploymentStackOutputs(props.Outputs); err != nil {
+						return err
+					}
+				}
+			}
+
+			if state.TemplateContent, err = flattenDeploymentStackTemplateContent(ctx, client, *id); err != nil {
+				return err
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ManagementGroupDeploymentStackResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.DeploymentStacksClient
+
+			id, err := sdkhacks.ParseManagementGroupDeploymentStackID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ManagementGroupDeploymentStackResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the Deployment Stack is redeployed with the full configuration, since the API doesn't support PATCH
+			payload, err := r.expand(config)
+			if err != nil {
+				return err
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			return deploymentStackError(id, resp.Model)
+		},
+	}
+}
+
+func (r ManagementGroupDeploymentStackResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 180 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.DeploymentStacksClient
+
+			id, err := sdkhacks.ParseManagementGroupDeploymentStackID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ManagementGroupDeploymentStackResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id, expandDeploymentStackDeleteOptions(config.ActionOnUnmanage)); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (ManagementGroupDeploymentStackResource) expand(input ManagementGroupDeploymentStackResourceModel) (*sdkhacks.DeploymentStack, error) {
+	template, templateLink, err := expandDeploymentStackTemplate(input.TemplateContent, input.TemplateSpecVersionId)
+	if err != nil {
+		return nil, err
+	}
+
+	parameters, err := expandDeploymentStackParameters(input.ParametersContent)
+	if err != nil {
+		return nil, err
+	}
+
+	var deploymentScope *string
+	if input.DeploymentSubscriptionId != "" {
+		deploymentScope = pointer.To(commonids.NewSubscriptionID(input.DeploymentSubscriptionId).ID())
+	}
+
+	return &sdkhacks.DeploymentStack{
+		Location: pointer.To(location.Normalize(input.Location)),
+		Properties: &sdkhacks.DeploymentStackProperties{
+			ActionOnUnmanage: expandDeploymentStackActionOnUnmanage(input.ActionOnUnmanage),
+			DenySettings:     expandDeploymentStackDenySettings(input.DenySettingsMode, input.DenySettingsExcludedActions, input.DenySettingsExcludedPrincipals, input.DenySettingsApplyToChildScopes),
+			DeploymentScope:  deploymentScope,
+			Description:      pointer.To(input.Description),
+			Parameters:       parameters,
+			Template:         template,
+			TemplateLink:     templateLink,
+		},
+		Tags: pointer.To(input.Tags),
+	}, nil
+}
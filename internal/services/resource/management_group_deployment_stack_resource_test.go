@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/sdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ManagementGroupDeploymentStackResource struct{}
+
+func TestAccManagementGroupDeploymentStack_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_deployment_stack", "test")
+	r := ManagementGroupDeploymentStackResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_resource_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccManagementGroupDeploymentStack_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_deployment_stack", "test")
+	r := ManagementGroupDeploymentStackResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccManagementGroupDeploymentStack_templateSpec(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_group_deployment_stack", "test")
+	r := ManagementGroupDeploymentStackResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.templateSpec(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ManagementGroupDeploymentStackResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := sdkhacks.ParseManagementGroupDeploymentStackID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Resource.DeploymentStacksClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (ManagementGroupDeploymentStackResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_management_group" "test" {
+  name = "TestAcc-Stack-%[1]d"
+}
+
+resource "azurerm_management_group_deployment_stack" "test" {
+  name                = "acctest-stack-%[1]d"
+  management_group_id = azurerm_management_group.test.id
+  location            = %[2]q
+  action_on_unmanage  = "DeleteAll"
+  deny_settings_mode  = "none"
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-08-01/managementGroupDeploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "resources": [
+    {
+      "type": "Microsoft.Authorization/policyDefinitions",
+      "apiVersion": "2021-06-01",
+      "name": "acctestpol-%[1]d",
+      "properties": {
+        "policyType": "Custom",
+        "mode": "All",
+        "policyRule": {
+          "if": {
+            "field": "location",
+            "equals": "westus"
+          },
+          "then": {
+            "effect": "audit"
+          }
+        }
+      }
+    }
+  ]
+}
+TEMPLATE
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ManagementGroupDeploymentStackResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_management_group_deployment_stack" "import" {
+  name                = azurerm_management_group_deployment_stack.test.name
+  management_group_id = azurerm_management_group_deployment_stack.test.management_group_id
+  location            = azurerm_management_group_deployment_stack.test.location
+  action_on_unmanage  = azurerm_management_group_deployment_stack.test.action_on_unmanage
+  deny_settings_mode  = azurerm_management_group_deployment_stack.test.deny_settings_mode
+  template_content    = azurerm_management_group_deployment_stack.test.template_content
+}
+`, r.basic(data))
+}
+
+func (ManagementGroupDeploymentStackResource) templateSpec(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_management_group" "test" {
+  name = "TestAcc-Stack-%[1]d"
+}
+
+data "azurerm_template_spec_version" "test" {
+  name                = "acctest-standing-data-empty"
+  resource_group_name = "standing-data-for-acctest"
+  version             = "v1.0.0"
+}
+
+resource "azurerm_management_group_deployment_stack" "test" {
+  name                     = "acctest-stack-%[1]d"
+  management_group_id      = azurerm_management_group.test.id
+  location                 = %[2]q
+  action_on_unmanage       = "DetachAll"
+  deny_settings_mode       = "none"
+  template_spec_version_id = data.azurerm_template_spec_version.test.id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
@@ -27,10 +28,10 @@ import (
 
 func managementGroupTemplateDeploymentResource() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: managementGroupTemplateDeploymentResourceCreate,
-		Read:   managementGroupTemplateDeploymentResourceRead,
-		Update: managementGroupTemplateDeploymentResourceUpdate,
-		Delete: managementGroupTemplateDeploymentResourceDelete,
+		CreateContext: templateDeploymentWithWhatIfDiagnostics(managementGroupTemplateDeploymentResourceCreate, managementGroupTemplateDeploymentWhatIfTriggers),
+		Read:          managementGroupTemplateDeploymentResourceRead,
+		UpdateContext: templateDeploymentWithWhatIfDiagnostics(managementGroupTemplateDeploymentResourceUpdate, managementGroupTemplateDeploymentWhatIfTriggers),
+		Delete:        managementGroupTemplateDeploymentResourceDelete,
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := parse.ManagementGroupTemplateDeploymentID(id)
 			return err
@@ -100,6 +101,27 @@ func managementGroupTemplateDeploymentResource() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 
+			"nested_resources_cleanup_mode": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(templateDeploymentNestedResourcesCleanupModes, false),
+			},
+
+			"nested_resources_cleanup_resource_types": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"what_if_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			// Computed
 			"output_content": {
 				Type:     pluginsdk.TypeString,
@@ -107,10 +129,31 @@ func managementGroupTemplateDeploymentResource() *pluginsdk.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"outputs": templateDeploymentOutputsSchema(),
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			customizeDiffTemplateDeploymentNestedResourcesCleanup,
+
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+				return customizeDiffTemplateDeploymentWhatIf(ctx, d, managementGroupTemplateDeploymentWhatIfTriggers, func(ctx context.Context, properties *resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error) {
+					client := meta.(*clients.Client).Resource.LegacyDeploymentsClient
+
+					return whatIfManagementGroupTemplateDeployment(ctx, d.Get("management_group_id").(string), d.Get("name").(string), resources.ScopedDeploymentWhatIf{
+						Location:   utils.String(location.Normalize(d.Get("location").(string))),
+						Properties: properties,
+					}, client)
+				})
+			},
+		),
 	}
 }
 
+var managementGroupTemplateDeploymentWhatIfTriggers = append([]string{"management_group_id", "location"}, templateDeploymentWhatIfTriggers...)
+
 func managementGroupTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Resource.LegacyDeploymentsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
@@ -195,6 +238,11 @@ func managementGroupTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData,
 		return err
 	}
 
+	// these fields only control the behaviour of Terraform, so the deployment doesn't need to be re-run when only these change
+	if !d.HasChangesExcept("nested_resources_cleanup_mode", "nested_resources_cleanup_resource_types", "what_if_enabled", "what_if_changes") {
+		return managementGroupTemplateDeploymentResourceRead(d, meta)
+	}
+
 	log.Printf("[DEBUG] Retrieving Management Group Template Deployment %q..", id.DeploymentName)
 	template, err := client.GetAtManagementGroupScope(ctx, id.ManagementGroupName, id.DeploymentName)
 	if err != nil {
@@ -324,6 +372,14 @@ func managementGroupTemplateDeploymentResourceRead(d *pluginsdk.ResourceData, me
 		}
 		d.Set("output_content", flattenedOutputs)
 
+		outputs, err := flattenTemplateDeploymentOutputs(props.Outputs)
+		if err != nil {
+			return fmt.Errorf("flattening `outputs`: %+v", err)
+		}
+		if err := d.Set("outputs", outputs); err != nil {
+			return fmt.Errorf("setting `outputs`: %+v", err)
+		}
+
 		templateLinkId := ""
 		if props.TemplateLink != nil {
 			if props.TemplateLink.ID != nil {
@@ -352,8 +408,28 @@ func managementGroupTemplateDeploymentResourceDelete(d *pluginsdk.ResourceData,
 		return err
 	}
 
-	// at this time unfortunately the Resources RP doesn't expose a means of deleting top-level objects
-	// so we're unable to delete these during deletion - this'll need to be detailed in the docs
+	// at this time unfortunately the Resources RP doesn't expose a means of deleting top-level objects (such as Resource Groups)
+	// so only the resources provisioned within a Resource Provider can be deleted - this is detailed in the docs
+	if deleteItemsInTemplate, resourceTypes, includeNestedDeployments := expandTemplateDeploymentNestedResourcesCleanup(d, nestedResourcesCleanupModeNone); deleteItemsInTemplate {
+		log.Printf("[DEBUG] Retrieving Management Group Template Deployment %q..", id.DeploymentName)
+		template, err := client.GetAtManagementGroupScope(ctx, id.ManagementGroupName, id.DeploymentName)
+		if err != nil {
+			if utils.ResponseWasNotFound(template.Response) {
+				return nil
+			}
+
+			return fmt.Errorf("retrieving Management Group Template Deployment %q: %+v", id.DeploymentName, err)
+		}
+		if template.Properties == nil {
+			return fmt.Errorf("retrieving Management Group Template Deployment %q: `properties` was nil", id.DeploymentName)
+		}
+
+		log.Printf("[DEBUG] Removing items provisioned by the Management Group Template Deployment %q..", id.DeploymentName)
+		if err := deleteItemsProvisionedByTemplate(ctx, meta.(*clients.Client).Resource, *template.Properties, meta.(*clients.Client).Account.SubscriptionId, resourceTypes, includeNestedDeployments); err != nil {
+			return fmt.Errorf("removing items provisioned by this Management Group Template Deployment: %+v", err)
+		}
+		log.Printf("[DEBUG] Removed items provisioned by the Management Group Template Deployment %q..", id.DeploymentName)
+	}
 
 	log.Printf("[DEBUG] Deleting Management Group Template Deployment %q..", id.DeploymentName)
 	future, err := client.DeleteAtManagementGroupScope(ctx, id.ManagementGroupName, id.DeploymentName)
@@ -391,3 +467,29 @@ func validateManagementGroupTemplateDeployment(ctx context.Context, id parse.Man
 
 	return nil
 }
+
+// whatIfManagementGroupTemplateDeployment returns nil when the Management Group doesn't exist yet, since the changes can't be predicted
+func whatIfManagementGroupTemplateDeployment(ctx context.Context, managementGroupId string, deploymentName string, deployment resources.ScopedDeploymentWhatIf, client *resources.DeploymentsClient) (*resources.WhatIfOperationResult, error) {
+	id, err := mgParse.ManagementGroupID(managementGroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	future, err := client.WhatIfAtManagementGroupScope(ctx, id.Name, deploymentName, deployment)
+	if err != nil {
+		if resp := future.Response(); resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[DEBUG] Management Group %q was not found - the changes made by Template Deployment %q can't be predicted", id.Name, deploymentName)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("requesting what-if: %+v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return nil, fmt.Errorf("waiting for what-if: %+v", err)
+	}
+	result, err := future.Result(*client)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving what-if result: %+v", err)
+	}
+
+	return &result, nil
+}
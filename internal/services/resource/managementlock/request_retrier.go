@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managementlock
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+// errRetriedRequestUnsuccessful is returned from the operation performed whilst the Management Locks are removed
+// when the retried request is unsuccessful, so that the Management Locks are recreated - but the response from the
+// retried request is returned, rather than this error
+var errRetriedRequestUnsuccessful = errors.New("the retried request was unsuccessful")
+
+// RequestRetrier returns a RequestRetrier which, when a request is blocked by Management Locks which are all listed
+// in `temporarily_remove_lock_ids`, removes these Management Locks, retries that request and then recreates the
+// Management Locks. Only the request which was blocked is retried, rather than the whole operation, since the
+// requests which preceded it (for example, when creating a Resource) have already been performed.
+//
+// The Client is obtained when a request is retried, since the Client is built using this RequestRetrier.
+func RequestRetrier(removableLockIds []string, client func() *clients.Client) common.RequestRetrier {
+	return func(req *http.Request, resp *http.Response, send func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+		return retryWithLocksRemoved(req, resp, send, removableLockIds, func() locksClient {
+			c := client()
+			if c == nil {
+				return nil
+			}
+			return locksClientFor(c)
+		})
+	}
+}
+
+func retryWithLocksRemoved(req *http.Request, resp *http.Response, send func(*http.Request) (*http.Response, error), removableLockIds []string, clientFunc func() locksClient) (*http.Response, error) {
+	if req == nil || resp == nil || resp.StatusCode != http.StatusConflict || len(removableLockIds) == 0 {
+		return resp, nil
+	}
+
+	// the requests used to remove and recreate the Management Locks mustn't themselves be retried
+	if strings.Contains(strings.ToLower(req.URL.Path), "/providers/microsoft.authorization/locks") {
+		return resp, nil
+	}
+
+	ctx := req.Context()
+	if _, ok := ctx.Deadline(); !ok {
+		log.Printf("[DEBUG] Not retrying %s %s since the request has no deadline", req.Method, req.URL.Path)
+		return resp, nil
+	}
+
+	// the request can only be retried when the body can be sent again
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, nil
+	}
+
+	body, err := readResponseBody(resp)
+	if err != nil {
+		return resp, nil
+	}
+
+	lockedScopes := lockedScopesFromError(errors.New(body))
+	if len(lockedScopes) == 0 {
+		return resp, nil
+	}
+
+	client := clientFunc()
+	if client == nil {
+		return resp, nil
+	}
+
+	locks, err := listLocksAtScopes(ctx, client, lockedScopes)
+	if err != nil {
+		log.Printf("[DEBUG] Not retrying %s %s since retrieving the Management Locks at the scope(s) %s failed: %+v", req.Method, req.URL.Path, strings.Join(lockedScopes, ", "), err)
+		return resp, nil
+	}
+	if !canBeRemoved(locks, removableLockIds) {
+		return resp, nil
+	}
+
+	deletedResourceId := ""
+	if req.Method == http.MethodDelete {
+		deletedResourceId = req.URL.Path
+	}
+
+	log.Printf("[DEBUG] Retrying %s %s with the Management Locks at the scope(s) %s temporarily removed..", req.Method, req.URL.Path, strings.Join(lockedScopes, ", "))
+	var retried *http.Response
+	err = withLocksRemoved(ctx, client, locks, deletedResourceId, func() error {
+		retry := req.Clone(ctx)
+		if req.GetBody != nil {
+			retryBody, err := req.GetBody()
+			if err != nil {
+				return fmt.Errorf("obtaining the request body: %+v", err)
+			}
+			retry.Body = retryBody
+		}
+
+		retriedResp, err := send(retry)
+		if err != nil {
+			return err
+		}
+		retried = retriedResp
+
+		if retried.StatusCode < 200 || retried.StatusCode > 299 {
+			return errRetriedRequestUnsuccessful
+		}
+		return nil
+	})
+
+	if retried == nil {
+		if err != nil {
+			return resp, err
+		}
+		return resp, nil
+	}
+
+	// the response from the retried request is returned, unless recreating the Management Locks failed
+	if err != nil && !errors.Is(err, errRetriedRequestUnsuccessful) {
+		return retried, err
+	}
+	return retried, nil
+}
+
+// readResponseBody reads the body of the response, restoring it so that it can be read again
+func readResponseBody(resp *http.Response) (string, error) {
+	if resp.Body == nil {
+		return "", nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return string(body), nil
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managementlock
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/managementlocks"
+)
+
+func TestRetryWithLocksRemoved(t *testing.T) {
+	useFastReplication(t)
+
+	lock := testManagementLock(testResourceGroupId, "example", managementlocks.LockLevelCanNotDelete)
+	resourceId := testResourceGroupId + "/providers/Microsoft.Storage/storageAccounts/example"
+
+	testCases := []struct {
+		Name                string
+		Method              string
+		Path                string
+		StatusCode          int
+		Removable           []string
+		RetryStatusCode     int
+		ExpectedSends       int
+		ExpectedStatusCode  int
+		ExpectedLockRemoved bool
+	}{
+		{
+			Name:               "successful request",
+			Method:             http.MethodPut,
+			Path:               resourceId,
+			StatusCode:         http.StatusOK,
+			Removable:          []string{lock.id.ID()},
+			ExpectedSends:      0,
+			ExpectedStatusCode: http.StatusOK,
+		},
+		{
+			Name:               "without removable locks",
+			Method:             http.MethodPut,
+			Path:               resourceId,
+			StatusCode:         http.StatusConflict,
+			ExpectedSends:      0,
+			ExpectedStatusCode: http.StatusConflict,
+		},
+		{
+			Name:               "lock which isn't removable",
+			Method:             http.MethodPut,
+			Path:               resourceId,
+			StatusCode:         http.StatusConflict,
+			Removable:          []string{testResourceGroupId + "/providers/Microsoft.Authorization/locks/other"},
+			ExpectedSends:      0,
+			ExpectedStatusCode: http.StatusConflict,
+		},
+		{
+			Name:               "request to a Management Lock",
+			Method:             http.MethodDelete,
+			Path:               lock.id.ID(),
+			StatusCode:         http.StatusConflict,
+			Removable:          []string{lock.id.ID()},
+			ExpectedSends:      0,
+			ExpectedStatusCode: http.StatusConflict,
+		},
+		{
+			Name:               "create with removable locks",
+			Method:             http.MethodPut,
+			Path:               resourceId,
+			StatusCode:         http.StatusConflict,
+			Removable:          []string{lock.id.ID()},
+			RetryStatusCode:    http.StatusCreated,
+			ExpectedSends:      1,
+			ExpectedStatusCode: http.StatusCreated,
+		},
+		{
+			Name:               "retried request is unsuccessful",
+			Method:             http.MethodPut,
+			Path:               resourceId,
+			StatusCode:         http.StatusConflict,
+			Removable:          []string{lock.id.ID()},
+			RetryStatusCode:    http.StatusBadRequest,
+			ExpectedSends:      1,
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			Name:                "delete of the locked scope",
+			Method:              http.MethodDelete,
+			Path:                testResourceGroupId,
+			StatusCode:          http.StatusConflict,
+			Removable:           []string{lock.id.ID()},
+			RetryStatusCode:     http.StatusAccepted,
+			ExpectedSends:       1,
+			ExpectedStatusCode:  http.StatusAccepted,
+			ExpectedLockRemoved: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		locks := newFakeLocksClient(lock)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		body := `{"location":"westeurope"}`
+		req, err := http.NewRequestWithContext(ctx, tc.Method, "https://management.azure.com"+tc.Path, strings.NewReader(body))
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+
+		resp := &http.Response{
+			StatusCode: tc.StatusCode,
+			Body:       io.NopCloser(strings.NewReader(fmt.Sprintf(`{"error":{"code":"ScopeLocked","message":"The scope '%s' cannot perform write operation because following scope(s) are locked: '%s'. Please remove the lock and try again."}}`, tc.Path, testResourceGroupId))),
+		}
+
+		sends := 0
+		send := func(retry *http.Request) (*http.Response, error) {
+			sends++
+			if _, ok := locks.get(lock.id); ok {
+				t.Fatalf("expected %s to be removed whilst retrying the request", lock.id)
+			}
+
+			retryBody, err := io.ReadAll(retry.Body)
+			if err != nil || string(retryBody) != body {
+				t.Fatalf("expected the retried request to have the body %q but got %q (%+v)", body, string(retryBody), err)
+			}
+
+			return &http.Response{
+				StatusCode: tc.RetryStatusCode,
+				Body:       io.NopCloser(bytes.NewReader(nil)),
+			}, nil
+		}
+
+		actual, err := retryWithLocksRemoved(req, resp, send, tc.Removable, func() locksClient {
+			return locks
+		})
+		cancel()
+		if err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		if sends != tc.ExpectedSends {
+			t.Fatalf("expected the request to be sent %d times but got %d", tc.ExpectedSends, sends)
+		}
+
+		if actual.StatusCode != tc.ExpectedStatusCode {
+			t.Fatalf("expected the status code %d but got %d", tc.ExpectedStatusCode, actual.StatusCode)
+		}
+
+		if _, ok := locks.get(lock.id); ok == tc.ExpectedLockRemoved {
+			t.Fatalf("expected %s to exist to be %t", lock.id, !tc.ExpectedLockRemoved)
+		}
+	}
+}
+
+func TestRetryWithLocksRemovedRestoresResponseBody(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, "https://management.azure.com"+testResourceGroupId, nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	body := `{"error":{"code":"Conflict","message":"another operation is in progress"}}`
+	resp := &http.Response{
+		StatusCode: http.StatusConflict,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+
+	actual, err := retryWithLocksRemoved(req, resp, nil, []string{testResourceGroupId + "/providers/Microsoft.Authorization/locks/example"}, func() locksClient {
+		t.Fatalf("expected the Management Locks not to be looked up")
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	actualBody, err := io.ReadAll(actual.Body)
+	if err != nil || string(actualBody) != body {
+		t.Fatalf("expected the response body %q but got %q (%+v)", body, string(actualBody), err)
+	}
+}
@@ -0,0 +1,365 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managementlock
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/managementlocks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// Azure returns a `ScopeLocked` error containing the scope(s) which are locked, for example:
+//
+//	ScopeLocked: The scope '{resourceId}' cannot perform delete operation because following scope(s) are locked:
+//	'{lockedScope}'. Please remove the lock and try again.
+//
+// however the error doesn't include the Management Locks themselves, so these have to be looked up.
+var lockedScopesRegex = regexp.MustCompile(`following scope\(s\) are locked: ([^\n]+?)\. Please`)
+
+var quotedScopeRegex = regexp.MustCompile(`'([^']+)'`)
+
+// locksClient is the subset of the Management Locks client used to look up and temporarily remove Management Locks
+type locksClient interface {
+	CreateOrUpdateByScope(ctx context.Context, id managementlocks.ScopedLockId, input managementlocks.ManagementLockObject) (managementlocks.CreateOrUpdateByScopeOperationResponse, error)
+	DeleteByScope(ctx context.Context, id managementlocks.ScopedLockId) (managementlocks.DeleteByScopeOperationResponse, error)
+	GetByScope(ctx context.Context, id managementlocks.ScopedLockId) (managementlocks.GetByScopeOperationResponse, error)
+	ListByScopeComplete(ctx context.Context, id commonids.ScopeId, options managementlocks.ListByScopeOperationOptions) (managementlocks.ListByScopeCompleteResult, error)
+}
+
+var _ locksClient = &managementlocks.ManagementLocksClient{}
+
+var (
+	// these match the replication checks performed by the `azurerm_management_lock` resource
+	lockReplicationMinTimeout = 10 * time.Second
+	lockReplicationChecks     = 12
+
+	// lockRecreationTimeout bounds recreating the Management Locks, which is independent of the operation's timeout
+	lockRecreationTimeout = 30 * time.Minute
+)
+
+type managementLock struct {
+	id         managementlocks.ScopedLockId
+	properties managementlocks.ManagementLockProperties
+}
+
+// lockedScopesFromError returns the scopes which are locked when the error is a `ScopeLocked` error
+func lockedScopesFromError(err error) []string {
+	if err == nil || !strings.Contains(err.Error(), "ScopeLocked") {
+		return nil
+	}
+
+	match := lockedScopesRegex.FindStringSubmatch(err.Error())
+	if len(match) != 2 {
+		return nil
+	}
+
+	scopes := make([]string, 0)
+	seen := make(map[string]struct{})
+	for _, quoted := range quotedScopeRegex.FindAllStringSubmatch(match[1], -1) {
+		scope := strings.TrimSuffix(quoted[1], "/")
+		if _, ok := seen[strings.ToLower(scope)]; ok {
+			continue
+		}
+		seen[strings.ToLower(scope)] = struct{}{}
+		scopes = append(scopes, scope)
+	}
+
+	return scopes
+}
+
+// scopeContains returns whether the child scope is either the same as, or nested within, the parent scope
+func scopeContains(parent, child string) bool {
+	parent = strings.ToLower(strings.TrimSuffix(parent, "/"))
+	child = strings.ToLower(strings.TrimSuffix(child, "/"))
+
+	return parent == child || strings.HasPrefix(child, parent+"/")
+}
+
+// listLocksAtScopes returns the Management Locks defined at (rather than inherited by) each of the scopes
+func listLocksAtScopes(ctx context.Context, client locksClient, scopes []string) ([]managementLock, error) {
+	locks := make([]managementLock, 0)
+	for _, scope := range scopes {
+		items, err := listLocks(ctx, client, scope)
+		if err != nil {
+			return nil, err
+		}
+
+		// `atScope()` also returns the locks inherited from the parent scopes, which aren't what's locked here
+		for _, lock := range items {
+			if strings.EqualFold(lock.id.Scope, scope) {
+				locks = append(locks, lock)
+			}
+		}
+	}
+
+	return locks, nil
+}
+
+// listLocksApplyingTo returns the Management Locks which apply to the Resource, either directly or inherited from
+// a parent scope
+func listLocksApplyingTo(ctx context.Context, client locksClient, resourceId string) ([]managementLock, error) {
+	items, err := listLocks(ctx, client, resourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	locks := make([]managementLock, 0)
+	for _, lock := range items {
+		if scopeContains(lock.id.Scope, resourceId) {
+			locks = append(locks, lock)
+		}
+	}
+
+	return locks, nil
+}
+
+func listLocks(ctx context.Context, client locksClient, scope string) ([]managementLock, error) {
+	scopeId := commonids.NewScopeID(scope)
+	resp, err := client.ListByScopeComplete(ctx, scopeId, managementlocks.ListByScopeOperationOptions{
+		Filter: pointer.To("atScope()"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing the Management Locks for %s: %+v", scopeId, err)
+	}
+
+	locks := make([]managementLock, 0)
+	for _, item := range resp.Items {
+		id, err := managementlocks.ParseScopedLockIDInsensitively(pointer.From(item.Id))
+		if err != nil {
+			return nil, err
+		}
+
+		locks = append(locks, managementLock{
+			id:         *id,
+			properties: item.Properties,
+		})
+	}
+
+	return locks, nil
+}
+
+// canBeRemoved returns whether each of the locks is listed within `temporarily_remove_lock_ids`
+func canBeRemoved(locks []managementLock, removableLockIds []string) bool {
+	if len(locks) == 0 {
+		return false
+	}
+
+	for _, lock := range locks {
+		if !isRemovable(lock, removableLockIds) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isRemovable(lock managementLock, removableLockIds []string) bool {
+	for _, v := range removableLockIds {
+		id, err := managementlocks.ParseScopedLockIDInsensitively(v)
+		if err != nil {
+			continue
+		}
+		if strings.EqualFold(id.ID(), lock.id.ID()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func formatLocks(locks []managementLock) string {
+	formatted := make([]string, 0)
+	for _, lock := range locks {
+		line := fmt.Sprintf("* `%s` (%s) at the scope `%s`", lock.id.LockName, lock.properties.Level, lock.id.Scope)
+		if notes := pointer.From(lock.properties.Notes); notes != "" {
+			line += fmt.Sprintf(" - notes: %q", notes)
+		}
+		formatted = append(formatted, line)
+	}
+	sort.Strings(formatted)
+
+	return strings.Join(formatted, "\n")
+}
+
+// scopeLockedError returns the error from the operation along with the Management Locks which blocked it, and when
+// these could be temporarily removed, how to configure this
+func scopeLockedError(err error, lockedScopes []string, locks []managementLock, removableLockIds []string) error {
+	if len(locks) == 0 {
+		return fmt.Errorf(`%+v
+
+This operation was blocked by a Management Lock at the scope(s) %s - however the Management Locks defined at these
+scope(s) couldn't be found.`, err, strings.Join(lockedScopes, ", "))
+	}
+
+	if canBeRemoved(locks, removableLockIds) {
+		return fmt.Errorf(`%+v
+
+This operation was blocked by the following Management Locks:
+
+%s
+
+These Management Locks are listed in `+"`temporarily_remove_lock_ids`"+`, however the request which was blocked
+couldn't be retried with these Management Locks removed, so these must be removed before this operation can be
+performed.`, err, formatLocks(locks))
+	}
+
+	// the template uses single quotes since it's a raw string, which are replaced with backticks
+	template := `%+v
+
+This operation was blocked by the following Management Locks:
+
+%s
+
+These Management Locks must be removed before this operation can be performed. Alternatively, Management Locks which
+are managed by this Terraform configuration can be removed for the duration of the operation, and then recreated, by
+specifying their IDs in 'temporarily_remove_lock_ids' within the 'management_lock' block of the 'features' block
+when configuring the Provider, for example:
+
+provider "azurerm" {
+  features {
+    management_lock {
+      temporarily_remove_lock_ids = [
+%s
+      ]
+    }
+  }
+}
+
+More information on the 'features' block can be found in the documentation:
+https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs/guides/features-block
+`
+	lockIds := make([]string, 0)
+	for _, lock := range locks {
+		lockIds = append(lockIds, fmt.Sprintf("        %q,", lock.id.ID()))
+	}
+	sort.Strings(lockIds)
+
+	return fmt.Errorf(strings.ReplaceAll(template, "'", "`"), err, formatLocks(locks), strings.Join(lockIds, "\n"))
+}
+
+// withLocksRemoved removes the Management Locks, performs the operation and then recreates the Management Locks,
+// excluding any which were defined on the Resource deleted by the operation, since these no longer exist
+func withLocksRemoved(ctx context.Context, client locksClient, locks []managementLock, deletedResourceId string, operation func() error) error {
+	removed := make([]managementLock, 0)
+	for _, lock := range locks {
+		log.Printf("[DEBUG] Temporarily removing %s..", lock.id)
+		if err := deleteLock(ctx, client, lock.id); err != nil {
+			err = fmt.Errorf("temporarily removing %s: %+v", lock.id, err)
+			if recreateErr := recreateLocksDetached(ctx, client, removed); recreateErr != nil {
+				return fmt.Errorf("%+v\n\nadditionally, recreating the Management Locks which were removed: %+v", err, recreateErr)
+			}
+			return err
+		}
+		removed = append(removed, lock)
+	}
+
+	operationErr := operation()
+
+	recreate := make([]managementLock, 0)
+	for _, lock := range removed {
+		if operationErr == nil && deletedResourceId != "" && scopeContains(deletedResourceId, lock.id.Scope) {
+			log.Printf("[DEBUG] Skipping recreating %s since the scope was deleted", lock.id)
+			continue
+		}
+		recreate = append(recreate, lock)
+	}
+
+	if err := recreateLocksDetached(ctx, client, recreate); err != nil {
+		if operationErr != nil {
+			return fmt.Errorf("%+v\n\nadditionally, recreating the Management Locks which were temporarily removed: %+v", operationErr, err)
+		}
+		return fmt.Errorf("recreating the Management Locks which were temporarily removed: %+v", err)
+	}
+
+	return operationErr
+}
+
+func deleteLock(ctx context.Context, client locksClient, id managementlocks.ScopedLockId) error {
+	if resp, err := client.DeleteByScope(ctx, id); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+		return err
+	}
+
+	return waitForLockReplication(ctx, client, id, "NotFound")
+}
+
+// recreateLocksDetached recreates the Management Locks using a context which isn't cancelled alongside the operation,
+// so that the Management Locks are recreated even when the operation has used up its timeout or Terraform is cancelled
+func recreateLocksDetached(ctx context.Context, client locksClient, locks []managementLock) error {
+	if len(locks) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), lockRecreationTimeout)
+	defer cancel()
+
+	return recreateLocks(ctx, client, locks)
+}
+
+func recreateLocks(ctx context.Context, client locksClient, locks []managementLock) error {
+	for _, lock := range locks {
+		log.Printf("[DEBUG] Recreating %s..", lock.id)
+		payload := managementlocks.ManagementLockObject{
+			Properties: managementlocks.ManagementLockProperties{
+				Level:  lock.properties.Level,
+				Notes:  lock.properties.Notes,
+				Owners: lock.properties.Owners,
+			},
+		}
+		if _, err := client.CreateOrUpdateByScope(ctx, lock.id, payload); err != nil {
+			return fmt.Errorf("recreating %s: %+v", lock.id, err)
+		}
+
+		if err := waitForLockReplication(ctx, client, lock.id, "OK"); err != nil {
+			return fmt.Errorf("recreating %s: %+v", lock.id, err)
+		}
+	}
+
+	return nil
+}
+
+// waitForLockReplication waits for changes to the Management Lock to replicate, in the same manner as the
+// `azurerm_management_lock` resource
+func waitForLockReplication(ctx context.Context, client locksClient, id managementlocks.ScopedLockId, target string) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context was missing a deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Target: []string{
+			target,
+		},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.GetByScope(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return resp, "NotFound", nil
+				}
+				return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			return resp, "OK", nil
+		},
+		MinTimeout:                lockReplicationMinTimeout,
+		ContinuousTargetOccurence: lockReplicationChecks,
+		Timeout:                   time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for replication of %s: %+v", id, err)
+	}
+
+	return nil
+}
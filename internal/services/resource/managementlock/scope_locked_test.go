@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managementlock
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/managementlocks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestLockedScopesFromError(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    error
+		Expected []string
+	}{
+		{
+			Name:     "no error",
+			Input:    nil,
+			Expected: nil,
+		},
+		{
+			Name:     "unrelated error",
+			Input:    errors.New("unexpected status 404 (404 Not Found) with error: ResourceGroupNotFound: Resource group 'example' could not be found."),
+			Expected: nil,
+		},
+		{
+			Name:     "single scope",
+			Input:    errors.New("deleting Storage Account (Subscription: \"00000000-0000-0000-0000-000000000000\"\nResource Group Name: \"example\"\nStorage Account Name: \"example\"): unexpected status 409 (409 Conflict) with error: ScopeLocked: The scope '/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example' cannot perform delete operation because following scope(s) are locked: '/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example'. Please remove the lock and try again."),
+			Expected: []string{"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example"},
+		},
+		{
+			Name:  "multiple scopes",
+			Input: errors.New("Code=\"ScopeLocked\" Message=\"The scope '/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example' cannot perform write operation because following scope(s) are locked: '/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example','/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/'. Please remove the lock and try again.\""),
+			Expected: []string{
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example",
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			},
+		},
+		{
+			Name:     "unrecognised message",
+			Input:    errors.New("ScopeLocked: The scope is locked."),
+			Expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		actual := lockedScopesFromError(tc.Input)
+		if len(actual) == 0 && len(tc.Expected) == 0 {
+			continue
+		}
+
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
+
+func TestScopeContains(t *testing.T) {
+	testCases := []struct {
+		Parent   string
+		Child    string
+		Expected bool
+	}{
+		{
+			Parent:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			Child:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			Expected: true,
+		},
+		{
+			Parent:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/Example",
+			Child:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example",
+			Expected: true,
+		},
+		{
+			Parent:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			Child:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example2",
+			Expected: false,
+		},
+		{
+			Parent:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example",
+			Child:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			Expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		if actual := scopeContains(tc.Parent, tc.Child); actual != tc.Expected {
+			t.Fatalf("expected scopeContains(%q, %q) to be %t but got %t", tc.Parent, tc.Child, tc.Expected, actual)
+		}
+	}
+}
+
+func TestLocksBlockingChanges(t *testing.T) {
+	readOnly := testManagementLock("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example", "read-only", managementlocks.LockLevelReadOnly)
+	canNotDelete := testManagementLock("/subscriptions/00000000-0000-0000-0000-000000000000", "can-not-delete", managementlocks.LockLevelCanNotDelete)
+	locks := []managementLock{readOnly, canNotDelete}
+
+	testCases := []struct {
+		Name      string
+		Replacing bool
+		Removable []string
+		Expected  []string
+	}{
+		{
+			Name:     "update",
+			Expected: []string{"read-only"},
+		},
+		{
+			Name:      "replacement",
+			Replacing: true,
+			Expected:  []string{"read-only", "can-not-delete"},
+		},
+		{
+			Name:      "replacement with removable locks",
+			Replacing: true,
+			Removable: []string{
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/example/providers/Microsoft.Authorization/locks/read-only",
+			},
+			Expected: []string{"can-not-delete"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		actual := make([]string, 0)
+		for _, lock := range locksBlockingChanges(locks, tc.Replacing, tc.Removable) {
+			actual = append(actual, lock.id.LockName)
+		}
+
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
+
+func TestCanBeRemoved(t *testing.T) {
+	first := testManagementLock("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example", "first", managementlocks.LockLevelCanNotDelete)
+	second := testManagementLock("/subscriptions/00000000-0000-0000-0000-000000000000", "second", managementlocks.LockLevelReadOnly)
+	removable := []string{first.id.ID()}
+
+	if canBeRemoved(nil, removable) {
+		t.Fatalf("expected no locks not to be removable")
+	}
+
+	if !canBeRemoved([]managementLock{first}, removable) {
+		t.Fatalf("expected %s to be removable", first.id)
+	}
+
+	if canBeRemoved([]managementLock{first, second}, removable) {
+		t.Fatalf("expected the locks not to be removable since %s isn't listed", second.id)
+	}
+}
+
+func TestRequiresReplacement(t *testing.T) {
+	resourceSchema := map[string]*pluginsdk.Schema{
+		"location": {
+			Type:     pluginsdk.TypeString,
+			ForceNew: true,
+		},
+		"tags": {
+			Type: pluginsdk.TypeMap,
+		},
+	}
+
+	if requiresReplacement(resourceSchema, []string{"tags.%", "tags.environment"}) {
+		t.Fatalf("expected changes to `tags` not to require replacement")
+	}
+
+	if !requiresReplacement(resourceSchema, []string{"tags.%", "location"}) {
+		t.Fatalf("expected changes to `location` to require replacement")
+	}
+}
+
+func TestIsLockableResourceId(t *testing.T) {
+	testCases := map[string]bool{
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example":                                                   true,
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Authorization/locks/example":   false,
+		"/providers/Microsoft.Management/managementGroups/example":                                                                     false,
+		"https://example.blob.core.windows.net/container/blob":                                                                         false,
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/store": true,
+	}
+
+	for input, expected := range testCases {
+		if actual := isLockableResourceId(input); actual != expected {
+			t.Fatalf("expected isLockableResourceId(%q) to be %t but got %t", input, expected, actual)
+		}
+	}
+}
+
+func TestScopeLockedError(t *testing.T) {
+	lock := testManagementLock("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example", "example", managementlocks.LockLevelCanNotDelete)
+	err := scopeLockedError(errors.New("deleting example"), []string{lock.id.Scope}, []managementLock{lock}, nil)
+
+	expected := "* `example` (CanNotDelete) at the scope `/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example` - notes: \"managed by terraform\""
+	if !strings.HasPrefix(err.Error(), "deleting example\n\n") || !strings.Contains(err.Error(), expected) {
+		t.Fatalf("expected the error to contain %q but got %q", expected, err.Error())
+	}
+
+	if !strings.Contains(err.Error(), fmt.Sprintf("%q,", lock.id.ID())) {
+		t.Fatalf("expected the error to contain the lock ID %q but got %q", lock.id.ID(), err.Error())
+	}
+}
+
+func testManagementLock(scope, name string, level managementlocks.LockLevel) managementLock {
+	notes := "managed by terraform"
+	return managementLock{
+		id: managementlocks.NewScopedLockID(scope, name),
+		properties: managementlocks.ManagementLockProperties{
+			Level: level,
+			Notes: &notes,
+		},
+	}
+}
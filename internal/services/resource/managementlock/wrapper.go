@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managementlock
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/managementlocks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// WrapResource wraps the Create, Update and Delete functions of the Resource so that operations which are blocked
+// by a Management Lock report the Management Locks responsible, and (when `detect_during_plan` is set) detects the
+// Management Locks which would block changes to an existing Resource during the plan. This is opt-in, and only
+// takes effect when `enabled` is set within the `management_lock` features block.
+//
+// This applies to all Resources built on the Plugin SDK (including Typed Resources), since any Resource can be
+// blocked by a Management Lock on a parent scope. Management Locks listed in `temporarily_remove_lock_ids` are
+// instead removed whilst retrying the request which they blocked, see RequestRetrier.
+func WrapResource(resource *pluginsdk.Resource) {
+	if resource.Create != nil { //nolint:staticcheck
+		resource.Create = wrapFunc(operationCreate, resource.Create) //nolint:staticcheck
+	}
+	if resource.CreateContext != nil {
+		resource.CreateContext = wrapContextFunc(resource.CreateContext)
+	}
+	if resource.CreateWithoutTimeout != nil {
+		resource.CreateWithoutTimeout = wrapContextFunc(resource.CreateWithoutTimeout)
+	}
+
+	if resource.Update != nil { //nolint:staticcheck
+		resource.Update = wrapFunc(operationUpdate, resource.Update) //nolint:staticcheck
+	}
+	if resource.UpdateContext != nil {
+		resource.UpdateContext = wrapContextFunc(resource.UpdateContext)
+	}
+	if resource.UpdateWithoutTimeout != nil {
+		resource.UpdateWithoutTimeout = wrapContextFunc(resource.UpdateWithoutTimeout)
+	}
+
+	if resource.Delete != nil { //nolint:staticcheck
+		resource.Delete = wrapFunc(operationDelete, resource.Delete) //nolint:staticcheck
+	}
+	if resource.DeleteContext != nil {
+		resource.DeleteContext = wrapContextFunc(resource.DeleteContext)
+	}
+	if resource.DeleteWithoutTimeout != nil {
+		resource.DeleteWithoutTimeout = wrapContextFunc(resource.DeleteWithoutTimeout)
+	}
+
+	if resource.CustomizeDiff != nil {
+		resource.CustomizeDiff = pluginsdk.CustomDiffInSequence(resource.CustomizeDiff, detectLocksDuringPlan(resource))
+	} else {
+		resource.CustomizeDiff = detectLocksDuringPlan(resource)
+	}
+}
+
+type operation string
+
+const (
+	operationCreate operation = pluginsdk.TimeoutCreate
+	operationUpdate operation = pluginsdk.TimeoutUpdate
+	operationDelete operation = pluginsdk.TimeoutDelete
+)
+
+// locksClientFor returns the client used to look up and temporarily remove the Management Locks
+var locksClientFor = func(client *clients.Client) locksClient {
+	return client.Resource.LocksClient
+}
+
+func enabled(meta interface{}) (*clients.Client, bool) {
+	client, ok := meta.(*clients.Client)
+	if !ok || client == nil || !client.Features.ManagementLock.Enabled {
+		return nil, false
+	}
+
+	return client, true
+}
+
+func wrapFunc(op operation, f func(d *pluginsdk.ResourceData, meta interface{}) error) func(d *pluginsdk.ResourceData, meta interface{}) error {
+	return func(d *pluginsdk.ResourceData, meta interface{}) error {
+		client, ok := enabled(meta)
+		if !ok || client.StopContext == nil {
+			return f(d, meta)
+		}
+
+		err := f(d, meta)
+		if err == nil {
+			return nil
+		}
+
+		ctx, cancel := context.WithTimeout(client.StopContext, d.Timeout(string(op)))
+		defer cancel()
+
+		return handleScopeLocked(ctx, client, err)
+	}
+}
+
+func wrapContextFunc(f func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics) func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+		client, ok := enabled(meta)
+		if !ok {
+			return f(ctx, d, meta)
+		}
+
+		diags := f(ctx, d, meta)
+		if !diags.HasError() {
+			return diags
+		}
+
+		err := diagnosticsError{diags: diags}
+		if len(lockedScopesFromError(err)) == 0 {
+			return diags
+		}
+
+		// the error diagnostics are replaced with the error detailing the Management Locks, retaining any warnings
+		output := make(diag.Diagnostics, 0)
+		for _, v := range diags {
+			if v.Severity != diag.Error {
+				output = append(output, v)
+			}
+		}
+		return append(output, diag.FromErr(handleScopeLocked(ctx, client, err))...)
+	}
+}
+
+// diagnosticsError allows the diagnostics returned from the context-aware functions to be handled as an error
+type diagnosticsError struct {
+	diags diag.Diagnostics
+}
+
+func (e diagnosticsError) Error() string {
+	messages := make([]string, 0)
+	for _, d := range e.diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+		messages = append(messages, d.Summary)
+		if d.Detail != "" && d.Detail != d.Summary {
+			messages = append(messages, d.Detail)
+		}
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+// handleScopeLocked returns the error from the operation, and when this was blocked by a Management Lock, the
+// Management Locks which blocked it
+func handleScopeLocked(ctx context.Context, client *clients.Client, err error) error {
+	lockedScopes := lockedScopesFromError(err)
+	if len(lockedScopes) == 0 {
+		return err
+	}
+
+	log.Printf("[DEBUG] Operation was blocked by a Management Lock at the scope(s) %s - retrieving the Management Locks..", strings.Join(lockedScopes, ", "))
+	locks, listErr := listLocksAtScopes(ctx, locksClientFor(client), lockedScopes)
+	if listErr != nil {
+		return fmt.Errorf("%+v\n\nthis operation was blocked by a Management Lock at the scope(s) %s - however retrieving the Management Locks failed: %+v", err, strings.Join(lockedScopes, ", "), listErr)
+	}
+
+	return scopeLockedError(err, lockedScopes, locks, client.Features.ManagementLock.TemporarilyRemoveLockIds)
+}
+
+// detectLocksDuringPlan returns a CustomizeDiffFunc which, when enabled in the `management_lock` features block,
+// raises an error when a Management Lock would block the changes to an existing Resource
+func detectLocksDuringPlan(resource *pluginsdk.Resource) pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+		client, ok := enabled(meta)
+		if !ok || !client.Features.ManagementLock.DetectDuringPlan {
+			return nil
+		}
+
+		// Management Locks can only be detected for existing Resources identified by a Resource Manager ID
+		if d.Id() == "" || !isLockableResourceId(d.Id()) {
+			return nil
+		}
+
+		changedKeys := d.GetChangedKeysPrefix("")
+		if len(changedKeys) == 0 {
+			return nil
+		}
+
+		// the Schema is resolved here rather than when wrapping the Resource, since `SchemaFunc` is evaluated lazily
+		replacing := requiresReplacement(resource.SchemaMap(), changedKeys)
+
+		locks, err := listLocksApplyingTo(ctx, locksClientFor(client), d.Id())
+		if err != nil {
+			return fmt.Errorf("detecting the Management Locks which apply to %q: %+v", d.Id(), err)
+		}
+
+		blocking := locksBlockingChanges(locks, replacing, client.Features.ManagementLock.TemporarilyRemoveLockIds)
+		if len(blocking) == 0 {
+			return nil
+		}
+
+		operation := "updated"
+		if replacing {
+			operation = "replaced"
+		}
+
+		return fmt.Errorf("%q can't be %s since it's locked by the following Management Locks:\n\n%s\n\nthese must be removed, or listed in `temporarily_remove_lock_ids` within the `management_lock` block of the Provider `features` block, before this change can be applied", d.Id(), operation, formatLocks(blocking))
+	}
+}
+
+// isLockableResourceId returns whether the ID is a Resource Manager ID which can be locked - excluding the
+// Management Locks themselves
+func isLockableResourceId(id string) bool {
+	if !strings.HasPrefix(strings.ToLower(id), "/subscriptions/") {
+		return false
+	}
+
+	_, err := managementlocks.ParseScopedLockIDInsensitively(id)
+	return err != nil
+}
+
+// requiresReplacement returns whether any of the changed top-level fields are ForceNew - changes to nested fields
+// which are ForceNew aren't detected, since these can't be addressed within Sets
+func requiresReplacement(resourceSchema map[string]*pluginsdk.Schema, changedKeys []string) bool {
+	for _, key := range changedKeys {
+		name := strings.SplitN(key, ".", 2)[0]
+		if v, ok := resourceSchema[name]; ok && v.ForceNew {
+			return true
+		}
+	}
+
+	return false
+}
+
+// locksBlockingChanges returns the Management Locks which would block the Resource being updated or replaced,
+// excluding the Management Locks which can be temporarily removed
+func locksBlockingChanges(locks []managementLock, replacing bool, removableLockIds []string) []managementLock {
+	blocking := make([]managementLock, 0)
+	for _, lock := range locks {
+		if isRemovable(lock, removableLockIds) {
+			continue
+		}
+
+		switch lock.properties.Level {
+		case managementlocks.LockLevelReadOnly:
+			blocking = append(blocking, lock)
+		case managementlocks.LockLevelCanNotDelete:
+			if replacing {
+				blocking = append(blocking, lock)
+			}
+		}
+	}
+
+	return blocking
+}
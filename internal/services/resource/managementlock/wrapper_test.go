@@ -0,0 +1,377 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package managementlock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/managementlocks"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+const testResourceGroupId = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example"
+
+func TestWithLocksRemovedRecreatesLocksWhenOperationTimesOut(t *testing.T) {
+	useFastReplication(t)
+
+	lock := testManagementLock(testResourceGroupId, "example", managementlocks.LockLevelCanNotDelete)
+	client := newFakeLocksClient(lock)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	err := withLocksRemoved(ctx, client, []managementLock{lock}, "", func() error {
+		if _, ok := client.get(lock.id); ok {
+			t.Fatalf("expected %s to be removed during the operation", lock.id)
+		}
+
+		// the operation uses up the timeout
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the operation's error to be returned but got %+v", err)
+	}
+
+	recreated, ok := client.get(lock.id)
+	if !ok {
+		t.Fatalf("expected %s to be recreated after the operation timed out", lock.id)
+	}
+	if recreated.Level != lock.properties.Level || pointer.From(recreated.Notes) != pointer.From(lock.properties.Notes) {
+		t.Fatalf("expected %s to be recreated with the level %q and notes %q but got %+v", lock.id, lock.properties.Level, pointer.From(lock.properties.Notes), recreated)
+	}
+}
+
+func TestWrapResourceReportsLocks(t *testing.T) {
+	lock := testManagementLock(testResourceGroupId, "example", managementlocks.LockLevelCanNotDelete)
+	scopeLocked := fmt.Errorf("unexpected status 409 (409 Conflict) with error: ScopeLocked: The scope '%s' cannot perform delete operation because following scope(s) are locked: '%s'. Please remove the lock and try again.", testResourceGroupId, testResourceGroupId)
+
+	testCases := []struct {
+		Name          string
+		Enabled       bool
+		Removable     []string
+		Error         error
+		ExpectedError string
+		ExpectedLists int
+	}{
+		{
+			Name:          "disabled",
+			Error:         scopeLocked,
+			ExpectedError: scopeLocked.Error(),
+			ExpectedLists: 0,
+		},
+		{
+			Name:          "enabled with another error",
+			Enabled:       true,
+			Error:         errors.New("some other error"),
+			ExpectedError: "some other error",
+			ExpectedLists: 0,
+		},
+		{
+			Name:          "enabled without removable locks",
+			Enabled:       true,
+			Error:         scopeLocked,
+			ExpectedError: "temporarily_remove_lock_ids = [",
+			ExpectedLists: 1,
+		},
+		{
+			Name:          "enabled with removable locks",
+			Enabled:       true,
+			Removable:     []string{lock.id.ID()},
+			Error:         scopeLocked,
+			ExpectedError: "couldn't be retried",
+			ExpectedLists: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		locks := newFakeLocksClient(lock)
+		useLocksClient(t, locks)
+
+		attempts := 0
+		resource := &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{},
+			Delete: func(d *pluginsdk.ResourceData, meta interface{}) error {
+				attempts++
+				return tc.Error
+			},
+		}
+		WrapResource(resource)
+
+		client := &clients.Client{
+			Features: features.UserFeatures{
+				ManagementLock: features.ManagementLockFeatures{
+					Enabled:                  tc.Enabled,
+					TemporarilyRemoveLockIds: tc.Removable,
+				},
+			},
+			StopContext: context.Background(),
+		}
+
+		err := resource.Delete(resource.TestResourceData(), client) //nolint:staticcheck
+		if err == nil || !strings.Contains(err.Error(), tc.ExpectedError) {
+			t.Fatalf("expected an error containing %q but got %+v", tc.ExpectedError, err)
+		}
+
+		// the request blocked by the Management Locks is retried by the RequestRetrier, rather than the whole function
+		if attempts != 1 {
+			t.Fatalf("expected the Delete function to be called once but got %d", attempts)
+		}
+
+		if locks.lists != tc.ExpectedLists {
+			t.Fatalf("expected the Management Locks to be listed %d times but got %d", tc.ExpectedLists, locks.lists)
+		}
+
+		if _, ok := locks.get(lock.id); !ok {
+			t.Fatalf("expected %s to still exist", lock.id)
+		}
+	}
+}
+
+func TestWrapResourceCustomizeDiff(t *testing.T) {
+	lock := testManagementLock(testResourceGroupId, "example", managementlocks.LockLevelReadOnly)
+
+	testCases := []struct {
+		Name          string
+		Disabled      bool
+		SchemaFunc    bool
+		CustomizeDiff pluginsdk.CustomizeDiffFunc
+		ExpectedError string
+		ExpectedLists int
+	}{
+		{
+			Name:          "disabled",
+			Disabled:      true,
+			ExpectedLists: 0,
+		},
+		{
+			Name:          "no existing CustomizeDiff",
+			ExpectedError: "can't be updated",
+			ExpectedLists: 1,
+		},
+		{
+			Name:          "ForceNew field defined using a SchemaFunc",
+			SchemaFunc:    true,
+			ExpectedError: "can't be replaced",
+			ExpectedLists: 1,
+		},
+		{
+			Name: "existing CustomizeDiff runs first",
+			CustomizeDiff: func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+				return nil
+			},
+			ExpectedError: "can't be updated",
+			ExpectedLists: 1,
+		},
+		{
+			Name: "existing CustomizeDiff error is returned",
+			CustomizeDiff: func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+				return errors.New("existing validation failed")
+			},
+			ExpectedError: "existing validation failed",
+			ExpectedLists: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		locks := newFakeLocksClient(lock)
+		useLocksClient(t, locks)
+
+		called := false
+		resource := &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"value": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+				},
+			},
+		}
+		if tc.SchemaFunc {
+			resource.Schema = nil
+			resource.SchemaFunc = func() map[string]*pluginsdk.Schema {
+				return map[string]*pluginsdk.Schema{
+					"value": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ForceNew: true,
+					},
+				}
+			}
+		}
+		if tc.CustomizeDiff != nil {
+			existing := tc.CustomizeDiff
+			resource.CustomizeDiff = func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+				called = true
+				return existing(ctx, d, meta)
+			}
+		}
+
+		WrapResource(resource)
+
+		client := &clients.Client{
+			Features: features.UserFeatures{
+				ManagementLock: features.ManagementLockFeatures{
+					Enabled:          !tc.Disabled,
+					DetectDuringPlan: true,
+				},
+			},
+		}
+		state := &terraform.InstanceState{
+			ID: testResourceGroupId,
+			Attributes: map[string]string{
+				"id":    testResourceGroupId,
+				"value": "old",
+			},
+		}
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"value": "new",
+		})
+
+		_, err := resource.Diff(context.Background(), state, config, client)
+		if tc.ExpectedError == "" && err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if tc.ExpectedError != "" && (err == nil || !strings.Contains(err.Error(), tc.ExpectedError)) {
+			t.Fatalf("expected an error containing %q but got %+v", tc.ExpectedError, err)
+		}
+
+		if tc.CustomizeDiff != nil && !called {
+			t.Fatalf("expected the existing CustomizeDiff to be called")
+		}
+
+		if locks.lists != tc.ExpectedLists {
+			t.Fatalf("expected the Management Locks to be listed %d times but got %d", tc.ExpectedLists, locks.lists)
+		}
+	}
+}
+
+func useFastReplication(t *testing.T) {
+	minTimeout, checks := lockReplicationMinTimeout, lockReplicationChecks
+	lockReplicationMinTimeout, lockReplicationChecks = time.Millisecond, 1
+	t.Cleanup(func() {
+		lockReplicationMinTimeout, lockReplicationChecks = minTimeout, checks
+	})
+}
+
+func useLocksClient(t *testing.T, client locksClient) {
+	existing := locksClientFor
+	locksClientFor = func(*clients.Client) locksClient {
+		return client
+	}
+	t.Cleanup(func() {
+		locksClientFor = existing
+	})
+}
+
+// fakeLocksClient stores the Management Locks in memory, failing any request made with a cancelled context
+type fakeLocksClient struct {
+	mu    sync.Mutex
+	locks map[string]managementlocks.ManagementLockProperties
+	lists int
+}
+
+var _ locksClient = &fakeLocksClient{}
+
+func newFakeLocksClient(locks ...managementLock) *fakeLocksClient {
+	client := &fakeLocksClient{
+		locks: make(map[string]managementlocks.ManagementLockProperties),
+	}
+	for _, lock := range locks {
+		client.locks[strings.ToLower(lock.id.ID())] = lock.properties
+	}
+
+	return client
+}
+
+func (c *fakeLocksClient) get(id managementlocks.ScopedLockId) (managementlocks.ManagementLockProperties, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	props, ok := c.locks[strings.ToLower(id.ID())]
+	return props, ok
+}
+
+func (c *fakeLocksClient) CreateOrUpdateByScope(ctx context.Context, id managementlocks.ScopedLockId, input managementlocks.ManagementLockObject) (result managementlocks.CreateOrUpdateByScopeOperationResponse, err error) {
+	if err := ctx.Err(); err != nil {
+		return result, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.locks[strings.ToLower(id.ID())] = input.Properties
+
+	return result, nil
+}
+
+func (c *fakeLocksClient) DeleteByScope(ctx context.Context, id managementlocks.ScopedLockId) (result managementlocks.DeleteByScopeOperationResponse, err error) {
+	if err := ctx.Err(); err != nil {
+		return result, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.locks, strings.ToLower(id.ID()))
+
+	return result, nil
+}
+
+func (c *fakeLocksClient) GetByScope(ctx context.Context, id managementlocks.ScopedLockId) (result managementlocks.GetByScopeOperationResponse, err error) {
+	if err := ctx.Err(); err != nil {
+		return result, err
+	}
+
+	props, ok := c.get(id)
+	if !ok {
+		result.HttpResponse = &http.Response{StatusCode: http.StatusNotFound}
+		return result, fmt.Errorf("%s was not found", id)
+	}
+
+	result.HttpResponse = &http.Response{StatusCode: http.StatusOK}
+	result.Model = &managementlocks.ManagementLockObject{
+		Id:         pointer.To(id.ID()),
+		Properties: props,
+	}
+	return result, nil
+}
+
+func (c *fakeLocksClient) ListByScopeComplete(ctx context.Context, id commonids.ScopeId, _ managementlocks.ListByScopeOperationOptions) (result managementlocks.ListByScopeCompleteResult, err error) {
+	if err := ctx.Err(); err != nil {
+		return result, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lists++
+
+	for lockId, props := range c.locks {
+		parsed, err := managementlocks.ParseScopedLockIDInsensitively(lockId)
+		if err != nil {
+			return result, err
+		}
+		if scopeContains(parsed.Scope, id.Scope) {
+			result.Items = append(result.Items, managementlocks.ManagementLockObject{
+				Id:         pointer.To(lockId),
+				Properties: props,
+			})
+		}
+	}
+
+	return result, nil
+}
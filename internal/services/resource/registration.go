@@ -69,5 +69,8 @@ func (r Registration) Resources() []sdk.Resource {
 		ResourceManagementPrivateLinkResource{},
 		ResourceDeploymentScriptAzurePowerShellResource{},
 		ResourceDeploymentScriptAzureCliResource{},
+		ResourceGroupDeploymentStackResource{},
+		SubscriptionDeploymentStackResource{},
+		ManagementGroupDeploymentStackResource{},
 	}
 }
@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/sdkhacks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ResourceGroupDeploymentStackResource struct{}
+
+func TestAccResourceGroupDeploymentStack_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_deployment_stack", "test")
+	r := ResourceGroupDeploymentStackResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_resource_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccResourceGroupDeploymentStack_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_deployment_stack", "test")
+	r := ResourceGroupDeploymentStackResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccResourceGroupDeploymentStack_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_deployment_stack", "test")
+	r := ResourceGroupDeploymentStackResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("deny_settings_mode").HasValue("denyDelete"),
+				check.That(data.ResourceName).Key("output_content").Exists(),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccResourceGroupDeploymentStack_templateSpec(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group_deployment_stack", "test")
+	r := ResourceGroupDeploymentStackResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.templateSpec(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (ResourceGroupDeploymentStackResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := sdkhacks.ParseResourceGroupDeploymentStackID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Resource.DeploymentStacksClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (ResourceGroupDeploymentStackResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_resource_group_deployment_stack" "test" {
+  name                = "acctest-stack-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  action_on_unmanage  = "DeleteAll"
+  deny_settings_mode  = "none"
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "resources": [
+    {
+      "type": "Microsoft.Network/publicIPAddresses",
+      "apiVersion": "2023-09-01",
+      "name": "acctestpip-%[1]d",
+      "location": "[resourceGroup().location]",
+      "sku": {
+        "name": "Standard"
+      },
+      "properties": {
+        "publicIPAllocationMethod": "Static"
+      }
+    }
+  ]
+}
+TEMPLATE
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r ResourceGroupDeploymentStackResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group_deployment_stack" "import" {
+  name                = azurerm_resource_group_deployment_stack.test.name
+  resource_group_name = azurerm_resource_group_deployment_stack.test.resource_group_name
+  action_on_unmanage  = azurerm_resource_group_deployment_stack.test.action_on_unmanage
+  deny_settings_mode  = azurerm_resource_group_deployment_stack.test.deny_settings_mode
+  template_content    = azurerm_resource_group_deployment_stack.test.template_content
+}
+`, r.basic(data))
+}
+
+func (ResourceGroupDeploymentStackResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_resource_group_deployment_stack" "test" {
+  name                                = "acctest-stack-%[1]d"
+  resource_group_name                 = azurerm_resource_group.test.name
+  action_on_unmanage                  = "DeleteAll"
+  deny_settings_mode                  = "denyDelete"
+  deny_settings_apply_to_child_scopes = true
+  deny_settings_excluded_actions      = ["Microsoft.Network/publicIPAddresses/delete"]
+  deny_settings_excluded_principals   = [data.azurerm_client_config.current.object_id]
+  description                         = "Acceptance Test Deployment Stack"
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "sku": {
+      "type": "string"
+    }
+  },
+  "resources": [
+    {
+      "type": "Microsoft.Network/publicIPAddresses",
+      "apiVersion": "2023-09-01",
+      "name": "acctestpip-%[1]d",
+      "location": "[resourceGroup().location]",
+      "sku": {
+        "name": "[parameters('sku')]"
+      },
+      "properties": {
+        "publicIPAllocationMethod": "Static"
+      }
+    }
+  ],
+  "outputs": {
+    "publicIpAddressId": {
+      "type": "string",
+      "value": "[resourceId('Microsoft.Network/publicIPAddresses', 'acctestpip-%[1]d')]"
+    }
+  }
+}
+TEMPLATE
+
+  parameters_content = jsonencode({
+    "sku" = {
+      value = "Standard"
+    }
+  })
+
+  tags = {
+    Hello = "World"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (ResourceGroupDeploymentStackResource) templateSpec(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+data "azurerm_template_spec_version" "test" {
+  name                = "acctest-standing-data-empty"
+  resource_group_name = "standing-data-for-acctest"
+  version             = "v1.0.0"
+}
+
+resource "azurerm_resource_group_deployment_stack" "test" {
+  name                     = "acctest-stack-%[1]d"
+  resource_group_name      = azurerm_resource_group.test.name
+  action_on_unmanage       = "DetachAll"
+  deny_settings_mode       = "none"
+  template_spec_version_id = data.azurerm_template_spec_version.test.id
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
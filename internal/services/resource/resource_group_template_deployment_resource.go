@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
@@ -25,10 +26,10 @@ import (
 
 func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: resourceGroupTemplateDeploymentResourceCreate,
-		Read:   resourceGroupTemplateDeploymentResourceRead,
-		Update: resourceGroupTemplateDeploymentResourceUpdate,
-		Delete: resourceGroupTemplateDeploymentResourceDelete,
+		CreateContext: templateDeploymentWithWhatIfDiagnostics(resourceGroupTemplateDeploymentResourceCreate, resourceGroupTemplateDeploymentWhatIfTriggers),
+		Read:          resourceGroupTemplateDeploymentResourceRead,
+		UpdateContext: templateDeploymentWithWhatIfDiagnostics(resourceGroupTemplateDeploymentResourceUpdate, resourceGroupTemplateDeploymentWhatIfTriggers),
+		Delete:        resourceGroupTemplateDeploymentResourceDelete,
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := parse.ResourceGroupTemplateDeploymentID(id)
 			return err
@@ -100,6 +101,27 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 
+			"nested_resources_cleanup_mode": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(templateDeploymentNestedResourcesCleanupModes, false),
+			},
+
+			"nested_resources_cleanup_resource_types": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"what_if_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			// Computed
 			"output_content": {
 				Type:     pluginsdk.TypeString,
@@ -107,35 +129,60 @@ func resourceGroupTemplateDeploymentResource() *pluginsdk.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
-		},
 
-		// this is needed to fix https://github.com/hashicorp/terraform-provider-azurerm/issues/12828
-		// On a change to `template_content` or `parameters_content`, we'll set `output_content` to empty
-		// The adverse effect of this is that any change to `template_content` will also cause any resource referencing `output_content` to update
-		CustomizeDiff: func(ctx context.Context, d *pluginsdk.ResourceDiff, i interface{}) error {
-			if d.HasChange("template_content") {
-				o, n := d.GetChange("template_content")
+			"outputs": templateDeploymentOutputsSchema(),
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
+		},
 
-				// the json has to be normalized and then compared against to see if a change has occurred
-				if !strings.EqualFold(o.(string), utils.NormalizeJson(n)) {
-					return d.SetNewComputed("output_content")
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			customizeDiffTemplateDeploymentNestedResourcesCleanup,
+
+			// this is needed to fix https://github.com/hashicorp/terraform-provider-azurerm/issues/12828
+			// On a change to `template_content` or `parameters_content`, we'll set `output_content` to empty
+			// The adverse effect of this is that any change to `template_content` will also cause any resource referencing `output_content` to update
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, i interface{}) error {
+				if d.HasChange("template_content") {
+					o, n := d.GetChange("template_content")
+
+					// the json has to be normalized and then compared against to see if a change has occurred
+					if !strings.EqualFold(o.(string), utils.NormalizeJson(n)) {
+						if err := d.SetNewComputed("outputs"); err != nil {
+							return err
+						}
+						return d.SetNewComputed("output_content")
+					}
 				}
-			}
 
-			if d.HasChange("parameters_content") {
-				o, n := d.GetChange("parameters_content")
+				if d.HasChange("parameters_content") {
+					o, n := d.GetChange("parameters_content")
 
-				// the json has to be normalized and then compared against to see if a change has occurred
-				if !strings.EqualFold(o.(string), utils.NormalizeJson(n)) {
-					return d.SetNewComputed("output_content")
+					// the json has to be normalized and then compared against to see if a change has occurred
+					if !strings.EqualFold(o.(string), utils.NormalizeJson(n)) {
+						if err := d.SetNewComputed("outputs"); err != nil {
+							return err
+						}
+						return d.SetNewComputed("output_content")
+					}
 				}
-			}
 
-			return nil
-		},
+				return nil
+			},
+
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+				return customizeDiffTemplateDeploymentWhatIf(ctx, d, resourceGroupTemplateDeploymentWhatIfTriggers, func(ctx context.Context, properties *resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error) {
+					client := meta.(*clients.Client).Resource.LegacyDeploymentsClient
+					properties.Mode = resources.DeploymentMode(d.Get("deployment_mode").(string))
+
+					return whatIfResourceGroupTemplateDeployment(ctx, d.Get("resource_group_name").(string), d.Get("name").(string), properties, client)
+				})
+			},
+		),
 	}
 }
 
+var resourceGroupTemplateDeploymentWhatIfTriggers = append([]string{"resource_group_name", "deployment_mode"}, templateDeploymentWhatIfTriggers...)
+
 func resourceGroupTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Resource.LegacyDeploymentsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -215,6 +262,11 @@ func resourceGroupTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData, me
 		return err
 	}
 
+	// these fields only control the behaviour of Terraform, so the deployment doesn't need to be re-run when only these change
+	if !d.HasChangesExcept("nested_resources_cleanup_mode", "nested_resources_cleanup_resource_types", "what_if_enabled", "what_if_changes") {
+		return resourceGroupTemplateDeploymentResourceRead(d, meta)
+	}
+
 	log.Printf("[DEBUG] Retrieving Template Deployment %q (Resource Group %q)..", id.DeploymentName, id.ResourceGroup)
 	template, err := client.Get(ctx, id.ResourceGroup, id.DeploymentName)
 	if err != nil {
@@ -344,6 +396,14 @@ func resourceGroupTemplateDeploymentResourceRead(d *pluginsdk.ResourceData, meta
 		}
 		d.Set("output_content", flattenedOutputs)
 
+		outputs, err := flattenTemplateDeploymentOutputs(props.Outputs)
+		if err != nil {
+			return fmt.Errorf("flattening `outputs`: %+v", err)
+		}
+		if err := d.Set("outputs", outputs); err != nil {
+			return fmt.Errorf("setting `outputs`: %+v", err)
+		}
+
 		templateLinkId := ""
 		if props.TemplateLink != nil {
 			if props.TemplateLink.ID != nil {
@@ -385,16 +445,22 @@ func resourceGroupTemplateDeploymentResourceDelete(d *pluginsdk.ResourceData, me
 		return fmt.Errorf("`properties` was nil for template`")
 	}
 
-	deleteItemsInTemplate := meta.(*clients.Client).Features.TemplateDeployment.DeleteNestedItemsDuringDeletion
+	// when `nested_resources_cleanup_mode` isn't specified, the behaviour is determined by the Provider's features block
+	defaultCleanupMode := nestedResourcesCleanupModeNone
+	if meta.(*clients.Client).Features.TemplateDeployment.DeleteNestedItemsDuringDeletion {
+		defaultCleanupMode = nestedResourcesCleanupModeAll
+	}
+
+	deleteItemsInTemplate, resourceTypes, includeNestedDeployments := expandTemplateDeploymentNestedResourcesCleanup(d, defaultCleanupMode)
 	if deleteItemsInTemplate {
 		resourceClient := meta.(*clients.Client).Resource
 		log.Printf("[DEBUG] Removing items provisioned by the Template Deployment %q (Resource Group %q)..", id.DeploymentName, id.ResourceGroup)
-		if err := deleteItemsProvisionedByTemplate(ctx, resourceClient, *template.Properties, id.SubscriptionId); err != nil {
+		if err := deleteItemsProvisionedByTemplate(ctx, resourceClient, *template.Properties, id.SubscriptionId, resourceTypes, includeNestedDeployments); err != nil {
 			return fmt.Errorf("removing items provisioned by this Template Deployment: %+v", err)
 		}
 		log.Printf("[DEBUG] Removed items provisioned by the Template Deployment %q (Resource Group %q)..", id.DeploymentName, id.ResourceGroup)
 	} else {
-		log.Printf("[DEBUG] Skipping removing items provisioned by the Template Deployment %q (Resource Group %q) as the feature is disabled", id.DeploymentName, id.ResourceGroup)
+		log.Printf("[DEBUG] Skipping removing items provisioned by the Template Deployment %q (Resource Group %q) as this is disabled", id.DeploymentName, id.ResourceGroup)
 	}
 
 	log.Printf("[DEBUG] Deleting Template Deployment %q (Resource Group %q)..", id.DeploymentName, id.ResourceGroup)
@@ -433,3 +499,26 @@ func validateResourceGroupTemplateDeployment(ctx context.Context, id parse.Resou
 
 	return nil
 }
+
+// whatIfResourceGroupTemplateDeployment returns nil when the Resource Group doesn't exist yet, since the changes can't be predicted
+func whatIfResourceGroupTemplateDeployment(ctx context.Context, resourceGroup string, deploymentName string, properties *resources.DeploymentWhatIfProperties, client *resources.DeploymentsClient) (*resources.WhatIfOperationResult, error) {
+	future, err := client.WhatIf(ctx, resourceGroup, deploymentName, resources.DeploymentWhatIf{
+		Properties: properties,
+	})
+	if err != nil {
+		if resp := future.Response(); resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[DEBUG] Resource Group %q was not found - the changes made by Template Deployment %q can't be predicted", resourceGroup, deploymentName)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("requesting what-if: %+v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return nil, fmt.Errorf("waiting for what-if: %+v", err)
+	}
+	result, err := future.Result(*client)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving what-if result: %+v", err)
+	}
+
+	return &result, nil
+}
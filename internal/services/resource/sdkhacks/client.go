@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkhacks
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// Deployment Stacks aren't available in the version of `hashicorp/go-azure-sdk` currently used by the provider,
+// as such this client is hand-written against the Swagger for API Version `2024-03-01`.
+// TODO: replace with the `deploymentstacksat*` packages once the SDK has been updated.
+const defaultApiVersion = "2024-03-01"
+
+// DeploymentStacksClient manages Deployment Stacks at the Resource Group, Subscription and Management Group
+// scopes, since the operations are identical other than the Resource ID of the Deployment Stack
+type DeploymentStacksClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewDeploymentStacksClientWithBaseURI(sdkApi sdkEnv.Api) (*DeploymentStacksClient, error) {
+	client, err := resourcemanager.NewClient(sdkApi, "deploymentstacks", defaultApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating DeploymentStacksClient: %+v", err)
+	}
+
+	return &DeploymentStacksClient{
+		Client: client,
+	}, nil
+}
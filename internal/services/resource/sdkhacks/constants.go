@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkhacks
+
+type DenySettingsMode string
+
+const (
+	DenySettingsModeDenyDelete         DenySettingsMode = "denyDelete"
+	DenySettingsModeDenyWriteAndDelete DenySettingsMode = "denyWriteAndDelete"
+	DenySettingsModeNone               DenySettingsMode = "none"
+)
+
+func PossibleValuesForDenySettingsMode() []string {
+	return []string{
+		string(DenySettingsModeDenyDelete),
+		string(DenySettingsModeDenyWriteAndDelete),
+		string(DenySettingsModeNone),
+	}
+}
+
+type UnmanageActionMode string
+
+const (
+	UnmanageActionModeDelete UnmanageActionMode = "delete"
+	UnmanageActionModeDetach UnmanageActionMode = "detach"
+)
+
+func PossibleValuesForUnmanageActionMode() []string {
+	return []string{
+		string(UnmanageActionModeDelete),
+		string(UnmanageActionModeDetach),
+	}
+}
+
+type DeploymentStackProvisioningState string
+
+const (
+	DeploymentStackProvisioningStateCanceled  DeploymentStackProvisioningState = "canceled"
+	DeploymentStackProvisioningStateFailed    DeploymentStackProvisioningState = "failed"
+	DeploymentStackProvisioningStateSucceeded DeploymentStackProvisioningState = "succeeded"
+)
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkhacks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+func init() {
+	recaser.RegisterResourceId(&ResourceGroupDeploymentStackId{})
+}
+
+var _ resourceids.ResourceId = &ResourceGroupDeploymentStackId{}
+
+// ResourceGroupDeploymentStackId is a struct representing the Resource ID for a Deployment Stack at the Resource Group scope
+type ResourceGroupDeploymentStackId struct {
+	SubscriptionId      string
+	ResourceGroupName   string
+	DeploymentStackName string
+}
+
+// NewResourceGroupDeploymentStackID returns a new ResourceGroupDeploymentStackId struct
+func NewResourceGroupDeploymentStackID(subscriptionId string, resourceGroupName string, deploymentStackName string) ResourceGroupDeploymentStackId {
+	return ResourceGroupDeploymentStackId{
+		SubscriptionId:      subscriptionId,
+		ResourceGroupName:   resourceGroupName,
+		DeploymentStackName: deploymentStackName,
+	}
+}
+
+// ParseResourceGroupDeploymentStackID parses 'input' into a ResourceGroupDeploymentStackId
+func ParseResourceGroupDeploymentStackID(input string) (*ResourceGroupDeploymentStackId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&ResourceGroupDeploymentStackId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := ResourceGroupDeploymentStackId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseResourceGroupDeploymentStackIDInsensitively parses 'input' case-insensitively into a ResourceGroupDeploymentStackId
+// note: this method should only be used for API response data and not user input
+func ParseResourceGroupDeploymentStackIDInsensitively(input string) (*ResourceGroupDeploymentStackId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&ResourceGroupDeploymentStackId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := ResourceGroupDeploymentStackId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *ResourceGroupDeploymentStackId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.ResourceGroupName, ok = input.Parsed["resourceGroupName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "resourceGroupName", input)
+	}
+
+	if id.DeploymentStackName, ok = input.Parsed["deploymentStackName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "deploymentStackName", input)
+	}
+
+	return nil
+}
+
+// ValidateResourceGroupDeploymentStackID checks that 'input' can be parsed as a Resource Group Deployment Stack ID
+func ValidateResourceGroupDeploymentStackID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseResourceGroupDeploymentStackID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Resource Group Deployment Stack ID
+func (id ResourceGroupDeploymentStackId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Resources/deploymentStacks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroupName, id.DeploymentStackName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Resource Group Deployment Stack ID
+func (id ResourceGroupDeploymentStackId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticResourceGroups", "resourceGroups", "resourceGroups"),
+		resourceids.ResourceGroupSegment("resourceGroupName", "example-resource-group"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftResources", "Microsoft.Resources", "Microsoft.Resources"),
+		resourceids.StaticSegment("staticDeploymentStacks", "deploymentStacks", "deploymentStacks"),
+		resourceids.UserSpecifiedSegment("deploymentStackName", "deploymentStackName"),
+	}
+}
+
+// String returns a human-readable description of this Resource Group Deployment Stack ID
+func (id ResourceGroupDeploymentStackId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Resource Group Name: %q", id.ResourceGroupName),
+		fmt.Sprintf("Deployment Stack Name: %q", id.DeploymentStackName),
+	}
+	return fmt.Sprintf("Resource Group Deployment Stack (%s)", strings.Join(components, "\n"))
+}
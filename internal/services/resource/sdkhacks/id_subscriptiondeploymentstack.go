@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkhacks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/recaser"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+func init() {
+	recaser.RegisterResourceId(&SubscriptionDeploymentStackId{})
+}
+
+var _ resourceids.ResourceId = &SubscriptionDeploymentStackId{}
+
+// SubscriptionDeploymentStackId is a struct representing the Resource ID for a Deployment Stack at the Subscription scope
+type SubscriptionDeploymentStackId struct {
+	SubscriptionId      string
+	DeploymentStackName string
+}
+
+// NewSubscriptionDeploymentStackID returns a new SubscriptionDeploymentStackId struct
+func NewSubscriptionDeploymentStackID(subscriptionId string, deploymentStackName string) SubscriptionDeploymentStackId {
+	return SubscriptionDeploymentStackId{
+		SubscriptionId:      subscriptionId,
+		DeploymentStackName: deploymentStackName,
+	}
+}
+
+// ParseSubscriptionDeploymentStackID parses 'input' into a SubscriptionDeploymentStackId
+func ParseSubscriptionDeploymentStackID(input string) (*SubscriptionDeploymentStackId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&SubscriptionDeploymentStackId{})
+	parsed, err := parser.Parse(input, false)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := SubscriptionDeploymentStackId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// ParseSubscriptionDeploymentStackIDInsensitively parses 'input' case-insensitively into a SubscriptionDeploymentStackId
+// note: this method should only be used for API response data and not user input
+func ParseSubscriptionDeploymentStackIDInsensitively(input string) (*SubscriptionDeploymentStackId, error) {
+	parser := resourceids.NewParserFromResourceIdType(&SubscriptionDeploymentStackId{})
+	parsed, err := parser.Parse(input, true)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := SubscriptionDeploymentStackId{}
+	if err = id.FromParseResult(*parsed); err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+func (id *SubscriptionDeploymentStackId) FromParseResult(input resourceids.ParseResult) error {
+	var ok bool
+
+	if id.SubscriptionId, ok = input.Parsed["subscriptionId"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "subscriptionId", input)
+	}
+
+	if id.DeploymentStackName, ok = input.Parsed["deploymentStackName"]; !ok {
+		return resourceids.NewSegmentNotSpecifiedError(id, "deploymentStackName", input)
+	}
+
+	return nil
+}
+
+// ValidateSubscriptionDeploymentStackID checks that 'input' can be parsed as a Subscription Deployment Stack ID
+func ValidateSubscriptionDeploymentStackID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParseSubscriptionDeploymentStackID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Subscription Deployment Stack ID
+func (id SubscriptionDeploymentStackId) ID() string {
+	fmtString := "/subscriptions/%s/providers/Microsoft.Resources/deploymentStacks/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.DeploymentStackName)
+}
+
+// Segments returns a slice of Resource ID Segments which comprise this Subscription Deployment Stack ID
+func (id SubscriptionDeploymentStackId) Segments() []resourceids.Segment {
+	return []resourceids.Segment{
+		resourceids.StaticSegment("staticSubscriptions", "subscriptions", "subscriptions"),
+		resourceids.SubscriptionIdSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceids.StaticSegment("staticProviders", "providers", "providers"),
+		resourceids.ResourceProviderSegment("staticMicrosoftResources", "Microsoft.Resources", "Microsoft.Resources"),
+		resourceids.StaticSegment("staticDeploymentStacks", "deploymentStacks", "deploymentStacks"),
+		resourceids.UserSpecifiedSegment("deploymentStackName", "deploymentStackName"),
+	}
+}
+
+// String returns a human-readable description of this Subscription Deployment Stack ID
+func (id SubscriptionDeploymentStackId) String() string {
+	components := []string{
+		fmt.Sprintf("Subscription: %q", id.SubscriptionId),
+		fmt.Sprintf("Deployment Stack Name: %q", id.DeploymentStackName),
+	}
+	return fmt.Sprintf("Subscription Deployment Stack (%s)", strings.Join(components, "\n"))
+}
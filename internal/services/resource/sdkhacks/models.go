@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkhacks
+
+type DeploymentStack struct {
+	Id         *string                    `json:"id,omitempty"`
+	Location   *string                    `json:"location,omitempty"`
+	Name       *string                    `json:"name,omitempty"`
+	Properties *DeploymentStackProperties `json:"properties,omitempty"`
+	Tags       *map[string]string         `json:"tags,omitempty"`
+	Type       *string                    `json:"type,omitempty"`
+}
+
+type DeploymentStackProperties struct {
+	ActionOnUnmanage          ActionOnUnmanage                  `json:"actionOnUnmanage"`
+	BypassStackOutOfSyncError *bool                             `json:"bypassStackOutOfSyncError,omitempty"`
+	DenySettings              DenySettings                      `json:"denySettings"`
+	DeploymentId              *string                           `json:"deploymentId,omitempty"`
+	DeploymentScope           *string                           `json:"deploymentScope,omitempty"`
+	Description               *string                           `json:"description,omitempty"`
+	Error                     *ErrorDetail                      `json:"error,omitempty"`
+	Outputs                   *map[string]interface{}           `json:"outputs,omitempty"`
+	Parameters                *map[string]interface{}           `json:"parameters,omitempty"`
+	ProvisioningState         *DeploymentStackProvisioningState `json:"provisioningState,omitempty"`
+	Resources                 *[]ManagedResourceReference       `json:"resources,omitempty"`
+	Template                  *map[string]interface{}           `json:"template,omitempty"`
+	TemplateLink              *DeploymentStacksTemplateLink     `json:"templateLink,omitempty"`
+}
+
+type ActionOnUnmanage struct {
+	ManagementGroups *UnmanageActionMode `json:"managementGroups,omitempty"`
+	ResourceGroups   *UnmanageActionMode `json:"resourceGroups,omitempty"`
+	Resources        UnmanageActionMode  `json:"resources"`
+}
+
+type DenySettings struct {
+	ApplyToChildScopes *bool            `json:"applyToChildScopes,omitempty"`
+	ExcludedActions    *[]string        `json:"excludedActions,omitempty"`
+	ExcludedPrincipals *[]string        `json:"excludedPrincipals,omitempty"`
+	Mode               DenySettingsMode `json:"mode"`
+}
+
+type DeploymentStacksTemplateLink struct {
+	Id  *string `json:"id,omitempty"`
+	Uri *string `json:"uri,omitempty"`
+}
+
+type DeploymentStackTemplateDefinition struct {
+	Template     *map[string]interface{}       `json:"template,omitempty"`
+	TemplateLink *DeploymentStacksTemplateLink `json:"templateLink,omitempty"`
+}
+
+type ManagedResourceReference struct {
+	DenyStatus *string `json:"denyStatus,omitempty"`
+	Id         *string `json:"id,omitempty"`
+	Status     *string `json:"status,omitempty"`
+}
+
+type ErrorDetail struct {
+	Code    *string        `json:"code,omitempty"`
+	Details *[]ErrorDetail `json:"details,omitempty"`
+	Message *string        `json:"message,omitempty"`
+	Target  *string        `json:"target,omitempty"`
+}
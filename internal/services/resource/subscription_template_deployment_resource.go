@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
@@ -25,10 +26,10 @@ import (
 
 func subscriptionTemplateDeploymentResource() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: subscriptionTemplateDeploymentResourceCreate,
-		Read:   subscriptionTemplateDeploymentResourceRead,
-		Update: subscriptionTemplateDeploymentResourceUpdate,
-		Delete: subscriptionTemplateDeploymentResourceDelete,
+		CreateContext: templateDeploymentWithWhatIfDiagnostics(subscriptionTemplateDeploymentResourceCreate, subscriptionTemplateDeploymentWhatIfTriggers),
+		Read:          subscriptionTemplateDeploymentResourceRead,
+		UpdateContext: templateDeploymentWithWhatIfDiagnostics(subscriptionTemplateDeploymentResourceUpdate, subscriptionTemplateDeploymentWhatIfTriggers),
+		Delete:        subscriptionTemplateDeploymentResourceDelete,
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := parse.SubscriptionTemplateDeploymentID(id)
 			return err
@@ -91,6 +92,27 @@ func subscriptionTemplateDeploymentResource() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 
+			"nested_resources_cleanup_mode": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(templateDeploymentNestedResourcesCleanupModes, false),
+			},
+
+			"nested_resources_cleanup_resource_types": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"what_if_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			// Computed
 			"output_content": {
 				Type:     pluginsdk.TypeString,
@@ -98,10 +120,31 @@ func subscriptionTemplateDeploymentResource() *pluginsdk.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"outputs": templateDeploymentOutputsSchema(),
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			customizeDiffTemplateDeploymentNestedResourcesCleanup,
+
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+				return customizeDiffTemplateDeploymentWhatIf(ctx, d, subscriptionTemplateDeploymentWhatIfTriggers, func(ctx context.Context, properties *resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error) {
+					client := meta.(*clients.Client).Resource.LegacyDeploymentsClient
+
+					return whatIfSubscriptionTemplateDeployment(ctx, d.Get("name").(string), resources.DeploymentWhatIf{
+						Location:   utils.String(location.Normalize(d.Get("location").(string))),
+						Properties: properties,
+					}, client)
+				})
+			},
+		),
 	}
 }
 
+var subscriptionTemplateDeploymentWhatIfTriggers = append([]string{"location"}, templateDeploymentWhatIfTriggers...)
+
 func subscriptionTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Resource.LegacyDeploymentsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -182,6 +225,11 @@ func subscriptionTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData, met
 		return err
 	}
 
+	// these fields only control the behaviour of Terraform, so the deployment doesn't need to be re-run when only these change
+	if !d.HasChangesExcept("nested_resources_cleanup_mode", "nested_resources_cleanup_resource_types", "what_if_enabled", "what_if_changes") {
+		return subscriptionTemplateDeploymentResourceRead(d, meta)
+	}
+
 	log.Printf("[DEBUG] Retrieving Subscription Template Deployment %q..", id.DeploymentName)
 	template, err := client.GetAtSubscriptionScope(ctx, id.DeploymentName)
 	if err != nil {
@@ -307,6 +355,14 @@ func subscriptionTemplateDeploymentResourceRead(d *pluginsdk.ResourceData, meta
 		}
 		d.Set("output_content", flattenedOutputs)
 
+		outputs, err := flattenTemplateDeploymentOutputs(props.Outputs)
+		if err != nil {
+			return fmt.Errorf("flattening `outputs`: %+v", err)
+		}
+		if err := d.Set("outputs", outputs); err != nil {
+			return fmt.Errorf("setting `outputs`: %+v", err)
+		}
+
 		templateLinkId := ""
 		if props.TemplateLink != nil {
 			if props.TemplateLink.ID != nil {
@@ -335,8 +391,28 @@ func subscriptionTemplateDeploymentResourceDelete(d *pluginsdk.ResourceData, met
 		return err
 	}
 
-	// at this time unfortunately the Resources RP doesn't expose a means of deleting top-level objects
-	// so we're unable to delete these during deletion - this'll need to be detailed in the docs
+	// at this time unfortunately the Resources RP doesn't expose a means of deleting top-level objects (such as Resource Groups)
+	// so only the resources provisioned within a Resource Provider can be deleted - this is detailed in the docs
+	if deleteItemsInTemplate, resourceTypes, includeNestedDeployments := expandTemplateDeploymentNestedResourcesCleanup(d, nestedResourcesCleanupModeNone); deleteItemsInTemplate {
+		log.Printf("[DEBUG] Retrieving Subscription Template Deployment %q..", id.DeploymentName)
+		template, err := client.GetAtSubscriptionScope(ctx, id.DeploymentName)
+		if err != nil {
+			if utils.ResponseWasNotFound(template.Response) {
+				return nil
+			}
+
+			return fmt.Errorf("retrieving Subscription Template Deployment %q: %+v", id.DeploymentName, err)
+		}
+		if template.Properties == nil {
+			return fmt.Errorf("retrieving Subscription Template Deployment %q: `properties` was nil", id.DeploymentName)
+		}
+
+		log.Printf("[DEBUG] Removing items provisioned by the Subscription Template Deployment %q..", id.DeploymentName)
+		if err := deleteItemsProvisionedByTemplate(ctx, meta.(*clients.Client).Resource, *template.Properties, id.SubscriptionId, resourceTypes, includeNestedDeployments); err != nil {
+			return fmt.Errorf("removing items provisioned by this Subscription Template Deployment: %+v", err)
+		}
+		log.Printf("[DEBUG] Removed items provisioned by the Subscription Template Deployment %q..", id.DeploymentName)
+	}
 
 	log.Printf("[DEBUG] Deleting Subscription Template Deployment %q..", id.DeploymentName)
 	future, err := client.DeleteAtSubscriptionScope(ctx, id.DeploymentName)
@@ -374,3 +450,24 @@ func validateSubscriptionTemplateDeployment(ctx context.Context, id parse.Subscr
 
 	return nil
 }
+
+// whatIfSubscriptionTemplateDeployment returns nil when the Subscription isn't found (for example as it's being created), since the changes can't be predicted
+func whatIfSubscriptionTemplateDeployment(ctx context.Context, deploymentName string, deployment resources.DeploymentWhatIf, client *resources.DeploymentsClient) (*resources.WhatIfOperationResult, error) {
+	future, err := client.WhatIfAtSubscriptionScope(ctx, deploymentName, deployment)
+	if err != nil {
+		if resp := future.Response(); resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[DEBUG] The Subscription was not found - the changes made by Template Deployment %q can't be predicted", deploymentName)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("requesting what-if: %+v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return nil, fmt.Errorf("waiting for what-if: %+v", err)
+	}
+	result, err := future.Result(*client)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving what-if result: %+v", err)
+	}
+
+	return &result, nil
+}
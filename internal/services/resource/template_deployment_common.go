@@ -10,6 +10,7 @@ import (
 	"log"
 	"net/http"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -37,6 +38,20 @@ var templateDeploymentDebugLevels = []string{
 	string(debugLevelRequestContentResponseContent),
 }
 
+type templateDeploymentNestedResourcesCleanupMode string
+
+const (
+	nestedResourcesCleanupModeAll           templateDeploymentNestedResourcesCleanupMode = "All"
+	nestedResourcesCleanupModeNone          templateDeploymentNestedResourcesCleanupMode = "None"
+	nestedResourcesCleanupModeResourceTypes templateDeploymentNestedResourcesCleanupMode = "ResourceTypes"
+)
+
+var templateDeploymentNestedResourcesCleanupModes = []string{
+	string(nestedResourcesCleanupModeAll),
+	string(nestedResourcesCleanupModeNone),
+	string(nestedResourcesCleanupModeResourceTypes),
+}
+
 func expandTemplateDeploymentDebugSetting(debugLevel string) *resources.DebugSetting {
 	if debugLevel == "" {
 		return &resources.DebugSetting{
@@ -89,6 +104,126 @@ func flattenTemplateDeploymentBody(input interface{}) (*string, error) {
 	return &output, nil
 }
 
+func templateDeploymentOutputsSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"type": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"value": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// flattenTemplateDeploymentOutputs flattens the outputs of a Template Deployment into a list sorted by name, where
+// `String` and `SecureString` values are returned as-is and all other types of value are JSON encoded
+func flattenTemplateDeploymentOutputs(input interface{}) ([]interface{}, error) {
+	output := make([]interface{}, 0)
+
+	outputs, ok := input.(map[string]interface{})
+	if !ok {
+		return output, nil
+	}
+
+	names := make([]string, 0, len(outputs))
+	for name := range outputs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		raw, ok := outputs[name].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		outputType := ""
+		if v, ok := raw["type"].(string); ok {
+			outputType = v
+		}
+
+		value := ""
+		switch v := raw["value"].(type) {
+		case nil:
+		case string:
+			value = v
+		default:
+			bytes, err := json.Marshal(v)
+			if err != nil {
+				return nil, fmt.Errorf("marshalling the value of output %q: %+v", name, err)
+			}
+			value = string(bytes)
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":  name,
+			"type":  outputType,
+			"value": value,
+		})
+	}
+
+	return output, nil
+}
+
+// customizeDiffTemplateDeploymentNestedResourcesCleanup ensures `nested_resources_cleanup_resource_types` is only
+// specified when `nested_resources_cleanup_mode` is `ResourceTypes`
+func customizeDiffTemplateDeploymentNestedResourcesCleanup(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("nested_resources_cleanup_mode") || !d.NewValueKnown("nested_resources_cleanup_resource_types") {
+		return nil
+	}
+
+	mode := d.Get("nested_resources_cleanup_mode").(string)
+	resourceTypes := d.Get("nested_resources_cleanup_resource_types").([]interface{})
+	if mode == string(nestedResourcesCleanupModeResourceTypes) && len(resourceTypes) == 0 {
+		return fmt.Errorf("`nested_resources_cleanup_resource_types` must be specified when `nested_resources_cleanup_mode` is `%s`", nestedResourcesCleanupModeResourceTypes)
+	}
+	if mode != string(nestedResourcesCleanupModeResourceTypes) && len(resourceTypes) > 0 {
+		return fmt.Errorf("`nested_resources_cleanup_resource_types` can only be specified when `nested_resources_cleanup_mode` is `%s`", nestedResourcesCleanupModeResourceTypes)
+	}
+
+	return nil
+}
+
+// expandTemplateDeploymentNestedResourcesCleanup returns whether the resources provisioned by the Template Deployment
+// should be deleted, along with the Resource Types to delete - where nil means that all resources should be deleted.
+// The resources provisioned by nested Template Deployments are only included when `nested_resources_cleanup_mode` is
+// set, since when it's omitted the `defaultMode` retains the (top-level only) behaviour of the Provider's features block
+func expandTemplateDeploymentNestedResourcesCleanup(d *pluginsdk.ResourceData, defaultMode templateDeploymentNestedResourcesCleanupMode) (deleteItems bool, resourceTypes []string, includeNestedDeployments bool) {
+	mode := defaultMode
+	if v := d.Get("nested_resources_cleanup_mode").(string); v != "" {
+		mode = templateDeploymentNestedResourcesCleanupMode(v)
+		includeNestedDeployments = true
+	}
+
+	switch mode {
+	case nestedResourcesCleanupModeAll:
+		return true, nil, includeNestedDeployments
+
+	case nestedResourcesCleanupModeResourceTypes:
+		resourceTypes = make([]string, 0)
+		for _, v := range d.Get("nested_resources_cleanup_resource_types").([]interface{}) {
+			resourceTypes = append(resourceTypes, v.(string))
+		}
+		return true, resourceTypes, includeNestedDeployments
+	}
+
+	return false, nil, false
+}
+
 func filterOutTemplateDeploymentParameters(input interface{}) interface{} {
 	if input == nil {
 		return nil
@@ -194,7 +329,9 @@ func deleteNestedResource(ctx context.Context, resourcesClient *resources.Client
 	return nil
 }
 
-func deleteItemsProvisionedByTemplate(ctx context.Context, client *client.Client, properties resources.DeploymentPropertiesExtended, subscriptionId string) error {
+// deleteItemsProvisionedByTemplate deletes the resources provisioned by the Template Deployment (and, when `includeNestedDeployments`
+// is set, those provisioned by any nested Template Deployments) - where `resourceTypes` is specified only resources of these types are deleted
+func deleteItemsProvisionedByTemplate(ctx context.Context, client *client.Client, properties resources.DeploymentPropertiesExtended, subscriptionId string, resourceTypes []string, includeNestedDeployments bool) error {
 	if properties.Providers == nil {
 		return fmt.Errorf("`properties.Providers` was nil - insufficient data to clean up this Template Deployment")
 	}
@@ -205,14 +342,45 @@ func deleteItemsProvisionedByTemplate(ctx context.Context, client *client.Client
 	providersClient := client.ResourceProvidersClient
 	resourcesClient := client.LegacyResourcesClient
 
+	resourceProviders := *properties.Providers
+	outputResources := *properties.OutputResources
+	if includeNestedDeployments {
+		log.Printf("[DEBUG] Determining the resources provisioned by this Template and any nested Templates..")
+		var err error
+		resourceProviders, outputResources, err = templateDeploymentNestedResources(ctx, client.LegacyDeploymentsClient, properties)
+		if err != nil {
+			return fmt.Errorf("determining the resources provisioned by nested Template Deployments: %+v", err)
+		}
+	}
+
+	nestedResources := make([]resources.Reference, 0)
+	for _, nestedResource := range outputResources {
+		if nestedResource.ID == nil {
+			continue
+		}
+
+		resourceType := templateDeploymentResourceType(*nestedResource.ID)
+		if resourceType == "" {
+			// top-level objects (such as Resource Groups) don't belong to a Resource Provider so can't be deleted here
+			log.Printf("[DEBUG] Skipping deletion of %q since it's not within a Resource Provider", *nestedResource.ID)
+			continue
+		}
+
+		if resourceTypes != nil && !templateDeploymentResourceTypeMatches(resourceTypes, resourceType) {
+			log.Printf("[DEBUG] Skipping deletion of %q since Resource Type %q isn't configured to be deleted", *nestedResource.ID, resourceType)
+			continue
+		}
+
+		nestedResources = append(nestedResources, nestedResource)
+	}
+
 	log.Printf("[DEBUG] Determining the API Versions used for Resources provisioned in this Template..")
-	resourceProviderApiVersions, err := determineResourceProviderAPIVersionsForResources(ctx, providersClient, *properties.Providers, subscriptionId)
+	resourceProviderApiVersions, err := determineResourceProviderAPIVersionsForResources(ctx, providersClient, resourceProviders, subscriptionId)
 	if err != nil {
 		return fmt.Errorf("determining API Versions for Resource Providers: %+v", err)
 	}
 
 	log.Printf("[DEBUG] Deleting the resources provisioned in this Template..")
-	nestedResources := *properties.OutputResources
 	deletedResources := make(map[string]bool)
 	deadline, ok := ctx.Deadline()
 	if !ok {
@@ -309,3 +477,115 @@ func findApiVersionForResourceType(resourceType string, availableResourceTypes [
 
 	return nil
 }
+
+// templateDeploymentNestedResources returns the Resource Providers and Resources used by the Template Deployment, including
+// those provisioned by any nested Template Deployments - which would otherwise be left behind when this is deleted
+func templateDeploymentNestedResources(ctx context.Context, client *resources.DeploymentsClient, properties resources.DeploymentPropertiesExtended) ([]resources.Provider, []resources.Reference, error) {
+	resourceProviders := make([]resources.Provider, 0)
+	outputResources := make([]resources.Reference, 0)
+	seen := make(map[string]struct{})
+
+	var expand func(input resources.DeploymentPropertiesExtended) error
+	expand = func(input resources.DeploymentPropertiesExtended) error {
+		if input.Providers != nil {
+			resourceProviders = append(resourceProviders, *input.Providers...)
+		}
+		if input.OutputResources == nil {
+			return nil
+		}
+
+		for _, item := range *input.OutputResources {
+			if item.ID == nil {
+				continue
+			}
+			if _, exists := seen[strings.ToLower(*item.ID)]; exists {
+				continue
+			}
+			seen[strings.ToLower(*item.ID)] = struct{}{}
+			outputResources = append(outputResources, item)
+
+			scope, deploymentName, ok := parseTemplateDeploymentNestedDeploymentID(*item.ID)
+			if !ok {
+				continue
+			}
+
+			var nested resources.DeploymentExtended
+			var err error
+			if scope == "" {
+				nested, err = client.GetAtTenantScope(ctx, deploymentName)
+			} else {
+				nested, err = client.GetAtScope(ctx, scope, deploymentName)
+			}
+			if err != nil {
+				if utils.ResponseWasNotFound(nested.Response) {
+					continue
+				}
+				return fmt.Errorf("retrieving nested Template Deployment %q: %+v", *item.ID, err)
+			}
+
+			if nested.Properties != nil {
+				if err := expand(*nested.Properties); err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	if err := expand(properties); err != nil {
+		return nil, nil, err
+	}
+
+	return resourceProviders, outputResources, nil
+}
+
+// parseTemplateDeploymentNestedDeploymentID returns the scope and name of a Template Deployment from its Resource ID,
+// where the scope is empty for a Template Deployment at the Tenant scope
+func parseTemplateDeploymentNestedDeploymentID(input string) (string, string, bool) {
+	segment := "/providers/microsoft.resources/deployments/"
+	index := strings.LastIndex(strings.ToLower(input), segment)
+	if index == -1 {
+		return "", "", false
+	}
+
+	deploymentName := input[index+len(segment):]
+	if deploymentName == "" || strings.Contains(deploymentName, "/") {
+		return "", "", false
+	}
+
+	return strings.Trim(input[:index], "/"), deploymentName, true
+}
+
+// templateDeploymentResourceType returns the Resource Type (e.g. `Microsoft.Network/virtualNetworks/subnets`) for
+// the Resource ID, or an empty string when the Resource ID isn't within a Resource Provider
+func templateDeploymentResourceType(input string) string {
+	segments := strings.Split(strings.Trim(input, "/"), "/")
+
+	index := -1
+	for i, segment := range segments {
+		if strings.EqualFold(segment, "providers") {
+			index = i
+		}
+	}
+	if index == -1 || index+1 >= len(segments) {
+		return ""
+	}
+
+	resourceType := []string{segments[index+1]}
+	for i := index + 2; i < len(segments); i += 2 {
+		resourceType = append(resourceType, segments[i])
+	}
+
+	return strings.Join(resourceType, "/")
+}
+
+func templateDeploymentResourceTypeMatches(resourceTypes []string, resourceType string) bool {
+	for _, v := range resourceTypes {
+		if strings.EqualFold(v, resourceType) {
+			return true
+		}
+	}
+
+	return false
+}
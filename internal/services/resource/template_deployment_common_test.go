@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlattenTemplateDeploymentOutputs(t *testing.T) {
+	testcases := []struct {
+		Name     string
+		Input    interface{}
+		Expected []interface{}
+	}{
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: []interface{}{},
+		},
+		{
+			Name: "Multiple Types",
+			Input: map[string]interface{}{
+				"someString": map[string]interface{}{
+					"type":  "String",
+					"value": "hello",
+				},
+				"anInt": map[string]interface{}{
+					"type":  "Int",
+					"value": float64(42),
+				},
+				"object": map[string]interface{}{
+					"type": "Object",
+					"value": map[string]interface{}{
+						"key": "value",
+					},
+				},
+				"array": map[string]interface{}{
+					"type":  "Array",
+					"value": []interface{}{"a", "b"},
+				},
+				"noValue": map[string]interface{}{
+					"type": "Bool",
+				},
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"name":  "anInt",
+					"type":  "Int",
+					"value": "42",
+				},
+				map[string]interface{}{
+					"name":  "array",
+					"type":  "Array",
+					"value": `["a","b"]`,
+				},
+				map[string]interface{}{
+					"name":  "noValue",
+					"type":  "Bool",
+					"value": "",
+				},
+				map[string]interface{}{
+					"name":  "object",
+					"type":  "Object",
+					"value": `{"key":"value"}`,
+				},
+				map[string]interface{}{
+					"name":  "someString",
+					"type":  "String",
+					"value": "hello",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		actual, err := flattenTemplateDeploymentOutputs(tc.Input)
+		if err != nil {
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
+
+func TestParseTemplateDeploymentNestedDeploymentID(t *testing.T) {
+	testcases := []struct {
+		Input          string
+		Valid          bool
+		Scope          string
+		DeploymentName string
+	}{
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/publicIPAddresses/pip",
+			Valid: false,
+		},
+		{
+			Input:          "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Resources/deployments/nested",
+			Valid:          true,
+			Scope:          "subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg",
+			DeploymentName: "nested",
+		},
+		{
+			Input:          "/subscriptions/00000000-0000-0000-0000-000000000000/providers/microsoft.resources/deployments/nested",
+			Valid:          true,
+			Scope:          "subscriptions/00000000-0000-0000-0000-000000000000",
+			DeploymentName: "nested",
+		},
+		{
+			Input:          "/providers/Microsoft.Resources/deployments/nested",
+			Valid:          true,
+			Scope:          "",
+			DeploymentName: "nested",
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Resources/deployments/nested/operations/op",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Logf("[DEBUG] Testing %q", tc.Input)
+
+		scope, deploymentName, ok := parseTemplateDeploymentNestedDeploymentID(tc.Input)
+		if ok != tc.Valid {
+			t.Fatalf("expected valid to be %t but got %t", tc.Valid, ok)
+		}
+		if scope != tc.Scope || deploymentName != tc.DeploymentName {
+			t.Fatalf("expected scope %q and name %q but got %q and %q", tc.Scope, tc.DeploymentName, scope, deploymentName)
+		}
+	}
+}
+
+func TestTemplateDeploymentResourceType(t *testing.T) {
+	testcases := map[string]string{
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg":                                                                                                   "",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/publicIPAddresses/pip":                                                 "Microsoft.Network/publicIPAddresses",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/virtualNetworks/vn/subnets/s":                                          "Microsoft.Network/virtualNetworks/subnets",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Storage/storageAccounts/sa/providers/Microsoft.Insights/diagnosticSettings/ds": "Microsoft.Insights/diagnosticSettings",
+	}
+
+	for input, expected := range testcases {
+		t.Logf("[DEBUG] Testing %q", input)
+
+		if actual := templateDeploymentResourceType(input); actual != expected {
+			t.Fatalf("expected %q but got %q", expected, actual)
+		}
+	}
+}
+
+func TestExpandTemplateDeploymentNestedResourcesCleanup(t *testing.T) {
+	testcases := []struct {
+		Name                  string
+		Mode                  string
+		ResourceTypes         []interface{}
+		DefaultMode           templateDeploymentNestedResourcesCleanupMode
+		ExpectedDeleteItems   bool
+		ExpectedResourceTypes []string
+		ExpectedIncludeNested bool
+	}{
+		{
+			Name:        "Default None",
+			DefaultMode: nestedResourcesCleanupModeNone,
+		},
+		{
+			Name:                "Default All (features block) only deletes the top-level resources",
+			DefaultMode:         nestedResourcesCleanupModeAll,
+			ExpectedDeleteItems: true,
+		},
+		{
+			Name:                  "Explicit All",
+			Mode:                  "All",
+			DefaultMode:           nestedResourcesCleanupModeNone,
+			ExpectedDeleteItems:   true,
+			ExpectedIncludeNested: true,
+		},
+		{
+			Name:        "Explicit None overrides the default",
+			Mode:        "None",
+			DefaultMode: nestedResourcesCleanupModeAll,
+		},
+		{
+			Name:                  "Explicit ResourceTypes",
+			Mode:                  "ResourceTypes",
+			ResourceTypes:         []interface{}{"Microsoft.Network/publicIPAddresses"},
+			DefaultMode:           nestedResourcesCleanupModeAll,
+			ExpectedDeleteItems:   true,
+			ExpectedResourceTypes: []string{"Microsoft.Network/publicIPAddresses"},
+			ExpectedIncludeNested: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		d := resourceGroupTemplateDeploymentResource().TestResourceData()
+		if tc.Mode != "" {
+			if err := d.Set("nested_resources_cleanup_mode", tc.Mode); err != nil {
+				t.Fatalf("setting `nested_resources_cleanup_mode`: %+v", err)
+			}
+		}
+		if tc.ResourceTypes != nil {
+			if err := d.Set("nested_resources_cleanup_resource_types", tc.ResourceTypes); err != nil {
+				t.Fatalf("setting `nested_resources_cleanup_resource_types`: %+v", err)
+			}
+		}
+
+		deleteItems, resourceTypes, includeNested := expandTemplateDeploymentNestedResourcesCleanup(d, tc.DefaultMode)
+		if deleteItems != tc.ExpectedDeleteItems {
+			t.Fatalf("expected deleteItems to be %t but got %t", tc.ExpectedDeleteItems, deleteItems)
+		}
+		if !reflect.DeepEqual(resourceTypes, tc.ExpectedResourceTypes) {
+			t.Fatalf("expected resourceTypes to be %+v but got %+v", tc.ExpectedResourceTypes, resourceTypes)
+		}
+		if includeNested != tc.ExpectedIncludeNested {
+			t.Fatalf("expected includeNestedDeployments to be %t but got %t", tc.ExpectedIncludeNested, includeNested)
+		}
+	}
+}
@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// the What-If operation is run during the plan, where the resource timeouts aren't available
+const templateDeploymentWhatIfTimeout = 30 * time.Minute
+
+// templateDeploymentWhatIfTriggers are the fields common to each Template Deployment which, when changed, mean
+// that the What-If operation needs to be re-run to predict the changes made by the deployment
+var templateDeploymentWhatIfTriggers = []string{
+	"template_content",
+	"template_spec_version_id",
+	"parameters_content",
+	"what_if_enabled",
+}
+
+// templateDeploymentWhatIfFunc runs the What-If operation at the scope of the Template Deployment, returning nil
+// where the changes can't be predicted yet (for example as the Resource Group doesn't exist yet)
+type templateDeploymentWhatIfFunc func(ctx context.Context, properties *resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error)
+
+func templateDeploymentWhatIfChangesSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"resource_id": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"change_type": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"changed_properties": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// customizeDiffTemplateDeploymentWhatIf runs the What-If operation when `what_if_enabled` is set and the deployment
+// is being created, or any of the `triggers` have changed, exposing the predicted changes in `what_if_changes`
+func customizeDiffTemplateDeploymentWhatIf(ctx context.Context, d *pluginsdk.ResourceDiff, triggers []string, whatIf templateDeploymentWhatIfFunc) error {
+	if !d.Get("what_if_enabled").(bool) {
+		if d.Id() == "" || len(d.Get("what_if_changes").([]interface{})) > 0 {
+			return d.SetNew("what_if_changes", []interface{}{})
+		}
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChanges(triggers...) {
+		return nil
+	}
+
+	config := d.GetRawConfig()
+	for _, key := range append([]string{"name"}, triggers...) {
+		if v := config.GetAttr(key); !v.IsWhollyKnown() {
+			log.Printf("[DEBUG] `%s` isn't known yet - the changes made by the Template Deployment will be predicted during the apply", key)
+			return d.SetNewComputed("what_if_changes")
+		}
+	}
+
+	properties := &resources.DeploymentWhatIfProperties{
+		DebugSetting: expandTemplateDeploymentDebugSetting(d.Get("debug_level").(string)),
+		Mode:         resources.DeploymentModeIncremental,
+		WhatIfSettings: &resources.DeploymentWhatIfSettings{
+			ResultFormat: resources.WhatIfResultFormatFullResourcePayloads,
+		},
+	}
+
+	if v := d.Get("template_spec_version_id").(string); v != "" {
+		properties.TemplateLink = &resources.TemplateLink{
+			ID: utils.String(v),
+		}
+	} else if v := config.GetAttr("template_content"); !v.IsNull() {
+		template, err := expandTemplateDeploymentBody(v.AsString())
+		if err != nil {
+			return fmt.Errorf("expanding `template_content`: %+v", err)
+		}
+		properties.Template = template
+	}
+
+	// `parameters_content` is Optional & Computed, so when it's omitted any existing value is reused
+	parametersContent := d.Get("parameters_content").(string)
+	if v := config.GetAttr("parameters_content"); !v.IsNull() {
+		parametersContent = v.AsString()
+	}
+	if parametersContent != "" {
+		parameters, err := expandTemplateDeploymentBody(parametersContent)
+		if err != nil {
+			return fmt.Errorf("expanding `parameters_content`: %+v", err)
+		}
+		properties.Parameters = parameters
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, templateDeploymentWhatIfTimeout)
+	defer cancel()
+
+	log.Printf("[DEBUG] Running the What-If operation for Template Deployment %q..", d.Get("name").(string))
+	result, err := whatIf(ctx, properties)
+	if err != nil {
+		return fmt.Errorf("predicting the changes made by Template Deployment %q: %+v", d.Get("name").(string), err)
+	}
+	if result == nil {
+		return d.SetNewComputed("what_if_changes")
+	}
+
+	if result.Error != nil {
+		if result.Error.Message != nil {
+			return fmt.Errorf("predicting the changes made by Template Deployment %q: %s", d.Get("name").(string), *result.Error.Message)
+		}
+		return fmt.Errorf("predicting the changes made by Template Deployment %q: %+v", d.Get("name").(string), *result.Error)
+	}
+
+	// a CustomizeDiff can't return warning diagnostics, so during the plan the predicted changes are only surfaced
+	// through `what_if_changes` (and the logs) - they're returned as a warning by `templateDeploymentWithWhatIfDiagnostics`
+	changes := flattenTemplateDeploymentWhatIfChanges(result.WhatIfOperationProperties)
+	for _, change := range changes {
+		v := change.(map[string]interface{})
+		log.Printf("[WARN] Template Deployment %q is predicted to %s %q", d.Get("name").(string), v["change_type"], v["resource_id"])
+	}
+
+	return d.SetNew("what_if_changes", changes)
+}
+
+// flattenTemplateDeploymentWhatIfChanges returns the changes predicted by the What-If operation, omitting any
+// resources which are either unchanged or ignored by the deployment
+func flattenTemplateDeploymentWhatIfChanges(input *resources.WhatIfOperationProperties) []interface{} {
+	output := make([]interface{}, 0)
+	if input == nil || input.Changes == nil {
+		return output
+	}
+
+	for _, change := range *input.Changes {
+		if change.ChangeType == resources.ChangeTypeNoChange || change.ChangeType == resources.ChangeTypeIgnore {
+			continue
+		}
+
+		resourceId := ""
+		if change.ResourceID != nil {
+			resourceId = *change.ResourceID
+		}
+
+		changedProperties := make([]interface{}, 0)
+		for _, path := range flattenTemplateDeploymentWhatIfPropertyChanges("", change.Delta) {
+			changedProperties = append(changedProperties, path)
+		}
+
+		output = append(output, map[string]interface{}{
+			"resource_id":        resourceId,
+			"change_type":        string(change.ChangeType),
+			"changed_properties": changedProperties,
+		})
+	}
+
+	sort.SliceStable(output, func(i, j int) bool {
+		return output[i].(map[string]interface{})["resource_id"].(string) < output[j].(map[string]interface{})["resource_id"].(string)
+	})
+
+	return output
+}
+
+// flattenTemplateDeploymentWhatIfPropertyChanges returns the full path of each of the changed properties, where
+// nested changes (such as to the items within an array) are joined to their parent's path using a `.`
+func flattenTemplateDeploymentWhatIfPropertyChanges(parent string, input *[]resources.WhatIfPropertyChange) []string {
+	output := make([]string, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, change := range *input {
+		path := parent
+		if change.Path != nil && *change.Path != "" {
+			if path != "" {
+				path += "."
+			}
+			path += *change.Path
+		}
+
+		if change.Children != nil && len(*change.Children) > 0 {
+			output = append(output, flattenTemplateDeploymentWhatIfPropertyChanges(path, change.Children)...)
+			continue
+		}
+
+		if path != "" {
+			output = append(output, path)
+		}
+	}
+
+	return output
+}
+
+// templateDeploymentWithWhatIfDiagnostics wraps the Create/Update function for a Template Deployment, surfacing the
+// changes predicted by the What-If operation as a warning once the deployment has been run
+func templateDeploymentWithWhatIfDiagnostics(f func(d *pluginsdk.ResourceData, meta interface{}) error, triggers []string) func(context.Context, *pluginsdk.ResourceData, interface{}) diag.Diagnostics {
+	return func(_ context.Context, d *pluginsdk.ResourceData, meta interface{}) diag.Diagnostics {
+		// the What-If operation is only run when one of the triggers changes, so any other changes are from a previous deployment
+		predicted := d.Get("what_if_enabled").(bool) && d.HasChanges(triggers...)
+
+		if err := f(d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+
+		if !predicted {
+			return nil
+		}
+
+		changes := d.Get("what_if_changes").([]interface{})
+		if len(changes) == 0 {
+			return nil
+		}
+
+		details := make([]string, 0, len(changes))
+		for _, raw := range changes {
+			v := raw.(map[string]interface{})
+			details = append(details, fmt.Sprintf("%s: %s", v["change_type"], v["resource_id"]))
+		}
+
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Template Deployment %q was predicted to change %d resource(s)", d.Get("name").(string), len(changes)),
+				Detail:   strings.Join(details, "\n"),
+			},
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestFlattenTemplateDeploymentWhatIfChanges(t *testing.T) {
+	testcases := []struct {
+		Name     string
+		Input    *resources.WhatIfOperationProperties
+		Expected []interface{}
+	}{
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: []interface{}{},
+		},
+		{
+			Name: "Unchanged And Ignored Resources",
+			Input: &resources.WhatIfOperationProperties{
+				Changes: &[]resources.WhatIfChange{
+					{
+						ResourceID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/publicIPAddresses/unchanged"),
+						ChangeType: resources.ChangeTypeNoChange,
+					},
+					{
+						ResourceID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/publicIPAddresses/ignored"),
+						ChangeType: resources.ChangeTypeIgnore,
+					},
+				},
+			},
+			Expected: []interface{}{},
+		},
+		{
+			Name: "Created, Modified And Deleted Resources",
+			Input: &resources.WhatIfOperationProperties{
+				Changes: &[]resources.WhatIfChange{
+					{
+						ResourceID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/publicIPAddresses/c"),
+						ChangeType: resources.ChangeTypeModify,
+						Delta: &[]resources.WhatIfPropertyChange{
+							{
+								Path:               utils.String("tags.Hello"),
+								PropertyChangeType: resources.PropertyChangeTypeModify,
+							},
+							{
+								Path:               utils.String("properties.ipTags"),
+								PropertyChangeType: resources.PropertyChangeTypeArray,
+								Children: &[]resources.WhatIfPropertyChange{
+									{
+										Path:               utils.String("0"),
+										PropertyChangeType: resources.PropertyChangeTypeCreate,
+									},
+								},
+							},
+						},
+					},
+					{
+						ResourceID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/publicIPAddresses/a"),
+						ChangeType: resources.ChangeTypeCreate,
+					},
+					{
+						ResourceID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/publicIPAddresses/b"),
+						ChangeType: resources.ChangeTypeDelete,
+					},
+				},
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"resource_id":        "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/publicIPAddresses/a",
+					"change_type":        "Create",
+					"changed_properties": []interface{}{},
+				},
+				map[string]interface{}{
+					"resource_id":        "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/publicIPAddresses/b",
+					"change_type":        "Delete",
+					"changed_properties": []interface{}{},
+				},
+				map[string]interface{}{
+					"resource_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Network/publicIPAddresses/c",
+					"change_type": "Modify",
+					"changed_properties": []interface{}{
+						"tags.Hello",
+						"properties.ipTags.0",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		actual := flattenTemplateDeploymentWhatIfChanges(tc.Input)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources" // nolint: staticcheck
@@ -25,10 +26,10 @@ import (
 
 func tenantTemplateDeploymentResource() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
-		Create: tenantTemplateDeploymentResourceCreate,
-		Read:   tenantTemplateDeploymentResourceRead,
-		Update: tenantTemplateDeploymentResourceUpdate,
-		Delete: tenantTemplateDeploymentResourceDelete,
+		CreateContext: templateDeploymentWithWhatIfDiagnostics(tenantTemplateDeploymentResourceCreate, tenantTemplateDeploymentWhatIfTriggers),
+		Read:          tenantTemplateDeploymentResourceRead,
+		UpdateContext: templateDeploymentWithWhatIfDiagnostics(tenantTemplateDeploymentResourceUpdate, tenantTemplateDeploymentWhatIfTriggers),
+		Delete:        tenantTemplateDeploymentResourceDelete,
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := parse.TenantTemplateDeploymentID(id)
 			return err
@@ -91,6 +92,27 @@ func tenantTemplateDeploymentResource() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 
+			"nested_resources_cleanup_mode": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(templateDeploymentNestedResourcesCleanupModes, false),
+			},
+
+			"nested_resources_cleanup_resource_types": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
+			"what_if_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			// Computed
 			"output_content": {
 				Type:     pluginsdk.TypeString,
@@ -98,10 +120,31 @@ func tenantTemplateDeploymentResource() *pluginsdk.Resource {
 				// NOTE:  outputs can be strings, ints, objects etc - whilst using a nested object was considered
 				// parsing the JSON using `jsondecode` allows the users to interact with/map objects as required
 			},
+
+			"outputs": templateDeploymentOutputsSchema(),
+
+			"what_if_changes": templateDeploymentWhatIfChangesSchema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			customizeDiffTemplateDeploymentNestedResourcesCleanup,
+
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+				return customizeDiffTemplateDeploymentWhatIf(ctx, d, tenantTemplateDeploymentWhatIfTriggers, func(ctx context.Context, properties *resources.DeploymentWhatIfProperties) (*resources.WhatIfOperationResult, error) {
+					client := meta.(*clients.Client).Resource.LegacyDeploymentsClient
+
+					return whatIfTenantTemplateDeployment(ctx, d.Get("name").(string), resources.ScopedDeploymentWhatIf{
+						Location:   utils.String(location.Normalize(d.Get("location").(string))),
+						Properties: properties,
+					}, client)
+				})
+			},
+		),
 	}
 }
 
+var tenantTemplateDeploymentWhatIfTriggers = append([]string{"location"}, templateDeploymentWhatIfTriggers...)
+
 func tenantTemplateDeploymentResourceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Resource.LegacyDeploymentsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
@@ -181,6 +224,11 @@ func tenantTemplateDeploymentResourceUpdate(d *pluginsdk.ResourceData, meta inte
 		return err
 	}
 
+	// these fields only control the behaviour of Terraform, so the deployment doesn't need to be re-run when only these change
+	if !d.HasChangesExcept("nested_resources_cleanup_mode", "nested_resources_cleanup_resource_types", "what_if_enabled", "what_if_changes") {
+		return tenantTemplateDeploymentResourceRead(d, meta)
+	}
+
 	log.Printf("[DEBUG] Retrieving Tenant Template Deployment %q..", id.DeploymentName)
 	template, err := client.GetAtTenantScope(ctx, id.DeploymentName)
 	if err != nil {
@@ -308,6 +356,14 @@ func tenantTemplateDeploymentResourceRead(d *pluginsdk.ResourceData, meta interf
 		}
 		d.Set("output_content", flattenedOutputs)
 
+		outputs, err := flattenTemplateDeploymentOutputs(props.Outputs)
+		if err != nil {
+			return fmt.Errorf("flattening `outputs`: %+v", err)
+		}
+		if err := d.Set("outputs", outputs); err != nil {
+			return fmt.Errorf("setting `outputs`: %+v", err)
+		}
+
 		templateLinkId := ""
 		if props.TemplateLink != nil {
 			if props.TemplateLink.ID != nil {
@@ -336,8 +392,28 @@ func tenantTemplateDeploymentResourceDelete(d *pluginsdk.ResourceData, meta inte
 		return err
 	}
 
-	// at this time unfortunately the Resources RP doesn't expose a means of deleting top-level objects
-	// so we're unable to delete these during deletion - this'll need to be detailed in the docs
+	// at this time unfortunately the Resources RP doesn't expose a means of deleting top-level objects (such as Resource Groups)
+	// so only the resources provisioned within a Resource Provider can be deleted - this is detailed in the docs
+	if deleteItemsInTemplate, resourceTypes, includeNestedDeployments := expandTemplateDeploymentNestedResourcesCleanup(d, nestedResourcesCleanupModeNone); deleteItemsInTemplate {
+		log.Printf("[DEBUG] Retrieving Tenant Template Deployment %q..", id.DeploymentName)
+		template, err := client.GetAtTenantScope(ctx, id.DeploymentName)
+		if err != nil {
+			if utils.ResponseWasNotFound(template.Response) {
+				return nil
+			}
+
+			return fmt.Errorf("retrieving Tenant Template Deployment %q: %+v", id.DeploymentName, err)
+		}
+		if template.Properties == nil {
+			return fmt.Errorf("retrieving Tenant Template Deployment %q: `properties` was nil", id.DeploymentName)
+		}
+
+		log.Printf("[DEBUG] Removing items provisioned by the Tenant Template Deployment %q..", id.DeploymentName)
+		if err := deleteItemsProvisionedByTemplate(ctx, meta.(*clients.Client).Resource, *template.Properties, meta.(*clients.Client).Account.SubscriptionId, resourceTypes, includeNestedDeployments); err != nil {
+			return fmt.Errorf("removing items provisioned by this Tenant Template Deployment: %+v", err)
+		}
+		log.Printf("[DEBUG] Removed items provisioned by the Tenant Template Deployment %q..", id.DeploymentName)
+	}
 
 	log.Printf("[DEBUG] Deleting Tenant Template Deployment %q..", id.DeploymentName)
 	future, err := client.DeleteAtTenantScope(ctx, id.DeploymentName)
@@ -375,3 +451,24 @@ func validateTenantTemplateDeployment(ctx context.Context, id parse.TenantTempla
 
 	return nil
 }
+
+// whatIfTenantTemplateDeployment returns nil when the scope of the deployment isn't found, since the changes can't be predicted
+func whatIfTenantTemplateDeployment(ctx context.Context, deploymentName string, deployment resources.ScopedDeploymentWhatIf, client *resources.DeploymentsClient) (*resources.WhatIfOperationResult, error) {
+	future, err := client.WhatIfAtTenantScope(ctx, deploymentName, deployment)
+	if err != nil {
+		if resp := future.Response(); resp != nil && resp.StatusCode == http.StatusNotFound {
+			log.Printf("[DEBUG] The scope of Template Deployment %q was not found - the changes it makes can't be predicted", deploymentName)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("requesting what-if: %+v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return nil, fmt.Errorf("waiting for what-if: %+v", err)
+	}
+	result, err := future.Result(*client)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving what-if result: %+v", err)
+	}
+
+	return &result, nil
+}
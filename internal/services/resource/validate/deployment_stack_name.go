@@ -0,0 +1,23 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func DeploymentStackName(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	var errors []error
+	if matched := regexp.MustCompile(`^[a-zA-Z0-9-._\(\)]{1,90}$`).Match([]byte(v)); !matched {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 90 characters in length and may only contain alphanumeric characters, dashes, full-stops, underscores and parentheses", k))
+	}
+
+	return nil, errors
+}
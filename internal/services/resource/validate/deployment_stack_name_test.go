@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDeploymentStackName(t *testing.T) {
+	testCases := []struct {
+		input string
+		valid bool
+	}{
+		{input: "", valid: false},
+		{input: "hello", valid: true},
+		{input: "-hello", valid: true},
+		{input: "hel-lo", valid: true},
+		{input: "123hello", valid: true},
+		{input: "h.e.l.l.o", valid: true},
+		{input: "h(e-l_l).o", valid: true},
+		{input: "hello world", valid: false},
+		{input: "hello/world", valid: false},
+		{input: strings.Repeat("a", 90), valid: true},
+		{input: strings.Repeat("a", 91), valid: false},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Testing %q..", testCase.input)
+		warnings, errors := DeploymentStackName(testCase.input, "test")
+		valid := len(warnings) == 0 && len(errors) == 0
+		if valid != testCase.valid {
+			t.Fatalf("Expected %t but got %t - %d warnings %d errors", testCase.valid, valid, len(warnings), len(errors))
+		}
+	}
+}
@@ -6,10 +6,12 @@ package storage
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"hash"
 	"io"
 	"os"
 	"runtime"
@@ -28,6 +30,7 @@ type BlobUpload struct {
 	ContainerName string
 
 	BlobType        string
+	BlockSize       int64
 	CacheControl    string
 	ContentType     string
 	ContentMD5      string
@@ -38,6 +41,10 @@ type BlobUpload struct {
 	Source          string
 	SourceContent   string
 	SourceUri       string
+
+	// ContentHash is optional - when specified the content uploaded from `Source` or `SourceContent` is written to it
+	// as it's uploaded, so that the hash matches the content of the blob
+	ContentHash hash.Hash
 }
 
 func (sbu BlobUpload) Create(ctx context.Context) error {
@@ -162,6 +169,25 @@ func (sbu BlobUpload) uploadBlockBlob(ctx context.Context) error {
 	}
 	defer file.Close()
 
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("could not stat file %q: %s", file.Name(), err)
+	}
+
+	fileSize := info.Size()
+	blockSize, err := sbu.storageBlobBlockSize(fileSize)
+	if err != nil {
+		return err
+	}
+
+	// files larger than a single block are uploaded as a series of blocks in parallel and then committed
+	if !storageBlobSingleUpload(fileSize, blockSize) {
+		if err := sbu.blockUploadFromSource(ctx, file, fileSize, blockSize); err != nil {
+			return fmt.Errorf("creating storage blob on Azure: %s", err)
+		}
+		return nil
+	}
+
 	input := blobs.PutBlockBlobInput{
 		ContentType: pointer.To(sbu.ContentType),
 		MetaData:    sbu.MetaData,
@@ -172,8 +198,16 @@ func (sbu BlobUpload) uploadBlockBlob(ctx context.Context) error {
 	if sbu.EncryptionScope != "" {
 		input.EncryptionScope = pointer.To(sbu.EncryptionScope)
 	}
-	if err := sbu.Client.PutBlockBlobFromFile(ctx, sbu.ContainerName, sbu.BlobName, file, input); err != nil {
-		return fmt.Errorf("PutBlockBlobFromFile: %s", err)
+
+	content := make([]byte, fileSize)
+	if _, err := file.ReadAt(content, 0); err != nil && err != io.EOF {
+		return fmt.Errorf("reading source file %q: %s", sbu.Source, err)
+	}
+	sbu.writeContentHash(content)
+	input.Content = &content
+
+	if _, err := sbu.Client.PutBlockBlob(ctx, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("PutBlockBlob: %s", err)
 	}
 
 	return nil
@@ -260,30 +294,16 @@ func (sbu BlobUpload) uploadPageBlob(ctx context.Context) error {
 
 type storageBlobPage struct {
 	offset  int64
-	section *io.SectionReader
+	content []byte
 }
 
 func (sbu BlobUpload) pageUploadFromSource(ctx context.Context, file io.ReaderAt, fileSize int64) error {
 	workerCount := sbu.Parallelism * runtime.NumCPU()
 
-	// first we chunk the file and assign them to 'pages'
-	pageList, err := sbu.storageBlobPageSplit(file, fileSize)
-	if err != nil {
-		return fmt.Errorf("splitting source file %q into pages: %s", sbu.Source, err)
-	}
-
-	// finally we upload the contents of said file
-	pages := make(chan storageBlobPage, len(pageList))
-	errs := make(chan error, len(pageList))
+	// the file is read sequentially and split into 'pages', which are uploaded whilst the file is being read
+	pages := make(chan storageBlobPage, workerCount)
+	errs := make(chan error, 1)
 	wg := &sync.WaitGroup{}
-	wg.Add(len(pageList))
-
-	total := int64(0)
-	for _, page := range pageList {
-		total += page.section.Size()
-		pages <- page
-	}
-	close(pages)
 
 	for i := 0; i < workerCount; i++ {
 		go sbu.blobPageUploadWorker(ctx, blobPageUploadContext{
@@ -294,8 +314,16 @@ func (sbu BlobUpload) pageUploadFromSource(ctx context.Context, file io.ReaderAt
 		})
 	}
 
+	err := sbu.storageBlobPageSplit(file, fileSize, func(page storageBlobPage) {
+		wg.Add(1)
+		pages <- page
+	})
+	close(pages)
 	wg.Wait()
 
+	if err != nil {
+		return fmt.Errorf("splitting source file %q into pages: %s", sbu.Source, err)
+	}
 	if len(errs) > 0 {
 		return fmt.Errorf("while uploading source file %q: %s", sbu.Source, <-errs)
 	}
@@ -310,7 +338,8 @@ const (
 	maxPageSize int64 = 4 * 1024 * 1024
 )
 
-func (sbu BlobUpload) storageBlobPageSplit(file io.ReaderAt, fileSize int64) ([]storageBlobPage, error) {
+// storageBlobPageSplit reads the file sequentially, calling upload for each range of non-empty pages
+func (sbu BlobUpload) storageBlobPageSplit(file io.ReaderAt, fileSize int64, upload func(page storageBlobPage)) error {
 	// whilst the file Size can be any arbitrary Size, it must be uploaded in fixed-Size pages
 	blobSize := fileSize
 	if fileSize%minPageSize != 0 {
@@ -319,46 +348,35 @@ func (sbu BlobUpload) storageBlobPageSplit(file io.ReaderAt, fileSize int64) ([]
 
 	emptyPage := make([]byte, minPageSize)
 
-	type byteRange struct {
-		offset int64
-		length int64
-	}
-
-	var nonEmptyRanges []byteRange
-	var currentRange byteRange
+	current := storageBlobPage{}
 	for i := int64(0); i < blobSize; i += minPageSize {
 		pageBuf := make([]byte, minPageSize)
-		if _, err := file.ReadAt(pageBuf, i); err != nil && err != io.EOF {
-			return nil, fmt.Errorf("could not read chunk at %d: %s", i, err)
+		n, err := file.ReadAt(pageBuf, i)
+		if err != nil && err != io.EOF {
+			return fmt.Errorf("could not read chunk at %d: %s", i, err)
 		}
+		sbu.writeContentHash(pageBuf[:n])
 
 		if bytes.Equal(pageBuf, emptyPage) {
-			if currentRange.length != 0 {
-				nonEmptyRanges = append(nonEmptyRanges, currentRange)
+			if len(current.content) != 0 {
+				upload(current)
 			}
-			currentRange = byteRange{
+			current = storageBlobPage{
 				offset: i + minPageSize,
 			}
 		} else {
-			currentRange.length += minPageSize
-			if currentRange.length == maxPageSize || (currentRange.offset+currentRange.length == blobSize) {
-				nonEmptyRanges = append(nonEmptyRanges, currentRange)
-				currentRange = byteRange{
+			current.content = append(current.content, pageBuf...)
+			length := int64(len(current.content))
+			if length == maxPageSize || (current.offset+length == blobSize) {
+				upload(current)
+				current = storageBlobPage{
 					offset: i + minPageSize,
 				}
 			}
 		}
 	}
 
-	pages := make([]storageBlobPage, 0, len(nonEmptyRanges))
-	for _, nonEmptyRange := range nonEmptyRanges {
-		pages = append(pages, storageBlobPage{
-			offset:  nonEmptyRange.offset,
-			section: io.NewSectionReader(file, nonEmptyRange.offset, nonEmptyRange.length),
-		})
-	}
-
-	return pages, nil
+	return nil
 }
 
 type blobPageUploadContext struct {
@@ -371,27 +389,20 @@ type blobPageUploadContext struct {
 func (sbu BlobUpload) blobPageUploadWorker(ctx context.Context, uploadCtx blobPageUploadContext) {
 	for page := range uploadCtx.pages {
 		start := page.offset
-		end := page.offset + page.section.Size() - 1
+		end := page.offset + int64(len(page.content)) - 1
 		if end > uploadCtx.blobSize-1 {
 			end = uploadCtx.blobSize - 1
 		}
 		size := end - start + 1
 
-		chunk := make([]byte, size)
-		if _, err := page.section.Read(chunk); err != nil && err != io.EOF {
-			uploadCtx.errors <- fmt.Errorf("reading source file %q at offset %d: %s", sbu.Source, page.offset, err)
-			uploadCtx.wg.Done()
-			continue
-		}
-
 		input := blobs.PutPageUpdateInput{
 			StartByte: start,
 			EndByte:   end,
-			Content:   chunk,
+			Content:   page.content[:size],
 		}
 
 		if _, err := sbu.Client.PutPageUpdate(ctx, sbu.ContainerName, sbu.BlobName, input); err != nil {
-			uploadCtx.errors <- fmt.Errorf("writing page at offset %d for file %q: %s", page.offset, sbu.Source, err)
+			sendUploadError(uploadCtx.errors, fmt.Errorf("writing page at offset %d for file %q: %s", page.offset, sbu.Source, err))
 			uploadCtx.wg.Done()
 			continue
 		}
@@ -400,6 +411,196 @@ func (sbu BlobUpload) blobPageUploadWorker(ctx context.Context, uploadCtx blobPa
 	}
 }
 
+type storageBlobBlock struct {
+	id      string
+	offset  int64
+	content []byte
+}
+
+const (
+	// defaultBlockSize is used when a block size hasn't been specified
+	defaultBlockSize int64 = 4 * 1024 * 1024
+
+	maxBlockSize  int64 = 4000 * 1024 * 1024
+	maxBlockCount int64 = 50000
+
+	// maxSingleUploadSize is the largest file which is read into memory and uploaded in a single request, larger
+	// files are uploaded in blocks regardless of the block size
+	maxSingleUploadSize int64 = 256 * 1024 * 1024
+)
+
+// storageBlobSingleUpload returns whether a file of the given size should be uploaded in a single request, rather than
+// as a series of blocks
+func storageBlobSingleUpload(fileSize int64, blockSize int64) bool {
+	return fileSize <= blockSize && fileSize <= maxSingleUploadSize
+}
+
+// storageBlobBlockSize returns the size of the blocks that a file of the given size should be uploaded in,
+// increasing the configured block size where necessary to remain within the maximum number of blocks per blob
+func (sbu BlobUpload) storageBlobBlockSize(fileSize int64) (int64, error) {
+	blockSize := defaultBlockSize
+	if sbu.BlockSize > 0 {
+		blockSize = sbu.BlockSize
+	}
+
+	if (fileSize+blockSize-1)/blockSize > maxBlockCount {
+		blockSize = (fileSize + maxBlockCount - 1) / maxBlockCount
+	}
+
+	if blockSize > maxBlockSize {
+		return 0, fmt.Errorf("source file %q is too large to be uploaded as a Block blob", sbu.Source)
+	}
+
+	return blockSize, nil
+}
+
+func (sbu BlobUpload) blockUploadFromSource(ctx context.Context, file io.ReaderAt, fileSize int64, blockSize int64) error {
+	blockCount := (fileSize + blockSize - 1) / blockSize
+
+	workerCount := int64(sbu.Parallelism * runtime.NumCPU())
+	if workerCount > blockCount {
+		workerCount = blockCount
+	}
+
+	// the file is read sequentially, so that the content can be hashed in order, with each block uploaded whilst
+	// the remainder of the file is being read
+	blockList := make(chan storageBlobBlock, workerCount)
+	errs := make(chan error, 1)
+	wg := &sync.WaitGroup{}
+
+	for i := int64(0); i < workerCount; i++ {
+		go sbu.blobBlockUploadWorker(ctx, blobBlockUploadContext{
+			blocks: blockList,
+			errors: errs,
+			wg:     wg,
+		})
+	}
+
+	// Block IDs must be Base64 encoded and all of the same length prior to encoding
+	blockIds := make([]blobs.BlockID, 0, blockCount)
+	var readErr error
+	for i := int64(0); i < blockCount; i++ {
+		offset := i * blockSize
+		length := blockSize
+		if offset+length > fileSize {
+			length = fileSize - offset
+		}
+
+		content := make([]byte, length)
+		if _, err := file.ReadAt(content, offset); err != nil && err != io.EOF {
+			readErr = fmt.Errorf("reading source file %q at offset %d: %s", sbu.Source, offset, err)
+			break
+		}
+		sbu.writeContentHash(content)
+
+		id := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("block-%08d", i)))
+		blockIds = append(blockIds, blobs.BlockID{Value: id})
+
+		wg.Add(1)
+		blockList <- storageBlobBlock{
+			id:      id,
+			offset:  offset,
+			content: content,
+		}
+	}
+	close(blockList)
+
+	wg.Wait()
+
+	if readErr != nil {
+		return readErr
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("while uploading source file %q: %s", sbu.Source, <-errs)
+	}
+
+	input := blobs.PutBlockListInput{
+		BlockList: blobs.BlockList{
+			LatestBlockIDs: blockIds,
+		},
+		ContentType: pointer.To(sbu.ContentType),
+		MetaData:    sbu.MetaData,
+	}
+	if sbu.ContentMD5 != "" {
+		input.ContentMD5 = pointer.To(sbu.ContentMD5)
+	}
+	if sbu.EncryptionScope != "" {
+		input.EncryptionScope = pointer.To(sbu.EncryptionScope)
+	}
+	if _, err := sbu.Client.PutBlockList(ctx, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("committing the block list for source file %q: %s", sbu.Source, err)
+	}
+
+	return nil
+}
+
+type blobBlockUploadContext struct {
+	blocks chan storageBlobBlock
+	errors chan error
+	wg     *sync.WaitGroup
+}
+
+func (sbu BlobUpload) blobBlockUploadWorker(ctx context.Context, uploadCtx blobBlockUploadContext) {
+	for block := range uploadCtx.blocks {
+		input := blobs.PutBlockInput{
+			BlockID: block.id,
+			Content: block.content,
+		}
+		if sbu.EncryptionScope != "" {
+			input.EncryptionScope = pointer.To(sbu.EncryptionScope)
+		}
+
+		if _, err := sbu.Client.PutBlock(ctx, sbu.ContainerName, sbu.BlobName, input); err != nil {
+			sendUploadError(uploadCtx.errors, fmt.Errorf("writing block at offset %d for file %q: %s", block.offset, sbu.Source, err))
+			uploadCtx.wg.Done()
+			continue
+		}
+
+		uploadCtx.wg.Done()
+	}
+}
+
+// sendUploadError records the error when an error hasn't already been recorded, since only the first error is returned
+func sendUploadError(errs chan<- error, err error) {
+	select {
+	case errs <- err:
+	default:
+	}
+}
+
+// writeContentHash writes the uploaded content to the ContentHash, when specified
+func (sbu BlobUpload) writeContentHash(content []byte) {
+	if sbu.ContentHash != nil {
+		sbu.ContentHash.Write(content)
+	}
+}
+
+// contentSHA256FromHash returns the hex encoded hash
+func contentSHA256FromHash(h hash.Hash) string {
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// contentSHA256FromFile returns the hex encoded SHA256 hash of the contents of the file at the specified path
+func contentSHA256FromFile(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("reading %q: %+v", path, err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func contentSHA256FromString(input string) string {
+	hash := sha256.Sum256([]byte(input))
+	return hex.EncodeToString(hash[:])
+}
+
 func convertHexToBase64Encoding(str string) (string, error) {
 	data, err := hex.DecodeString(str)
 	if err != nil {
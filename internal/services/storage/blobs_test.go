@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+)
+
+func TestStorageBlobBlockSize(t *testing.T) {
+	const mb int64 = 1024 * 1024
+
+	testcases := []struct {
+		Name       string
+		BlockSize  int64
+		FileSize   int64
+		Expected   int64
+		ShouldFail bool
+	}{
+		{
+			Name:     "Default Block Size",
+			FileSize: 100 * mb,
+			Expected: defaultBlockSize,
+		},
+		{
+			Name:      "Configured Block Size",
+			BlockSize: 16 * mb,
+			FileSize:  100 * mb,
+			Expected:  16 * mb,
+		},
+		{
+			Name:     "Default Block Size Exactly At Block Limit",
+			FileSize: maxBlockCount * defaultBlockSize,
+			Expected: defaultBlockSize,
+		},
+		{
+			Name:     "Default Block Size Exceeding Block Limit",
+			FileSize: maxBlockCount*defaultBlockSize + 1,
+			Expected: defaultBlockSize + 1,
+		},
+		{
+			Name:       "File Too Large",
+			BlockSize:  maxBlockSize,
+			FileSize:   maxBlockCount*maxBlockSize + 1,
+			ShouldFail: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		actual, err := BlobUpload{BlockSize: tc.BlockSize}.storageBlobBlockSize(tc.FileSize)
+		if err != nil {
+			if !tc.ShouldFail {
+				t.Fatalf("expected no error but got: %+v", err)
+			}
+			continue
+		}
+		if tc.ShouldFail {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if actual != tc.Expected {
+			t.Fatalf("expected a block size of %d but got %d", tc.Expected, actual)
+		}
+		if (tc.FileSize+actual-1)/actual > maxBlockCount {
+			t.Fatalf("expected at most %d blocks but got %d", maxBlockCount, (tc.FileSize+actual-1)/actual)
+		}
+	}
+}
+
+func TestStorageBlobSingleUpload(t *testing.T) {
+	const mb int64 = 1024 * 1024
+
+	testcases := []struct {
+		Name      string
+		BlockSize int64
+		FileSize  int64
+		Expected  bool
+	}{
+		{
+			Name:      "Smaller Than The Block Size",
+			BlockSize: defaultBlockSize,
+			FileSize:  defaultBlockSize - 1,
+			Expected:  true,
+		},
+		{
+			Name:      "Exactly The Block Size",
+			BlockSize: defaultBlockSize,
+			FileSize:  defaultBlockSize,
+			Expected:  true,
+		},
+		{
+			Name:      "Larger Than The Block Size",
+			BlockSize: defaultBlockSize,
+			FileSize:  defaultBlockSize + 1,
+			Expected:  false,
+		},
+		{
+			Name:      "Large Block Size At The Single Upload Limit",
+			BlockSize: maxBlockSize,
+			FileSize:  maxSingleUploadSize,
+			Expected:  true,
+		},
+		{
+			Name:      "Large Block Size Exceeding The Single Upload Limit",
+			BlockSize: maxBlockSize,
+			FileSize:  maxSingleUploadSize + 1,
+			Expected:  false,
+		},
+		{
+			Name:      "Maximum Block Size",
+			BlockSize: maxBlockSize,
+			FileSize:  1000 * mb,
+			Expected:  false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		if actual := storageBlobSingleUpload(tc.FileSize, tc.BlockSize); actual != tc.Expected {
+			t.Fatalf("expected %t but got %t", tc.Expected, actual)
+		}
+	}
+}
+
+func TestStorageBlobPageSplit(t *testing.T) {
+	// a non-empty page, followed by an empty page and then a partial non-empty page
+	content := bytes.Repeat([]byte("a"), int(minPageSize))
+	content = append(content, make([]byte, minPageSize)...)
+	content = append(content, bytes.Repeat([]byte("b"), 512)...)
+
+	upload := BlobUpload{ContentHash: sha256.New()}
+	pages := make([]storageBlobPage, 0)
+	err := upload.storageBlobPageSplit(bytes.NewReader(content), int64(len(content)), func(page storageBlobPage) {
+		pages = append(pages, page)
+	})
+	if err != nil {
+		t.Fatalf("expected no error but got: %+v", err)
+	}
+
+	if len(pages) != 2 {
+		t.Fatalf("expected 2 pages but got %d", len(pages))
+	}
+	if pages[0].offset != 0 || int64(len(pages[0].content)) != minPageSize {
+		t.Fatalf("expected the first page to be at offset 0 with %d bytes but got offset %d with %d bytes", minPageSize, pages[0].offset, len(pages[0].content))
+	}
+	if pages[1].offset != 2*minPageSize || !bytes.HasPrefix(pages[1].content, content[2*minPageSize:]) {
+		t.Fatalf("expected the second page to be at offset %d with the remaining content but got offset %d", 2*minPageSize, pages[1].offset)
+	}
+
+	expected := contentSHA256FromString(string(content))
+	if actual := contentSHA256FromHash(upload.ContentHash); actual != expected {
+		t.Fatalf("expected the content hash to be %q but got %q", expected, actual)
+	}
+}
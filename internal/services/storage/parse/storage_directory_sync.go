@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/fileshares"
+)
+
+var _ resourceids.Id = StorageDirectorySyncId{}
+
+// StorageDirectorySyncId is used by the resource azurerm_storage_directory_sync, where exactly one of
+// StorageContainerId or StorageShareId is set - and DestinationPrefix is empty when synchronising to the root
+type StorageDirectorySyncId struct {
+	StorageContainerId *commonids.StorageContainerId
+	StorageShareId     *fileshares.ShareId
+	DestinationPrefix  string
+}
+
+func NewStorageDirectorySyncIDForContainer(containerId commonids.StorageContainerId, destinationPrefix string) StorageDirectorySyncId {
+	return StorageDirectorySyncId{
+		StorageContainerId: &containerId,
+		DestinationPrefix:  destinationPrefix,
+	}
+}
+
+func NewStorageDirectorySyncIDForShare(shareId fileshares.ShareId, destinationPrefix string) StorageDirectorySyncId {
+	return StorageDirectorySyncId{
+		StorageShareId:    &shareId,
+		DestinationPrefix: destinationPrefix,
+	}
+}
+
+func (id StorageDirectorySyncId) ID() string {
+	targetId := ""
+	if id.StorageContainerId != nil {
+		targetId = id.StorageContainerId.ID()
+	}
+	if id.StorageShareId != nil {
+		targetId = id.StorageShareId.ID()
+	}
+
+	if id.DestinationPrefix == "" {
+		return targetId
+	}
+
+	return fmt.Sprintf("%s|%s", targetId, id.DestinationPrefix)
+}
+
+func (id StorageDirectorySyncId) String() string {
+	components := make([]string, 0)
+	if id.StorageContainerId != nil {
+		components = append(components, fmt.Sprintf("Storage Container %s", id.StorageContainerId.ID()))
+	}
+	if id.StorageShareId != nil {
+		components = append(components, fmt.Sprintf("Storage Share %s", id.StorageShareId.ID()))
+	}
+	components = append(components, fmt.Sprintf("Destination Prefix %q", id.DestinationPrefix))
+
+	return fmt.Sprintf("Storage Directory Sync: (%s)", strings.Join(components, " / "))
+}
+
+// StorageDirectorySyncID parses a Storage Directory Sync ID, which is in the format `{StorageContainerId|StorageShareId}`
+// or `{StorageContainerId|StorageShareId}|{DestinationPrefix}`
+func StorageDirectorySyncID(input string) (*StorageDirectorySyncId, error) {
+	targetId, destinationPrefix, hasPrefix := strings.Cut(input, "|")
+	if hasPrefix && destinationPrefix == "" {
+		return nil, fmt.Errorf("expected ID to be in the format {StorageContainerId|StorageShareId}|{DestinationPrefix} but the Destination Prefix was empty in %q", input)
+	}
+
+	if containerId, err := commonids.ParseStorageContainerID(targetId); err == nil {
+		id := NewStorageDirectorySyncIDForContainer(*containerId, destinationPrefix)
+		return &id, nil
+	}
+
+	shareId, err := fileshares.ParseShareID(targetId)
+	if err != nil {
+		return nil, fmt.Errorf("expected %q to be either a Storage Container ID or a Storage Share ID", targetId)
+	}
+
+	id := NewStorageDirectorySyncIDForShare(*shareId, destinationPrefix)
+	return &id, nil
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/fileshares"
+)
+
+func TestStorageDirectorySyncID(t *testing.T) {
+	containerId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/blobServices/default/containers/container1"
+	shareId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/fileServices/default/shares/share1"
+
+	testData := []struct {
+		Name   string
+		Input  string
+		Expect *StorageDirectorySyncId
+		Error  bool
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+			Error: true,
+		},
+		{
+			Name:  "Storage Account ID",
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1",
+			Error: true,
+		},
+		{
+			Name:  "Invalid Target ID with Destination Prefix",
+			Input: "hello|site",
+			Error: true,
+		},
+		{
+			Name:  "Empty Destination Prefix",
+			Input: containerId + "|",
+			Error: true,
+		},
+		{
+			Name:  "Storage Container ID",
+			Input: containerId,
+			Expect: &StorageDirectorySyncId{
+				StorageContainerId: &commonids.StorageContainerId{
+					SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+					ResourceGroupName:  "resGroup1",
+					StorageAccountName: "storageAccount1",
+					ContainerName:      "container1",
+				},
+			},
+		},
+		{
+			Name:  "Storage Container ID with Destination Prefix",
+			Input: containerId + "|site/v1",
+			Expect: &StorageDirectorySyncId{
+				StorageContainerId: &commonids.StorageContainerId{
+					SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+					ResourceGroupName:  "resGroup1",
+					StorageAccountName: "storageAccount1",
+					ContainerName:      "container1",
+				},
+				DestinationPrefix: "site/v1",
+			},
+		},
+		{
+			Name:  "Storage Share ID with Destination Prefix",
+			Input: shareId + "|config",
+			Expect: &StorageDirectorySyncId{
+				StorageShareId: &fileshares.ShareId{
+					SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+					ResourceGroupName:  "resGroup1",
+					StorageAccountName: "storageAccount1",
+					ShareName:          "share1",
+				},
+				DestinationPrefix: "config",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := StorageDirectorySyncID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.Error {
+			t.Fatal("expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(actual, v.Expect) {
+			t.Fatalf("expected %+v but got %+v", v.Expect, actual)
+		}
+
+		if actual.ID() != v.Input {
+			t.Fatalf("expected the ID to round-trip as %q but got %q", v.Input, actual.ID())
+		}
+	}
+}
@@ -92,6 +92,7 @@ func (r Registration) Resources() []sdk.Resource {
 		AccountStaticWebsiteResource{},
 		LocalUserResource{},
 		StorageContainerImmutabilityPolicyResource{},
+		StorageDirectorySyncResource{},
 		SyncServerEndpointResource{},
 	}
 }
@@ -5,8 +5,10 @@ package storage
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"log"
+	"os"
 	"strings"
 	"time"
 
@@ -152,7 +154,6 @@ func resourceStorageBlob() *pluginsdk.Resource {
 			},
 
 			"parallelism": {
-				// TODO: @tombuildsstuff - a note this only works for Page blobs
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
 				Default:      8,
@@ -160,6 +161,18 @@ func resourceStorageBlob() *pluginsdk.Resource {
 				ValidateFunc: validation.IntAtLeast(1),
 			},
 
+			"block_size_in_mb": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 4000),
+			},
+
+			"content_sha256": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"metadata": MetaDataComputedSchema(),
 		},
 
@@ -169,6 +182,32 @@ func resourceStorageBlob() *pluginsdk.Resource {
 					return fmt.Errorf(`"source" must be aligned to 512-byte boundary for "type" set to "Page"`)
 				}
 			}
+
+			// the local file referenced by `source` may have changed since it was uploaded, in which case the blob needs to be recreated
+			if diff.Id() != "" && diff.NewValueKnown("source") && !diff.HasChange("source") {
+				source := diff.Get("source").(string)
+				existing := diff.Get("content_sha256").(string)
+				if source != "" && existing != "" {
+					hash, err := contentSHA256FromFile(source)
+					if err != nil {
+						if os.IsNotExist(err) {
+							log.Printf("[DEBUG] Unable to locate `source` file %q - skipping content drift detection", source)
+							return nil
+						}
+						return fmt.Errorf("computing the SHA256 hash of `source` file %q: %+v", source, err)
+					}
+
+					if hash != existing {
+						if err := diff.SetNew("content_sha256", hash); err != nil {
+							return err
+						}
+						if err := diff.ForceNew("content_sha256"); err != nil {
+							return err
+						}
+					}
+				}
+			}
+
 			return nil
 		},
 	}
@@ -236,6 +275,7 @@ func resourceStorageBlobCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		Client:        blobsClient,
 
 		BlobType:      d.Get("type").(string),
+		BlockSize:     int64(d.Get("block_size_in_mb").(int)) * 1024 * 1024,
 		CacheControl:  d.Get("cache_control").(string),
 		ContentType:   d.Get("content_type").(string),
 		ContentMD5:    contentMD5,
@@ -245,6 +285,9 @@ func resourceStorageBlobCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		Source:        d.Get("source").(string),
 		SourceContent: d.Get("source_content").(string),
 		SourceUri:     d.Get("source_uri").(string),
+
+		// the hash of the uploaded content is tracked so that changes to the local `source` file can be detected
+		ContentHash: sha256.New(),
 	}
 
 	if encryptionScope := d.Get("encryption_scope"); encryptionScope.(string) != "" {
@@ -258,6 +301,12 @@ func resourceStorageBlobCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	d.SetId(id.ID())
 
+	contentSHA256 := ""
+	if blobInput.Source != "" || blobInput.SourceContent != "" {
+		contentSHA256 = contentSHA256FromHash(blobInput.ContentHash)
+	}
+	d.Set("content_sha256", contentSHA256)
+
 	return resourceStorageBlobUpdate(d, meta)
 }
 
@@ -155,7 +155,7 @@ func TestAccStorageBlob_blockFromInlineContent(t *testing.T) {
 				data.CheckWithClient(r.blobMatchesContent(blobs.BlockBlob, []byte(content))),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source_content", "type"),
+		data.ImportStep("content_sha256", "parallelism", "size", "source_content", "type"),
 	})
 }
 
@@ -172,7 +172,7 @@ func TestAccStorageBlob_blockFromInlineContentWithContentType(t *testing.T) {
 				data.CheckWithClient(r.blobMatchesContent(blobs.BlockBlob, []byte(content))),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source_content", "type"),
+		data.ImportStep("content_sha256", "parallelism", "size", "source_content", "type"),
 	})
 }
 
@@ -241,7 +241,50 @@ func TestAccStorageBlob_blockFromLocalFile(t *testing.T) {
 				data.CheckWithClient(r.blobMatchesFile(blobs.BlockBlob, sourceBlob.Name())),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source", "type"),
+		data.ImportStep("content_sha256", "parallelism", "size", "source", "type"),
+	})
+}
+
+func TestAccStorageBlob_blockFromLocalFileInBlocks(t *testing.T) {
+	sourceBlob, err := os.CreateTemp("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+
+	if err := populateTempFile(sourceBlob); err != nil {
+		t.Fatalf("Error populating temp file: %s", err)
+	}
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.blockFromLocalBlobInBlocks(data, sourceBlob.Name()),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("content_sha256").IsSet(),
+				data.CheckWithClient(r.blobMatchesFile(blobs.BlockBlob, sourceBlob.Name())),
+			),
+		},
+		data.ImportStep("block_size_in_mb", "content_sha256", "parallelism", "size", "source", "type"),
+		{
+			// changing the contents of the local file should recreate the blob
+			PreConfig: func() {
+				file, err := os.OpenFile(sourceBlob.Name(), os.O_RDWR, 0o644)
+				if err != nil {
+					t.Fatalf("Failed to open local source blob file: %s", err)
+				}
+				if err := populateTempFile(file); err != nil {
+					t.Fatalf("Error populating temp file: %s", err)
+				}
+			},
+			Config: r.blockFromLocalBlobInBlocks(data, sourceBlob.Name()),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.blobMatchesFile(blobs.BlockBlob, sourceBlob.Name())),
+			),
+		},
+		data.ImportStep("block_size_in_mb", "content_sha256", "parallelism", "size", "source", "type"),
 	})
 }
 
@@ -266,7 +309,7 @@ func TestAccStorageBlob_blockFromLocalFileWithContentMd5(t *testing.T) {
 				acceptance.TestCheckResourceAttr(data.ResourceName, "source", sourceBlob.Name()),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source", "type"),
+		data.ImportStep("content_sha256", "parallelism", "size", "source", "type"),
 	})
 }
 
@@ -341,7 +384,7 @@ func TestAccStorageBlob_encryptionScope(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source_content", "type"),
+		data.ImportStep("content_sha256", "parallelism", "size", "source_content", "type"),
 	})
 }
 
@@ -357,28 +400,28 @@ func TestAccStorageBlob_encryptionScopeUpdate(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source_content", "type"),
+		data.ImportStep("content_sha256", "parallelism", "size", "source_content", "type"),
 		{
 			Config: r.encryptionScopeUpdateMetadata(data, content),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source_content", "type"),
+		data.ImportStep("content_sha256", "parallelism", "size", "source_content", "type"),
 		{
 			Config: r.encryptionScopeUpdateProperties(data, content),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source_content", "type"),
+		data.ImportStep("content_sha256", "parallelism", "size", "source_content", "type"),
 		{
 			Config: r.encryptionScopeUpdateAccessTier(data, content),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source_content", "type"),
+		data.ImportStep("content_sha256", "parallelism", "size", "source_content", "type"),
 	})
 }
 
@@ -462,7 +505,7 @@ func TestAccStorageBlob_pageFromLocalFile(t *testing.T) {
 				data.CheckWithClient(r.blobMatchesFile(blobs.PageBlob, sourceBlob.Name())),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type", "source"),
+		data.ImportStep("content_sha256", "parallelism", "size", "type", "source"),
 	})
 }
 
@@ -477,7 +520,7 @@ func TestAccStorageBlob_pageFromInlineContent(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "source_content", "type"),
+		data.ImportStep("content_sha256", "parallelism", "size", "source_content", "type"),
 		{
 			Config:      r.pageFromInlineContent(data, 511),
 			ExpectError: regexp.MustCompile(`"source" must be aligned to 512-byte boundary for "type" set to "Page"`),
@@ -533,7 +576,7 @@ func TestAccStorageBlob_archive(t *testing.T) {
 				check.That(data.ResourceName).ExistsInAzure(r),
 			),
 		},
-		data.ImportStep("parallelism", "size", "type", "source_content"),
+		data.ImportStep("content_sha256", "parallelism", "size", "type", "source_content"),
 	})
 }
 
@@ -922,6 +965,27 @@ resource "azurerm_storage_blob" "test" {
 `, template, fileName)
 }
 
+func (r StorageBlobResource) blockFromLocalBlobInBlocks(data acceptance.TestData, fileName string) string {
+	template := r.template(data, "private")
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.vhd"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source                 = "%s"
+  block_size_in_mb       = 1
+  parallelism            = 4
+}
+`, template, fileName)
+}
+
 func (r StorageBlobResource) contentMd5ForLocalFile(data acceptance.TestData, fileName string) string {
 	template := r.template(data, "blob")
 	return fmt.Sprintf(`
@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"fmt"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// directorySyncManifest builds a map of the relative path (using forward slashes) of each file within
+// `sourceDirectory` matching the include/exclude patterns to the SHA256 hash of its contents
+func directorySyncManifest(sourceDirectory string, include []string, exclude []string) (map[string]string, error) {
+	manifest := make(map[string]string)
+
+	err := filepath.WalkDir(sourceDirectory, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		// symlinks are followed when they point to a file, other special files are ignored
+		if !entry.Type().IsRegular() {
+			info, err := os.Stat(filePath)
+			if err != nil {
+				return fmt.Errorf("retrieving information for %q: %+v", filePath, err)
+			}
+			if !info.Mode().IsRegular() {
+				return nil
+			}
+		}
+
+		relativePath, err := filepath.Rel(sourceDirectory, filePath)
+		if err != nil {
+			return err
+		}
+		relativePath = filepath.ToSlash(relativePath)
+
+		if !directorySyncPathIsIncluded(relativePath, include, exclude) {
+			return nil
+		}
+
+		hash, err := contentSHA256FromFile(filePath)
+		if err != nil {
+			return fmt.Errorf("computing the SHA256 hash of %q: %+v", filePath, err)
+		}
+		manifest[relativePath] = hash
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// directorySyncPathIsIncluded returns whether the relative path matches at least one of the include patterns
+// (or no include patterns are specified) and none of the exclude patterns
+func directorySyncPathIsIncluded(relativePath string, include []string, exclude []string) bool {
+	for _, pattern := range exclude {
+		if directorySyncGlobMatch(pattern, relativePath) {
+			return false
+		}
+	}
+
+	if len(include) == 0 {
+		return true
+	}
+
+	for _, pattern := range include {
+		if directorySyncGlobMatch(pattern, relativePath) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// directorySyncGlobMatch matches a slash-separated path against a glob pattern, where each segment of the
+// pattern uses the syntax of `path.Match` and a segment of `**` matches zero or more path segments
+func directorySyncGlobMatch(pattern string, name string) bool {
+	return directorySyncGlobMatchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func directorySyncGlobMatchSegments(pattern []string, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			pattern = pattern[1:]
+			if len(pattern) == 0 {
+				return true
+			}
+
+			for i := 0; i <= len(name); i++ {
+				if directorySyncGlobMatchSegments(pattern, name[i:]) {
+					return true
+				}
+			}
+
+			return false
+		}
+
+		if len(name) == 0 {
+			return false
+		}
+
+		if matched, err := path.Match(pattern[0], name[0]); err != nil || !matched {
+			return false
+		}
+
+		pattern = pattern[1:]
+		name = name[1:]
+	}
+
+	return len(name) == 0
+}
+
+// directorySyncContentType returns the Content Type for the file at the relative path, preferring any
+// overrides for the file extension before falling back to the well-known type for the extension
+func directorySyncContentType(relativePath string, overrides map[string]string) string {
+	extension := strings.ToLower(path.Ext(relativePath))
+	if extension == "" {
+		return "application/octet-stream"
+	}
+
+	for k, v := range overrides {
+		if strings.EqualFold(strings.TrimPrefix(k, "."), strings.TrimPrefix(extension, ".")) {
+			return v
+		}
+	}
+
+	if contentType := mime.TypeByExtension(extension); contentType != "" {
+		return contentType
+	}
+
+	return "application/octet-stream"
+}
+
+// directorySyncDirectories returns each of the directories (including parent directories) containing the
+// specified relative file paths, ordered so that parent directories are listed before their children
+func directorySyncDirectories(relativePaths []string) []string {
+	unique := make(map[string]struct{})
+	for _, relativePath := range relativePaths {
+		for dir := path.Dir(relativePath); dir != "." && dir != "/"; dir = path.Dir(dir) {
+			unique[dir] = struct{}{}
+		}
+	}
+
+	directories := make([]string, 0, len(unique))
+	for dir := range unique {
+		directories = append(directories, dir)
+	}
+
+	sort.Slice(directories, func(i, j int) bool {
+		depthI := strings.Count(directories[i], "/")
+		depthJ := strings.Count(directories[j], "/")
+		if depthI != depthJ {
+			return depthI < depthJ
+		}
+		return directories[i] < directories[j]
+	})
+
+	return directories
+}
+
+// directorySyncDestinationPath returns the path of the file within the Storage Container or Storage Share, which is
+// the path relative to `source_directory` within the `destination_prefix` (when specified)
+func directorySyncDestinationPath(destinationPrefix string, relativePath string) string {
+	if destinationPrefix == "" {
+		return relativePath
+	}
+
+	return path.Join(destinationPrefix, relativePath)
+}
+
+// directorySyncContentHashFromMetaData returns the hash stored in the MetaData of a synchronised file, which is
+// empty when the file wasn't uploaded by this resource
+func directorySyncContentHashFromMetaData(metaData map[string]string) string {
+	for k, v := range metaData {
+		if strings.EqualFold(k, directorySyncContentHashMetaDataKey) {
+			return v
+		}
+	}
+
+	return ""
+}
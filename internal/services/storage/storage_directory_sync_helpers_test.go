@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestDirectorySyncGlobMatch(t *testing.T) {
+	testcases := []struct {
+		Pattern  string
+		Name     string
+		Expected bool
+	}{
+		{Pattern: "*.html", Name: "index.html", Expected: true},
+		{Pattern: "*.html", Name: "docs/index.html", Expected: false},
+		{Pattern: "**/*.html", Name: "index.html", Expected: true},
+		{Pattern: "**/*.html", Name: "docs/guides/index.html", Expected: true},
+		{Pattern: "docs/**", Name: "docs/guides/index.html", Expected: true},
+		{Pattern: "docs/**", Name: "images/logo.png", Expected: false},
+		{Pattern: "docs/**/index.html", Name: "docs/index.html", Expected: true},
+		{Pattern: "images/*.png", Name: "images/logo.png", Expected: true},
+		{Pattern: "images/*.png", Name: "images/icons/logo.png", Expected: false},
+		{Pattern: ".git/**", Name: ".gitignore", Expected: false},
+	}
+
+	for _, tc := range testcases {
+		t.Logf("[DEBUG] Testing %q against %q", tc.Pattern, tc.Name)
+
+		if actual := directorySyncGlobMatch(tc.Pattern, tc.Name); actual != tc.Expected {
+			t.Fatalf("expected %t but got %t", tc.Expected, actual)
+		}
+	}
+}
+
+func TestDirectorySyncContentType(t *testing.T) {
+	testcases := []struct {
+		Name      string
+		Overrides map[string]string
+		Expected  string
+	}{
+		{Name: "LICENSE", Expected: "application/octet-stream"},
+		{Name: "data.unknownextension", Expected: "application/octet-stream"},
+		{Name: "styles/site.css", Expected: "text/css; charset=utf-8"},
+		{Name: "index.HTML", Overrides: map[string]string{".html": "text/html"}, Expected: "text/html"},
+		{Name: "data.unknownextension", Overrides: map[string]string{"unknownextension": "text/plain"}, Expected: "text/plain"},
+	}
+
+	for _, tc := range testcases {
+		t.Logf("[DEBUG] Testing %q", tc.Name)
+
+		if actual := directorySyncContentType(tc.Name, tc.Overrides); actual != tc.Expected {
+			t.Fatalf("expected %q but got %q", tc.Expected, actual)
+		}
+	}
+}
+
+func TestDirectorySyncDirectories(t *testing.T) {
+	actual := directorySyncDirectories([]string{"index.html", "docs/guides/setup.html", "docs/index.html", "images/logo.png"})
+	expected := []string{"docs", "images", "docs/guides"}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestDirectorySyncDestinationPath(t *testing.T) {
+	testCases := []struct {
+		DestinationPrefix string
+		RelativePath      string
+		Expected          string
+	}{
+		{
+			RelativePath: "index.html",
+			Expected:     "index.html",
+		},
+		{
+			RelativePath: "docs/index.html",
+			Expected:     "docs/index.html",
+		},
+		{
+			DestinationPrefix: "site",
+			RelativePath:      "index.html",
+			Expected:          "site/index.html",
+		},
+		{
+			DestinationPrefix: "releases/v1",
+			RelativePath:      "docs/index.html",
+			Expected:          "releases/v1/docs/index.html",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q within %q..", tc.RelativePath, tc.DestinationPrefix)
+
+		if actual := directorySyncDestinationPath(tc.DestinationPrefix, tc.RelativePath); actual != tc.Expected {
+			t.Fatalf("expected %q but got %q", tc.Expected, actual)
+		}
+	}
+}
+
+func TestDirectorySyncContentHashFromMetaData(t *testing.T) {
+	testCases := []struct {
+		MetaData map[string]string
+		Expected string
+	}{
+		{
+			MetaData: nil,
+			Expected: "",
+		},
+		{
+			MetaData: map[string]string{
+				"owner": "example",
+			},
+			Expected: "",
+		},
+		{
+			MetaData: map[string]string{
+				"sha256": "abc123",
+			},
+			Expected: "abc123",
+		},
+		{
+			MetaData: map[string]string{
+				"Sha256": "abc123",
+			},
+			Expected: "abc123",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %+v..", tc.MetaData)
+
+		if actual := directorySyncContentHashFromMetaData(tc.MetaData); actual != tc.Expected {
+			t.Fatalf("expected %q but got %q", tc.Expected, actual)
+		}
+	}
+}
+
+func TestDirectorySyncManifest(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"index.html":        "<html></html>",
+		"docs/index.html":   "<html></html>",
+		"docs/draft.html":   "<html>draft</html>",
+		"images/logo.png":   "png",
+		".git/HEAD":         "ref: refs/heads/main",
+		"images/readme.txt": "images",
+	} {
+		filePath := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+			t.Fatalf("creating directory for %q: %+v", name, err)
+		}
+		if err := os.WriteFile(filePath, []byte(content), 0o600); err != nil {
+			t.Fatalf("writing %q: %+v", name, err)
+		}
+	}
+
+	manifest, err := directorySyncManifest(dir, []string{"**/*.html", "images/*.png"}, []string{".git/**", "**/draft.html"})
+	if err != nil {
+		t.Fatalf("building manifest: %+v", err)
+	}
+
+	expected := map[string]string{
+		"index.html":      contentSHA256FromString("<html></html>"),
+		"docs/index.html": contentSHA256FromString("<html></html>"),
+		"images/logo.png": contentSHA256FromString("png"),
+	}
+	if !reflect.DeepEqual(manifest, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, manifest)
+	}
+}
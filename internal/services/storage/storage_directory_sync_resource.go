@@ -0,0 +1,707 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/fileshares"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/blobs"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/file/directories"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/file/files"
+)
+
+// directorySyncContentHashMetaDataKey is the MetaData key used to store the SHA256 hash of each file which has been
+// synchronised, allowing the `files` manifest to be refreshed from the Storage Container or Storage Share
+const directorySyncContentHashMetaDataKey = "sha256"
+
+type StorageDirectorySyncResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = StorageDirectorySyncResource{}
+	_ sdk.ResourceWithCustomizeDiff = StorageDirectorySyncResource{}
+)
+
+type StorageDirectorySyncResourceModel struct {
+	SourceDirectory    string            `tfschema:"source_directory"`
+	StorageContainerId string            `tfschema:"storage_container_id"`
+	StorageShareId     string            `tfschema:"storage_share_id"`
+	DestinationPrefix  string            `tfschema:"destination_prefix"`
+	ContentTypes       map[string]string `tfschema:"content_types"`
+	Exclude            []string          `tfschema:"exclude"`
+	Include            []string          `tfschema:"include"`
+	Parallelism        int64             `tfschema:"parallelism"`
+	Files              map[string]string `tfschema:"files"`
+}
+
+func (r StorageDirectorySyncResource) ResourceType() string {
+	return "azurerm_storage_directory_sync"
+}
+
+func (r StorageDirectorySyncResource) ModelObject() interface{} {
+	return &StorageDirectorySyncResourceModel{}
+}
+
+func (r StorageDirectorySyncResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageDirectorySyncID
+}
+
+func (r StorageDirectorySyncResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"source_directory": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"storage_container_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateStorageContainerID,
+			ExactlyOneOf: []string{"storage_container_id", "storage_share_id"},
+		},
+
+		"storage_share_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: fileshares.ValidateShareID,
+			ExactlyOneOf: []string{"storage_container_id", "storage_share_id"},
+		},
+
+		"destination_prefix": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.StorageDirectorySyncDestinationPrefix,
+		},
+
+		"content_types": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"exclude": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.StorageDirectorySyncGlob,
+			},
+		},
+
+		"include": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validate.StorageDirectorySyncGlob,
+			},
+		},
+
+		"parallelism": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      8,
+			ValidateFunc: validation.IntBetween(1, 64),
+		},
+	}
+}
+
+func (r StorageDirectorySyncResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"files": {
+			Type:     pluginsdk.TypeMap,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r StorageDirectorySyncResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			diff := metadata.ResourceDiff
+
+			if !diff.NewValueKnown("source_directory") || !diff.NewValueKnown("include") || !diff.NewValueKnown("exclude") {
+				return diff.SetNewComputed("files")
+			}
+
+			sourceDirectory := diff.Get("source_directory").(string)
+			if _, err := os.Stat(sourceDirectory); err != nil {
+				if os.IsNotExist(err) {
+					// the directory may be created during the apply
+					log.Printf("[DEBUG] Unable to locate `source_directory` %q - the files to be synchronised will be determined during the apply", sourceDirectory)
+					return diff.SetNewComputed("files")
+				}
+				return fmt.Errorf("retrieving information for `source_directory` %q: %+v", sourceDirectory, err)
+			}
+
+			manifest, err := directorySyncManifest(sourceDirectory, expandDirectorySyncPatterns(diff.Get("include").([]interface{})), expandDirectorySyncPatterns(diff.Get("exclude").([]interface{})))
+			if err != nil {
+				return fmt.Errorf("building the list of files within `source_directory` %q: %+v", sourceDirectory, err)
+			}
+
+			existing := make(map[string]string)
+			for k, v := range diff.Get("files").(map[string]interface{}) {
+				existing[k] = v.(string)
+			}
+
+			if diff.Id() == "" || !directorySyncManifestsEqual(existing, manifest) {
+				return diff.SetNew("files", manifest)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageDirectorySyncResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model StorageDirectorySyncResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			var id parse.StorageDirectorySyncId
+			if model.StorageContainerId != "" {
+				containerId, err := commonids.ParseStorageContainerID(model.StorageContainerId)
+				if err != nil {
+					return err
+				}
+				id = parse.NewStorageDirectorySyncIDForContainer(*containerId, model.DestinationPrefix)
+			} else {
+				shareId, err := fileshares.ParseShareID(model.StorageShareId)
+				if err != nil {
+					return err
+				}
+				id = parse.NewStorageDirectorySyncIDForShare(*shareId, model.DestinationPrefix)
+			}
+
+			target, err := newDirectorySyncTarget(ctx, metadata, id)
+			if err != nil {
+				return err
+			}
+
+			manifest, err := directorySyncManifest(model.SourceDirectory, model.Include, model.Exclude)
+			if err != nil {
+				return fmt.Errorf("building the list of files within `source_directory` %q: %+v", model.SourceDirectory, err)
+			}
+
+			// the files are synchronised to the destination, so any which already exist there need to be imported
+			existing, err := target.existing(ctx, sortedDirectorySyncPaths(manifest), int(model.Parallelism))
+			if err != nil {
+				return fmt.Errorf("checking for existing files within %s: %+v", id, err)
+			}
+			if len(existing) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := target.upload(ctx, model, manifest, sortedDirectorySyncPaths(manifest)); err != nil {
+				return fmt.Errorf("synchronising `source_directory` %q to %s: %+v", model.SourceDirectory, id, err)
+			}
+
+			metadata.SetID(id)
+
+			model.Files = manifest
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r StorageDirectorySyncResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			storageClient := metadata.Client.Storage
+
+			var state StorageDirectorySyncResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := parse.StorageDirectorySyncID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if id.StorageContainerId != nil {
+				resp, err := storageClient.ResourceManager.BlobContainers.Get(ctx, *id.StorageContainerId)
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return metadata.MarkAsGone(id)
+					}
+					return fmt.Errorf("retrieving %s: %+v", *id.StorageContainerId, err)
+				}
+				state.StorageContainerId = id.StorageContainerId.ID()
+			} else {
+				resp, err := storageClient.ResourceManager.FileShares.Get(ctx, *id.StorageShareId, fileshares.DefaultGetOperationOptions())
+				if err != nil {
+					if response.WasNotFound(resp.HttpResponse) {
+						return metadata.MarkAsGone(id)
+					}
+					return fmt.Errorf("retrieving %s: %+v", *id.StorageShareId, err)
+				}
+				state.StorageShareId = id.StorageShareId.ID()
+			}
+			state.DestinationPrefix = id.DestinationPrefix
+
+			target, err := newDirectorySyncTarget(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+
+			// files which have been removed or changed outside of Terraform are refreshed from the hash stored in
+			// their MetaData, so that these are synchronised again during the next apply
+			parallelism := state.Parallelism
+			if parallelism == 0 {
+				parallelism = 8
+			}
+			files, err := target.existing(ctx, sortedDirectorySyncPaths(state.Files), int(parallelism))
+			if err != nil {
+				return fmt.Errorf("retrieving the synchronised files within %s: %+v", id, err)
+			}
+			state.Files = files
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageDirectorySyncResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model StorageDirectorySyncResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := parse.StorageDirectorySyncID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			target, err := newDirectorySyncTarget(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+
+			manifest, err := directorySyncManifest(model.SourceDirectory, model.Include, model.Exclude)
+			if err != nil {
+				return fmt.Errorf("building the list of files within `source_directory` %q: %+v", model.SourceDirectory, err)
+			}
+
+			oldFilesRaw, _ := metadata.ResourceData.GetChange("files")
+			existing := make(map[string]string)
+			for k, v := range oldFilesRaw.(map[string]interface{}) {
+				existing[k] = v.(string)
+			}
+
+			// all files are uploaded again when the Content Types change, since these are set on upload
+			reuploadAll := metadata.ResourceData.HasChange("content_types")
+
+			toUpload := make([]string, 0)
+			for _, relativePath := range sortedDirectorySyncPaths(manifest) {
+				if hash, ok := existing[relativePath]; reuploadAll || !ok || hash != manifest[relativePath] {
+					toUpload = append(toUpload, relativePath)
+				}
+			}
+
+			toDelete := make([]string, 0)
+			for _, relativePath := range sortedDirectorySyncPaths(existing) {
+				if _, ok := manifest[relativePath]; !ok {
+					toDelete = append(toDelete, relativePath)
+				}
+			}
+
+			log.Printf("[DEBUG] Synchronising `source_directory` %q to %s: uploading %d and deleting %d files", model.SourceDirectory, id, len(toUpload), len(toDelete))
+
+			if err := target.upload(ctx, model, manifest, toUpload); err != nil {
+				return fmt.Errorf("synchronising `source_directory` %q to %s: %+v", model.SourceDirectory, id, err)
+			}
+
+			if err := target.delete(ctx, toDelete); err != nil {
+				return fmt.Errorf("removing deleted files from %s: %+v", id, err)
+			}
+
+			model.Files = manifest
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r StorageDirectorySyncResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model StorageDirectorySyncResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := parse.StorageDirectorySyncID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			target, err := newDirectorySyncTarget(ctx, metadata, *id)
+			if err != nil {
+				return err
+			}
+
+			if err := target.delete(ctx, sortedDirectorySyncPaths(model.Files)); err != nil {
+				return fmt.Errorf("deleting synchronised files from %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// directorySyncTarget uploads files to and deletes files from either a Storage Container or a Storage Share, where
+// the path of each file within the destination is its path relative to `source_directory` within `destinationPrefix`
+type directorySyncTarget struct {
+	destinationPrefix string
+
+	containerName string
+	blobsClient   *blobs.Client
+
+	shareName         string
+	directoriesClient *directories.Client
+	filesClient       *files.Client
+}
+
+func newDirectorySyncTarget(ctx context.Context, metadata sdk.ResourceMetaData, id parse.StorageDirectorySyncId) (*directorySyncTarget, error) {
+	storageClient := metadata.Client.Storage
+
+	if containerId := id.StorageContainerId; containerId != nil {
+		account, err := storageClient.GetAccount(ctx, commonids.NewStorageAccountID(containerId.SubscriptionId, containerId.ResourceGroupName, containerId.StorageAccountName))
+		if err != nil {
+			return nil, fmt.Errorf("retrieving Account for %s: %+v", *containerId, err)
+		}
+		if account == nil {
+			return nil, fmt.Errorf("locating Storage Account for %s", *containerId)
+		}
+
+		blobsClient, err := storageClient.BlobsDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+		if err != nil {
+			return nil, fmt.Errorf("building Blobs Client: %+v", err)
+		}
+
+		return &directorySyncTarget{
+			destinationPrefix: id.DestinationPrefix,
+			containerName:     containerId.ContainerName,
+			blobsClient:       blobsClient,
+		}, nil
+	}
+
+	shareId := id.StorageShareId
+	if shareId == nil {
+		return nil, fmt.Errorf("either a Storage Container or a Storage Share must be specified in %s", id)
+	}
+
+	account, err := storageClient.GetAccount(ctx, commonids.NewStorageAccountID(shareId.SubscriptionId, shareId.ResourceGroupName, shareId.StorageAccountName))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account for %s: %+v", *shareId, err)
+	}
+	if account == nil {
+		return nil, fmt.Errorf("locating Storage Account for %s", *shareId)
+	}
+
+	directoriesClient, err := storageClient.FileShareDirectoriesDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, fmt.Errorf("building File Share Directories Client: %+v", err)
+	}
+
+	filesClient, err := storageClient.FileShareFilesDataPlaneClient(ctx, *account, storageClient.DataPlaneOperationSupportingAnyAuthMethod())
+	if err != nil {
+		return nil, fmt.Errorf("building File Share Files Client: %+v", err)
+	}
+
+	return &directorySyncTarget{
+		destinationPrefix: id.DestinationPrefix,
+		shareName:         shareId.ShareName,
+		directoriesClient: directoriesClient,
+		filesClient:       filesClient,
+	}, nil
+}
+
+// destinationPath returns the path of the file within the Storage Container or Storage Share
+func (t directorySyncTarget) destinationPath(relativePath string) string {
+	return directorySyncDestinationPath(t.destinationPrefix, relativePath)
+}
+
+// existing returns the hash stored in the MetaData of each of the files which exist within the Storage Container or
+// Storage Share - where the hash is empty when the file wasn't uploaded by this resource
+func (t directorySyncTarget) existing(ctx context.Context, relativePaths []string, workerCount int) (map[string]string, error) {
+	result := make(map[string]string)
+	if len(relativePaths) == 0 {
+		return result, nil
+	}
+
+	var mutex sync.Mutex
+	err := t.forEach(relativePaths, workerCount, func(relativePath string) error {
+		destinationPath := t.destinationPath(relativePath)
+
+		var metaData map[string]string
+		if t.blobsClient != nil {
+			resp, err := t.blobsClient.GetProperties(ctx, t.containerName, destinationPath, blobs.GetPropertiesInput{})
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %q: %+v", destinationPath, err)
+			}
+			metaData = resp.MetaData
+		} else {
+			dir, fileName := directorySyncSplitPath(destinationPath)
+			resp, err := t.filesClient.GetProperties(ctx, t.shareName, dir, fileName)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("retrieving %q: %+v", destinationPath, err)
+			}
+			metaData = resp.MetaData
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		result[relativePath] = directorySyncContentHashFromMetaData(metaData)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (t directorySyncTarget) upload(ctx context.Context, model StorageDirectorySyncResourceModel, manifest map[string]string, relativePaths []string) error {
+	if len(relativePaths) == 0 {
+		return nil
+	}
+
+	// the parent directories of each file must exist within a Storage Share prior to the file being created
+	if t.filesClient != nil {
+		destinationPaths := make([]string, 0, len(relativePaths))
+		for _, relativePath := range relativePaths {
+			destinationPaths = append(destinationPaths, t.destinationPath(relativePath))
+		}
+
+		for _, dir := range directorySyncDirectories(destinationPaths) {
+			if resp, err := t.directoriesClient.Create(ctx, t.shareName, dir, directories.CreateDirectoryInput{}); err != nil && !response.WasConflict(resp.HttpResponse) {
+				return fmt.Errorf("creating directory %q: %+v", dir, err)
+			}
+		}
+	}
+
+	return t.forEach(relativePaths, int(model.Parallelism), func(relativePath string) error {
+		sourcePath := filepath.Join(model.SourceDirectory, filepath.FromSlash(relativePath))
+		destinationPath := t.destinationPath(relativePath)
+		contentType := directorySyncContentType(relativePath, model.ContentTypes)
+		metaData := map[string]string{
+			directorySyncContentHashMetaDataKey: manifest[relativePath],
+		}
+
+		if t.blobsClient != nil {
+			upload := BlobUpload{
+				Client:        t.blobsClient,
+				BlobName:      destinationPath,
+				ContainerName: t.containerName,
+				BlobType:      "block",
+				ContentType:   contentType,
+				MetaData:      metaData,
+				Parallelism:   1,
+				Source:        sourcePath,
+			}
+			if err := upload.Create(ctx); err != nil {
+				return fmt.Errorf("uploading %q: %+v", relativePath, err)
+			}
+			return nil
+		}
+
+		file, err := os.Open(sourcePath)
+		if err != nil {
+			return fmt.Errorf("opening %q: %+v", sourcePath, err)
+		}
+		defer file.Close()
+
+		info, err := file.Stat()
+		if err != nil {
+			return fmt.Errorf("retrieving information for %q: %+v", sourcePath, err)
+		}
+
+		dir, fileName := directorySyncSplitPath(destinationPath)
+		input := files.CreateInput{
+			ContentLength: info.Size(),
+			ContentType:   pointer.To(contentType),
+			MetaData:      metaData,
+		}
+		if _, err := t.filesClient.Create(ctx, t.shareName, dir, fileName, input); err != nil {
+			return fmt.Errorf("creating %q: %+v", relativePath, err)
+		}
+
+		if info.Size() > 0 {
+			if err := t.filesClient.PutFile(ctx, t.shareName, dir, fileName, file, 4); err != nil {
+				return fmt.Errorf("uploading %q: %+v", relativePath, err)
+			}
+		}
+
+		return nil
+	})
+}
+
+func (t directorySyncTarget) delete(ctx context.Context, relativePaths []string) error {
+	if len(relativePaths) == 0 {
+		return nil
+	}
+
+	err := t.forEach(relativePaths, 8, func(relativePath string) error {
+		destinationPath := t.destinationPath(relativePath)
+
+		if t.blobsClient != nil {
+			if resp, err := t.blobsClient.Delete(ctx, t.containerName, destinationPath, blobs.DeleteInput{DeleteSnapshots: true}); err != nil && !response.WasNotFound(resp.HttpResponse) {
+				return fmt.Errorf("deleting %q: %+v", relativePath, err)
+			}
+			return nil
+		}
+
+		dir, fileName := directorySyncSplitPath(destinationPath)
+		if resp, err := t.filesClient.Delete(ctx, t.shareName, dir, fileName); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("deleting %q: %+v", relativePath, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// directories within a Storage Share are removed once empty, deepest first - directories which still
+	// contain other files are left in place
+	if t.filesClient != nil {
+		destinationPaths := make([]string, 0, len(relativePaths))
+		for _, relativePath := range relativePaths {
+			destinationPaths = append(destinationPaths, t.destinationPath(relativePath))
+		}
+
+		dirs := directorySyncDirectories(destinationPaths)
+		for i := len(dirs) - 1; i >= 0; i-- {
+			if resp, err := t.directoriesClient.Delete(ctx, t.shareName, dirs[i]); err != nil && !response.WasNotFound(resp.HttpResponse) && !response.WasConflict(resp.HttpResponse) {
+				return fmt.Errorf("deleting directory %q: %+v", dirs[i], err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// forEach runs the function for each of the relative paths using the specified number of workers,
+// returning the errors from any failed invocations
+func (t directorySyncTarget) forEach(relativePaths []string, workerCount int, f func(relativePath string) error) error {
+	if workerCount > len(relativePaths) {
+		workerCount = len(relativePaths)
+	}
+
+	paths := make(chan string, len(relativePaths))
+	for _, relativePath := range relativePaths {
+		paths <- relativePath
+	}
+	close(paths)
+
+	errs := make(chan error, len(relativePaths))
+	wg := &sync.WaitGroup{}
+	wg.Add(workerCount)
+
+	for i := 0; i < workerCount; i++ {
+		go func() {
+			defer wg.Done()
+			for relativePath := range paths {
+				if err := f(relativePath); err != nil {
+					errs <- err
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	var result []error
+	for err := range errs {
+		result = append(result, err)
+	}
+
+	return errors.Join(result...)
+}
+
+// directorySyncSplitPath returns the directory (which is empty for the root of the Storage Share) and file name
+func directorySyncSplitPath(relativePath string) (string, string) {
+	dir, fileName := path.Split(relativePath)
+	return strings.TrimSuffix(dir, "/"), fileName
+}
+
+func directorySyncManifestsEqual(first map[string]string, second map[string]string) bool {
+	if len(first) != len(second) {
+		return false
+	}
+
+	for k, v := range first {
+		if other, ok := second[k]; !ok || other != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sortedDirectorySyncPaths(manifest map[string]string) []string {
+	paths := make([]string, 0, len(manifest))
+	for relativePath := range manifest {
+		paths = append(paths, relativePath)
+	}
+	sort.Strings(paths)
+
+	return paths
+}
+
+func expandDirectorySyncPatterns(input []interface{}) []string {
+	patterns := make([]string, 0)
+	for _, v := range input {
+		if pattern, ok := v.(string); ok && pattern != "" {
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	return patterns
+}
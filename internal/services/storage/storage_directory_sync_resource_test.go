@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/fileshares"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StorageDirectorySyncResource struct{}
+
+func TestAccStorageDirectorySync_container(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_directory_sync", "test")
+	r := StorageDirectorySyncResource{}
+	sourceDirectory := r.populateSourceDirectory(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.container(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("3"),
+				check.That(data.ResourceName).Key("files.index.html").IsSet(),
+				check.That(data.ResourceName).Key("files.docs/guide.html").IsSet(),
+				check.That(data.ResourceName).Key("files.images/logo.svg").IsSet(),
+			),
+		},
+		{
+			PreConfig: func() {
+				r.writeFile(t, sourceDirectory, "index.html", "<html><body>updated</body></html>")
+				r.writeFile(t, sourceDirectory, "docs/new.html", "<html><body>new</body></html>")
+				if err := os.Remove(filepath.Join(sourceDirectory, "images", "logo.svg")); err != nil {
+					t.Fatalf("removing file: %+v", err)
+				}
+			},
+			Config: r.container(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("3"),
+				check.That(data.ResourceName).Key("files.docs/new.html").IsSet(),
+			),
+		},
+	})
+}
+
+func TestAccStorageDirectorySync_containerDestinationPrefix(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_directory_sync", "test")
+	r := StorageDirectorySyncResource{}
+	sourceDirectory := r.populateSourceDirectory(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.containerDestinationPrefix(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("4"),
+			),
+		},
+	})
+}
+
+func TestAccStorageDirectorySync_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_directory_sync", "test")
+	r := StorageDirectorySyncResource{}
+	sourceDirectory := r.populateSourceDirectory(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.containerDestinationPrefix(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(func(data acceptance.TestData) string {
+			return r.requiresImport(data, sourceDirectory)
+		}),
+	})
+}
+
+func TestAccStorageDirectorySync_share(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_directory_sync", "test")
+	r := StorageDirectorySyncResource{}
+	sourceDirectory := r.populateSourceDirectory(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.share(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("4"),
+			),
+		},
+	})
+}
+
+func TestAccStorageDirectorySync_shareDestinationPrefix(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_directory_sync", "test")
+	r := StorageDirectorySyncResource{}
+	sourceDirectory := r.populateSourceDirectory(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.shareDestinationPrefix(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("4"),
+			),
+		},
+	})
+}
+
+func (r StorageDirectorySyncResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageDirectorySyncID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if id.StorageContainerId != nil {
+		resp, err := client.Storage.ResourceManager.BlobContainers.Get(ctx, *id.StorageContainerId)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving %s: %+v", *id.StorageContainerId, err)
+		}
+		return pointer.To(resp.Model != nil), nil
+	}
+
+	resp, err := client.Storage.ResourceManager.FileShares.Get(ctx, *id.StorageShareId, fileshares.DefaultGetOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id.StorageShareId, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r StorageDirectorySyncResource) populateSourceDirectory(t *testing.T) string {
+	dir := t.TempDir()
+	r.writeFile(t, dir, "index.html", "<html><body>index</body></html>")
+	r.writeFile(t, dir, "docs/guide.html", "<html><body>guide</body></html>")
+	r.writeFile(t, dir, "images/logo.svg", "<svg xmlns=\"http://www.w3.org/2000/svg\"></svg>")
+	r.writeFile(t, dir, "notes.md", "# Notes")
+	return dir
+}
+
+func (r StorageDirectorySyncResource) writeFile(t *testing.T, dir string, name string, content string) {
+	filePath := filepath.Join(dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		t.Fatalf("creating directory for %q: %+v", name, err)
+	}
+	if err := os.WriteFile(filePath, []byte(content), 0o600); err != nil {
+		t.Fatalf("writing %q: %+v", name, err)
+	}
+}
+
+func (r StorageDirectorySyncResource) container(data acceptance.TestData, sourceDirectory string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_container" "test" {
+  name               = "acctestcontainer"
+  storage_account_id = azurerm_storage_account.test.id
+}
+
+resource "azurerm_storage_directory_sync" "test" {
+  source_directory     = %q
+  storage_container_id = azurerm_storage_container.test.id
+  include              = ["**/*.html", "**/*.svg"]
+  exclude              = ["drafts/**"]
+
+  content_types = {
+    ".svg" = "image/svg+xml"
+  }
+}
+`, r.template(data), sourceDirectory)
+}
+
+func (r StorageDirectorySyncResource) containerDestinationPrefix(data acceptance.TestData, sourceDirectory string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_container" "test" {
+  name               = "acctestcontainer"
+  storage_account_id = azurerm_storage_account.test.id
+}
+
+resource "azurerm_storage_directory_sync" "test" {
+  source_directory     = %q
+  storage_container_id = azurerm_storage_container.test.id
+  destination_prefix   = "site/v1"
+}
+`, r.template(data), sourceDirectory)
+}
+
+func (r StorageDirectorySyncResource) requiresImport(data acceptance.TestData, sourceDirectory string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_directory_sync" "import" {
+  source_directory     = azurerm_storage_directory_sync.test.source_directory
+  storage_container_id = azurerm_storage_directory_sync.test.storage_container_id
+  destination_prefix   = azurerm_storage_directory_sync.test.destination_prefix
+}
+`, r.containerDestinationPrefix(data, sourceDirectory))
+}
+
+func (r StorageDirectorySyncResource) shareDestinationPrefix(data acceptance.TestData, sourceDirectory string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share" "test" {
+  name               = "acctestshare"
+  storage_account_id = azurerm_storage_account.test.id
+  quota              = 5
+}
+
+resource "azurerm_storage_directory_sync" "test" {
+  source_directory   = %q
+  storage_share_id   = azurerm_storage_share.test.id
+  destination_prefix = "config"
+}
+`, r.template(data), sourceDirectory)
+}
+
+func (r StorageDirectorySyncResource) share(data acceptance.TestData, sourceDirectory string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share" "test" {
+  name               = "acctestshare"
+  storage_account_id = azurerm_storage_account.test.id
+  quota              = 5
+}
+
+resource "azurerm_storage_directory_sync" "test" {
+  source_directory = %q
+  storage_share_id = azurerm_storage_share.test.id
+}
+`, r.template(data), sourceDirectory)
+}
+
+func (r StorageDirectorySyncResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
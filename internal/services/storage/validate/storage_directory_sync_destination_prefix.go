@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StorageDirectorySyncDestinationPrefix validates a slash-separated path within a Storage Container or Storage Share
+func StorageDirectorySyncDestinationPrefix(v interface{}, k string) (warnings []string, errors []error) {
+	input, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return warnings, errors
+	}
+
+	if input == "" {
+		errors = append(errors, fmt.Errorf("%q cannot be an empty string", k))
+		return warnings, errors
+	}
+
+	if strings.Contains(input, "|") || strings.Contains(input, "\\") {
+		errors = append(errors, fmt.Errorf("%q cannot contain `|` or `\\`, got %q", k, input))
+		return warnings, errors
+	}
+
+	for _, segment := range strings.Split(input, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			errors = append(errors, fmt.Errorf("%q must be a path without leading, trailing or consecutive `/` and cannot contain `.` or `..` segments, got %q", k, input))
+			return warnings, errors
+		}
+	}
+
+	return warnings, errors
+}
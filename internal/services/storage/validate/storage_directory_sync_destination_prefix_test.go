@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestStorageDirectorySyncDestinationPrefix(t *testing.T) {
+	testCases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "site",
+			Valid: true,
+		},
+		{
+			Input: "releases/v1.2.3",
+			Valid: true,
+		},
+		{
+			Input: "/site",
+			Valid: false,
+		},
+		{
+			Input: "site/",
+			Valid: false,
+		},
+		{
+			Input: "site//docs",
+			Valid: false,
+		},
+		{
+			Input: "site/../docs",
+			Valid: false,
+		},
+		{
+			Input: "./site",
+			Valid: false,
+		},
+		{
+			Input: "site|docs",
+			Valid: false,
+		},
+		{
+			Input: "site\\docs",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Input)
+		_, errors := StorageDirectorySyncDestinationPrefix(tc.Input, "destination_prefix")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// StorageDirectorySyncGlob validates a slash-separated glob pattern, where each segment uses the syntax of
+// `path.Match` and `**` matches zero or more path segments
+func StorageDirectorySyncGlob(v interface{}, k string) (warnings []string, errors []error) {
+	input, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return warnings, errors
+	}
+
+	if input == "" {
+		errors = append(errors, fmt.Errorf("%q cannot be an empty string", k))
+		return warnings, errors
+	}
+
+	if strings.HasPrefix(input, "/") {
+		errors = append(errors, fmt.Errorf("%q must be relative to the source directory and cannot start with `/`, got %q", k, input))
+		return warnings, errors
+	}
+
+	for _, segment := range strings.Split(input, "/") {
+		if _, err := path.Match(segment, ""); err != nil {
+			errors = append(errors, fmt.Errorf("%q contains an invalid pattern %q: %+v", k, input, err))
+			return warnings, errors
+		}
+	}
+
+	return warnings, errors
+}
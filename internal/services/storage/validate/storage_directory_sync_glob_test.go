@@ -0,0 +1,32 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestStorageDirectorySyncGlob(t *testing.T) {
+	testCases := []struct {
+		input       string
+		shouldError bool
+	}{
+		{"", true},
+		{"*.html", false},
+		{"**/*.js", false},
+		{"assets/**", false},
+		{"images/[a-z]*.png", false},
+		{"/absolute/*.txt", true},
+		{"images/[a-z.png", true},
+	}
+
+	for _, test := range testCases {
+		_, es := StorageDirectorySyncGlob(test.input, "include")
+
+		if test.shouldError && len(es) == 0 {
+			t.Fatalf("Expected validating %q to fail", test.input)
+		}
+		if !test.shouldError && len(es) > 0 {
+			t.Fatalf("Expected validating %q to pass but got: %+v", test.input, es)
+		}
+	}
+}
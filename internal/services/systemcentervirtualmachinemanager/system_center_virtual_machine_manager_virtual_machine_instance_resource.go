@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/virtualmachinetemplates"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/virtualnetworks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/systemcentervirtualmachinemanager/2023-10-07/vmmservers"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/systemcentervirtualmachinemanager/parse"
@@ -66,8 +67,9 @@ type NetworkInterface struct {
 }
 
 type OperatingSystem struct {
-	ComputerName  string `tfschema:"computer_name"`
-	AdminPassword string `tfschema:"admin_password"`
+	ComputerName           string `tfschema:"computer_name"`
+	AdminPassword          string `tfschema:"admin_password"`
+	AdminPasswordWoVersion int64  `tfschema:"admin_password_wo_version"`
 }
 
 type StorageDisk struct {
@@ -260,16 +262,35 @@ func (r SystemCenterVirtualMachineManagerVirtualMachineInstanceResource) Argumen
 						Optional:     true,
 						ForceNew:     true,
 						ValidateFunc: validate.SystemCenterVirtualMachineManagerVirtualMachineInstanceComputerName,
-						AtLeastOneOf: []string{"operating_system.0.computer_name", "operating_system.0.admin_password"},
+						AtLeastOneOf: []string{"operating_system.0.computer_name", "operating_system.0.admin_password", "operating_system.0.admin_password_wo"},
 					},
 
 					"admin_password": {
-						Type:         pluginsdk.TypeString,
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						ForceNew:      true,
+						Sensitive:     true,
+						ValidateFunc:  validation.StringIsNotEmpty,
+						AtLeastOneOf:  []string{"operating_system.0.computer_name", "operating_system.0.admin_password", "operating_system.0.admin_password_wo"},
+						ConflictsWith: []string{"operating_system.0.admin_password_wo"},
+					},
+
+					"admin_password_wo": {
+						Type:          pluginsdk.TypeString,
+						Optional:      true,
+						WriteOnly:     true,
+						ValidateFunc:  validation.StringIsNotEmpty,
+						AtLeastOneOf:  []string{"operating_system.0.computer_name", "operating_system.0.admin_password", "operating_system.0.admin_password_wo"},
+						ConflictsWith: []string{"operating_system.0.admin_password"},
+						RequiredWith:  []string{"operating_system.0.admin_password_wo_version"},
+					},
+
+					"admin_password_wo_version": {
+						Type:         pluginsdk.TypeInt,
 						Optional:     true,
 						ForceNew:     true,
-						Sensitive:    true,
-						ValidateFunc: validation.StringIsNotEmpty,
-						AtLeastOneOf: []string{"operating_system.0.computer_name", "operating_system.0.admin_password"},
+						ValidateFunc: validation.IntAtLeast(1),
+						RequiredWith: []string{"operating_system.0.admin_password_wo"},
 					},
 				},
 			},
@@ -388,6 +409,16 @@ func (r SystemCenterVirtualMachineManagerVirtualMachineInstanceResource) Create(
 				},
 			}
 
+			if len(model.OperatingSystem) > 0 {
+				adminPasswordWo, err := pluginsdk.GetWriteOnly(metadata.ResourceData, "operating_system.0.admin_password_wo", cty.String)
+				if err != nil {
+					return err
+				}
+				if !adminPasswordWo.IsNull() {
+					parameters.Properties.OsProfile.AdminPassword = pointer.To(adminPasswordWo.AsString())
+				}
+			}
+
 			if v := model.NetworkInterfaces; v != nil {
 				parameters.Properties.NetworkProfile = &virtualmachineinstances.NetworkProfile{
 					NetworkInterfaces: expandSystemCenterVirtualMachineManagerVirtualMachineInstanceNetworkInterfacesForCreate(v),
@@ -447,7 +478,7 @@ func (r SystemCenterVirtualMachineManagerVirtualMachineInstanceResource) Read()
 				if props := model.Properties; props != nil {
 					state.Hardware = flattenSystemCenterVirtualMachineManagerVirtualMachineInstanceHardwareProfile(props.HardwareProfile)
 					state.Infrastructure = flattenSystemCenterVirtualMachineManagerVirtualMachineInstanceInfrastructureProfile(props.InfrastructureProfile)
-					state.OperatingSystem = flattenSystemCenterVirtualMachineManagerVirtualMachineInstanceOSProfile(props.OsProfile, metadata.ResourceData.Get("operating_system.0.admin_password").(string))
+					state.OperatingSystem = flattenSystemCenterVirtualMachineManagerVirtualMachineInstanceOSProfile(props.OsProfile, metadata.ResourceData.Get("operating_system.0.admin_password").(string), int64(metadata.ResourceData.Get("operating_system.0.admin_password_wo_version").(int)))
 					state.SystemCenterVirtualMachineManagerAvailabilitySetIds = flattenSystemCenterVirtualMachineManagerVirtualMachineInstanceAvailabilitySets(props.AvailabilitySets)
 
 					if v := props.NetworkProfile; v != nil {
@@ -935,15 +966,16 @@ func flattenSystemCenterVirtualMachineManagerVirtualMachineInstanceNetworkInterf
 	return result
 }
 
-func flattenSystemCenterVirtualMachineManagerVirtualMachineInstanceOSProfile(input *virtualmachineinstances.OsProfileForVMInstance, adminPassword string) []OperatingSystem {
+func flattenSystemCenterVirtualMachineManagerVirtualMachineInstanceOSProfile(input *virtualmachineinstances.OsProfileForVMInstance, adminPassword string, adminPasswordWoVersion int64) []OperatingSystem {
 	result := make([]OperatingSystem, 0)
 	if input == nil {
 		return result
 	}
 
 	return append(result, OperatingSystem{
-		ComputerName:  pointer.From(input.ComputerName),
-		AdminPassword: adminPassword,
+		ComputerName:           pointer.From(input.ComputerName),
+		AdminPassword:          adminPassword,
+		AdminPasswordWoVersion: adminPasswordWoVersion,
 	})
 }
 
@@ -5,14 +5,17 @@ package pluginsdk
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-cty/cty"
 )
 
 // GetWriteOnly gets a write only attribute, checking that it is of an expected type and subsequently returns it
+// attributes nested within a block can be retrieved using their full key, e.g. `os_profile.0.admin_password_wo`
 func GetWriteOnly(d *ResourceData, name string, attributeType cty.Type) (*cty.Value, error) {
-	value, diags := d.GetRawConfigAt(cty.GetAttrPath(name))
+	value, diags := d.GetRawConfigAt(writeOnlyAttributePath(name))
 	if diags.HasError() {
 		return nil, fmt.Errorf("retrieving write-only attribute `%s`: %+v", name, diags)
 	}
@@ -25,7 +28,7 @@ func GetWriteOnly(d *ResourceData, name string, attributeType cty.Type) (*cty.Va
 
 // GetWriteOnlyFromDiff gets a write only attribute from the diff, checking that it is of an expected type and subsequently returns it
 func GetWriteOnlyFromDiff(d *ResourceDiff, name string, attributeType cty.Type) (*cty.Value, error) {
-	value, diags := d.GetRawConfigAt(cty.GetAttrPath(name))
+	value, diags := d.GetRawConfigAt(writeOnlyAttributePath(name))
 	if diags.HasError() {
 		return nil, fmt.Errorf("retrieving write-only attribute `%s`: %+v", name, diags)
 	}
@@ -35,3 +38,15 @@ func GetWriteOnlyFromDiff(d *ResourceDiff, name string, attributeType cty.Type)
 	}
 	return pointer.To(value), nil
 }
+
+func writeOnlyAttributePath(name string) cty.Path {
+	path := cty.Path{}
+	for _, segment := range strings.Split(name, ".") {
+		if index, err := strconv.Atoi(segment); err == nil {
+			path = path.IndexInt(index)
+			continue
+		}
+		path = path.GetAttr(segment)
+	}
+	return path
+}
@@ -4,10 +4,12 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 )
 
@@ -51,16 +53,16 @@ var (
 	versionHeadingRegex = regexp.MustCompile(`## \d*\.\d*\.\d* \(\w* \d{1,2}, \d{4}\)`)
 )
 
-func formatChangelog(path string) error {
+func formatChangelog(path string) (*changelog, error) {
 	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer file.Close()
 
 	bytes, err := os.ReadFile(path)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	content := strings.Split(string(bytes), "\n")
 
@@ -95,10 +97,10 @@ func formatChangelog(path string) error {
 	newContent := rebuildChangelog(changeLog)
 
 	if err := os.WriteFile(path, []byte(strings.Join(newContent, "\n")), 0o644); err != nil {
-		return fmt.Errorf("writing to `%s`", path)
+		return nil, fmt.Errorf("writing to `%s`", path)
 	}
 
-	return nil
+	return changeLog, nil
 }
 
 func determineSectionType(line string) sectionType {
@@ -243,15 +245,193 @@ func rebuildChangelog(changeLog *changelog) []string {
 	return append(newContent, changeLog.post...)
 }
 
+type changeKind string
+
+const (
+	changeKindNew         changeKind = "new"
+	changeKindEnhancement changeKind = "enhancement"
+	changeKindBug         changeKind = "bug"
+	changeKindDeprecation changeKind = "deprecation"
+	changeKindBreaking    changeKind = "breaking"
+)
+
+type objectType string
+
+const (
+	objectTypeAction            objectType = "action"
+	objectTypeDataSource        objectType = "data_source"
+	objectTypeDependency        objectType = "dependency"
+	objectTypeEphemeralResource objectType = "ephemeral_resource"
+	objectTypeFunction          objectType = "function"
+	objectTypeListResource      objectType = "list_resource"
+	objectTypeProvider          objectType = "provider"
+	objectTypeResource          objectType = "resource"
+)
+
+// changelogJSON is the structured changelog for a single release, intended to be consumed by tooling
+type changelogJSON struct {
+	Version string               `json:"version"`
+	Entries []changelogEntryJSON `json:"entries"`
+}
+
+type changelogEntryJSON struct {
+	Kind         changeKind `json:"kind"`
+	ObjectType   objectType `json:"object_type"`
+	Name         string     `json:"name,omitempty"`
+	Attributes   []string   `json:"attributes,omitempty"`
+	PullRequests []int      `json:"pull_requests,omitempty"`
+	Description  string     `json:"description"`
+}
+
+var (
+	unreleasedVersionRegex = regexp.MustCompile(`^## v?([0-9.]+) \(Unreleased\)`)
+	newObjectRegex         = regexp.MustCompile(`^\*\*New ([\w ]+?):?\*\*:? ` + "`([^`]+)`")
+	backtickRegex          = regexp.MustCompile("`([^`]+)`( value)?")
+	attributeRegex         = regexp.MustCompile(`^[a-z][a-z0-9_]*(\.[a-z0-9_]+)*$`)
+	pullRequestRegex       = regexp.MustCompile(`\[(?:GH-|#)(\d+)\]`)
+)
+
+// writeChangelogJSON writes the entries for the release being formatted as a structured JSON changelog. Since this is
+// derived from the changelog entries (rather than the metadata of the pull requests) see the README for its limitations
+func writeChangelogJSON(changeLog *changelog, path string) error {
+	out := changelogJSON{
+		Entries: make([]changelogEntryJSON, 0),
+	}
+
+	for _, line := range changeLog.pre {
+		if matches := unreleasedVersionRegex.FindStringSubmatch(line); len(matches) == 2 {
+			out.Version = matches[1]
+			break
+		}
+	}
+
+	sections := []struct {
+		kind    changeKind
+		entries [][]string
+	}{
+		{kind: changeKindBreaking, entries: [][]string{changeLog.breaking}},
+		{kind: changeKindNew, entries: [][]string{changeLog.features.dataSources, changeLog.features.general}},
+		{kind: changeKindEnhancement, entries: [][]string{changeLog.enhancements.dependencies, changeLog.enhancements.dataSources, changeLog.enhancements.general}},
+		{kind: changeKindBug, entries: [][]string{changeLog.bugs.dataSources, changeLog.bugs.general}},
+	}
+	for _, section := range sections {
+		for _, entries := range section.entries {
+			for _, line := range entries {
+				if entry := parseChangelogEntry(line, section.kind); entry != nil {
+					out.Entries = append(out.Entries, *entry)
+				}
+			}
+		}
+	}
+
+	bytes, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, bytes, 0o644)
+}
+
+// parseChangelogEntry classifies a changelog entry such as "* `azurerm_resource` - add support for the `thing1` property [GH-12345]"
+func parseChangelogEntry(line string, kind changeKind) *changelogEntryJSON {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, "* ") {
+		return nil
+	}
+	description := strings.TrimPrefix(line, "* ")
+
+	entry := &changelogEntryJSON{
+		Kind:         kind,
+		ObjectType:   objectTypeResource,
+		PullRequests: make([]int, 0),
+		Description:  strings.TrimSpace(pullRequestRegex.ReplaceAllString(description, "")),
+	}
+
+	for _, match := range pullRequestRegex.FindAllStringSubmatch(description, -1) {
+		if v, err := strconv.Atoi(match[1]); err == nil && !slices.Contains(entry.PullRequests, v) {
+			entry.PullRequests = append(entry.PullRequests, v)
+		}
+	}
+
+	if matches := newObjectRegex.FindStringSubmatch(description); len(matches) == 3 {
+		entry.Name = matches[2]
+		switch strings.ToLower(matches[1]) {
+		case "action":
+			entry.ObjectType = objectTypeAction
+		case "data source":
+			entry.ObjectType = objectTypeDataSource
+		case "ephemeral resource":
+			entry.ObjectType = objectTypeEphemeralResource
+		case "function", "provider function":
+			entry.ObjectType = objectTypeFunction
+		case "list resource":
+			entry.ObjectType = objectTypeListResource
+		}
+		return entry
+	}
+
+	// anything in the FEATURES section which isn't a new object is an enhancement
+	if kind == changeKindNew {
+		entry.Kind = changeKindEnhancement
+	}
+
+	rest := description
+	switch {
+	case strings.HasPrefix(rest, "dependencies:"):
+		entry.ObjectType = objectTypeDependency
+		rest = strings.TrimPrefix(rest, "dependencies:")
+	case strings.HasPrefix(rest, "Data Source:"):
+		entry.ObjectType = objectTypeDataSource
+		rest = strings.TrimPrefix(rest, "Data Source:")
+	case !strings.HasPrefix(rest, "`"):
+		entry.ObjectType = objectTypeProvider
+	}
+
+	// the name of the object is the first backticked value, any attributes are listed after the separator
+	if entry.ObjectType != objectTypeProvider {
+		if matches := backtickRegex.FindStringSubmatchIndex(rest); matches != nil {
+			entry.Name = rest[matches[2]:matches[3]]
+			rest = rest[matches[1]:]
+		}
+	}
+
+	if entry.ObjectType != objectTypeDependency {
+		for _, match := range backtickRegex.FindAllStringSubmatch(rest, -1) {
+			// values of a property, e.g. "the `none` value for `outbound_type`", aren't attributes
+			if match[2] != "" || strings.HasPrefix(match[1], "azurerm_") || !attributeRegex.MatchString(match[1]) {
+				continue
+			}
+			if !slices.Contains(entry.Attributes, match[1]) {
+				entry.Attributes = append(entry.Attributes, match[1])
+			}
+		}
+	}
+
+	if kind != changeKindBreaking && strings.Contains(strings.ToLower(description), "deprecat") {
+		entry.Kind = changeKindDeprecation
+	}
+
+	return entry
+}
+
 func main() {
-	if len(os.Args) != 2 {
-		fmt.Println("Usage: go run main.go <path to changelog>")
+	if len(os.Args) != 2 && len(os.Args) != 3 {
+		fmt.Println("Usage: go run main.go <path to changelog> [path to write the JSON changelog for the release to]")
 		return
 	}
 	filePath := os.Args[1]
 
-	if err := formatChangelog(filePath); err != nil {
+	changeLog, err := formatChangelog(filePath)
+	if err != nil {
 		fmt.Println(fmt.Errorf("formatting changelog: %+v", err))
 		return
 	}
+
+	if len(os.Args) == 3 {
+		jsonPath := os.Args[2]
+		if err := writeChangelogJSON(changeLog, jsonPath); err != nil {
+			fmt.Println(fmt.Errorf("writing JSON changelog to `%s`: %+v", jsonPath, err))
+			return
+		}
+	}
 }
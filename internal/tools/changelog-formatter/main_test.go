@@ -0,0 +1,346 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseChangelogEntry(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Line     string
+		Kind     changeKind
+		Expected *changelogEntryJSON
+	}{
+		{
+			Name:     "empty line",
+			Line:     "",
+			Kind:     changeKindEnhancement,
+			Expected: nil,
+		},
+		{
+			Name:     "not a list item",
+			Line:     "`azurerm_resource_group` - support for the `managed_by` property [GH-12345]",
+			Kind:     changeKindEnhancement,
+			Expected: nil,
+		},
+		{
+			Name:     "sub heading",
+			Line:     "### Notes",
+			Kind:     changeKindBug,
+			Expected: nil,
+		},
+		{
+			Name: "new resource",
+			Line: "* **New Resource:** `azurerm_example` [GH-12345]",
+			Kind: changeKindNew,
+			Expected: &changelogEntryJSON{
+				Kind:         changeKindNew,
+				ObjectType:   objectTypeResource,
+				Name:         "azurerm_example",
+				PullRequests: []int{12345},
+				Description:  "**New Resource:** `azurerm_example`",
+			},
+		},
+		{
+			Name: "new data source",
+			Line: "* **New Data Source**: `azurerm_example` [#12345]",
+			Kind: changeKindNew,
+			Expected: &changelogEntryJSON{
+				Kind:         changeKindNew,
+				ObjectType:   objectTypeDataSource,
+				Name:         "azurerm_example",
+				PullRequests: []int{12345},
+				Description:  "**New Data Source**: `azurerm_example`",
+			},
+		},
+		{
+			Name: "new list resource",
+			Line: "* **New List Resource:** `azurerm_example` [GH-12345]",
+			Kind: changeKindNew,
+			Expected: &changelogEntryJSON{
+				Kind:         changeKindNew,
+				ObjectType:   objectTypeListResource,
+				Name:         "azurerm_example",
+				PullRequests: []int{12345},
+				Description:  "**New List Resource:** `azurerm_example`",
+			},
+		},
+		{
+			Name: "new provider function",
+			Line: "* **New Provider Function:** `normalise_resource_id` [GH-12345]",
+			Kind: changeKindNew,
+			Expected: &changelogEntryJSON{
+				Kind:         changeKindNew,
+				ObjectType:   objectTypeFunction,
+				Name:         "normalise_resource_id",
+				PullRequests: []int{12345},
+				Description:  "**New Provider Function:** `normalise_resource_id`",
+			},
+		},
+		{
+			Name: "feature which isn't a new object",
+			Line: "* `azurerm_example` - support for the `sku` property [GH-12345]",
+			Kind: changeKindNew,
+			Expected: &changelogEntryJSON{
+				Kind:         changeKindEnhancement,
+				ObjectType:   objectTypeResource,
+				Name:         "azurerm_example",
+				Attributes:   []string{"sku"},
+				PullRequests: []int{12345},
+				Description:  "`azurerm_example` - support for the `sku` property",
+			},
+		},
+		{
+			Name: "enhancement with nested and duplicate attributes",
+			Line: "* `azurerm_kubernetes_cluster` - support for the `default_node_pool.max_surge` and `tags` properties, `tags` can now be updated [GH-123] [#456] [GH-123]",
+			Kind: changeKindEnhancement,
+			Expected: &changelogEntryJSON{
+				Kind:         changeKindEnhancement,
+				ObjectType:   objectTypeResource,
+				Name:         "azurerm_kubernetes_cluster",
+				Attributes:   []string{"default_node_pool.max_surge", "tags"},
+				PullRequests: []int{123, 456},
+				Description:  "`azurerm_kubernetes_cluster` - support for the `default_node_pool.max_surge` and `tags` properties, `tags` can now be updated",
+			},
+		},
+		{
+			Name: "values and other resources aren't attributes",
+			Line: "* `azurerm_kubernetes_cluster` - support for the `none` value for `outbound_type`, as used by `azurerm_kubernetes_cluster_node_pool` [GH-12345]",
+			Kind: changeKindEnhancement,
+			Expected: &changelogEntryJSON{
+				Kind:         changeKindEnhancement,
+				ObjectType:   objectTypeResource,
+				Name:         "azurerm_kubernetes_cluster",
+				Attributes:   []string{"outbound_type"},
+				PullRequests: []int{12345},
+				Description:  "`azurerm_kubernetes_cluster` - support for the `none` value for `outbound_type`, as used by `azurerm_kubernetes_cluster_node_pool`",
+			},
+		},
+		{
+			Name: "data source",
+			Line: "* Data Source: `azurerm_example` - export the `identity` block [GH-12345]",
+			Kind: changeKindBug,
+			Expected: &changelogEntryJSON{
+				Kind:         changeKindBug,
+				ObjectType:   objectTypeDataSource,
+				Name:         "azurerm_example",
+				Attributes:   []string{"identity"},
+				PullRequests: []int{12345},
+				Description:  "Data Source: `azurerm_example` - export the `identity` block",
+			},
+		},
+		{
+			Name: "dependency",
+			Line: "* dependencies: `go-azure-sdk` - update to `v0.20250101.1077201` [GH-12345]",
+			Kind: changeKindEnhancement,
+			Expected: &changelogEntryJSON{
+				Kind:         changeKindEnhancement,
+				ObjectType:   objectTypeDependency,
+				Name:         "go-azure-sdk",
+				PullRequests: []int{12345},
+				Description:  "dependencies: `go-azure-sdk` - update to `v0.20250101.1077201`",
+			},
+		},
+		{
+			Name: "provider",
+			Line: "* Provider: support for the `ARM_USE_CLI` environment variable [GH-12345]",
+			Kind: changeKindEnhancement,
+			Expected: &changelogEntryJSON{
+				Kind:         changeKindEnhancement,
+				ObjectType:   objectTypeProvider,
+				PullRequests: []int{12345},
+				Description:  "Provider: support for the `ARM_USE_CLI` environment variable",
+			},
+		},
+		{
+			Name: "deprecation",
+			Line: "* `azurerm_example` - the `legacy_setting` property has been deprecated in favour of `setting` [GH-12345]",
+			Kind: changeKindEnhancement,
+			Expected: &changelogEntryJSON{
+				Kind:         changeKindDeprecation,
+				ObjectType:   objectTypeResource,
+				Name:         "azurerm_example",
+				Attributes:   []string{"legacy_setting", "setting"},
+				PullRequests: []int{12345},
+				Description:  "`azurerm_example` - the `legacy_setting` property has been deprecated in favour of `setting`",
+			},
+		},
+		{
+			Name: "breaking deprecation remains breaking",
+			Line: "* `azurerm_example` - the deprecated `legacy_setting` property has been removed [GH-12345]",
+			Kind: changeKindBreaking,
+			Expected: &changelogEntryJSON{
+				Kind:         changeKindBreaking,
+				ObjectType:   objectTypeResource,
+				Name:         "azurerm_example",
+				Attributes:   []string{"legacy_setting"},
+				PullRequests: []int{12345},
+				Description:  "`azurerm_example` - the deprecated `legacy_setting` property has been removed",
+			},
+		},
+		{
+			Name: "malformed pull request and unterminated backtick",
+			Line: "* `azurerm_example` - fix a crash when `sku is omitted [GH-abc]",
+			Kind: changeKindBug,
+			Expected: &changelogEntryJSON{
+				Kind:         changeKindBug,
+				ObjectType:   objectTypeResource,
+				Name:         "azurerm_example",
+				PullRequests: []int{},
+				Description:  "`azurerm_example` - fix a crash when `sku is omitted [GH-abc]",
+			},
+		},
+		{
+			Name: "no object name",
+			Line: "*  fix a crash during the plan  ",
+			Kind: changeKindBug,
+			Expected: &changelogEntryJSON{
+				Kind:         changeKindBug,
+				ObjectType:   objectTypeProvider,
+				PullRequests: []int{},
+				Description:  "fix a crash during the plan",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		actual := parseChangelogEntry(tc.Line, tc.Kind)
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
+
+func TestWriteChangelogJSON(t *testing.T) {
+	testCases := []struct {
+		Name      string
+		Changelog *changelog
+		Expected  changelogJSON
+	}{
+		{
+			Name: "no entries",
+			Changelog: &changelog{
+				pre: []string{"## 4.50.0 (Unreleased)", ""},
+			},
+			Expected: changelogJSON{
+				Version: "4.50.0",
+				Entries: []changelogEntryJSON{},
+			},
+		},
+		{
+			Name: "no unreleased version",
+			Changelog: &changelog{
+				pre: []string{"## 4.49.0 (October 16, 2026)", ""},
+				bugs: bugs{
+					general: []string{"* `azurerm_example` - fix a crash [GH-3]"},
+				},
+			},
+			Expected: changelogJSON{
+				Entries: []changelogEntryJSON{
+					{
+						Kind:         changeKindBug,
+						ObjectType:   objectTypeResource,
+						Name:         "azurerm_example",
+						PullRequests: []int{3},
+						Description:  "`azurerm_example` - fix a crash",
+					},
+				},
+			},
+		},
+		{
+			Name: "entries are ordered by section and malformed lines are skipped",
+			Changelog: &changelog{
+				pre: []string{"## v4.50.0 (Unreleased)", ""},
+				breaking: []string{
+					"* `azurerm_example` - the `legacy_setting` property has been removed [GH-1]",
+				},
+				features: features{
+					dataSources: []string{"* **New Data Source:** `azurerm_example` [GH-2]"},
+					general:     []string{"* **New Resource:** `azurerm_example` [GH-2]"},
+				},
+				enhancements: enhancements{
+					dependencies: []string{"* dependencies: `go-azure-sdk` - update to `v0.20250101.1077201` [GH-3]"},
+					general:      []string{"not a changelog entry"},
+				},
+				bugs: bugs{
+					dataSources: []string{"* Data Source: `azurerm_example` - fix a crash when `sku` is omitted [GH-4]"},
+				},
+				post: []string{"## 4.49.0 (October 16, 2026)", "", "* `azurerm_example` - fix a crash [GH-5]"},
+			},
+			Expected: changelogJSON{
+				Version: "4.50.0",
+				Entries: []changelogEntryJSON{
+					{
+						Kind:         changeKindBreaking,
+						ObjectType:   objectTypeResource,
+						Name:         "azurerm_example",
+						Attributes:   []string{"legacy_setting"},
+						PullRequests: []int{1},
+						Description:  "`azurerm_example` - the `legacy_setting` property has been removed",
+					},
+					{
+						Kind:         changeKindNew,
+						ObjectType:   objectTypeDataSource,
+						Name:         "azurerm_example",
+						PullRequests: []int{2},
+						Description:  "**New Data Source:** `azurerm_example`",
+					},
+					{
+						Kind:         changeKindNew,
+						ObjectType:   objectTypeResource,
+						Name:         "azurerm_example",
+						PullRequests: []int{2},
+						Description:  "**New Resource:** `azurerm_example`",
+					},
+					{
+						Kind:         changeKindEnhancement,
+						ObjectType:   objectTypeDependency,
+						Name:         "go-azure-sdk",
+						PullRequests: []int{3},
+						Description:  "dependencies: `go-azure-sdk` - update to `v0.20250101.1077201`",
+					},
+					{
+						Kind:         changeKindBug,
+						ObjectType:   objectTypeDataSource,
+						Name:         "azurerm_example",
+						Attributes:   []string{"sku"},
+						PullRequests: []int{4},
+						Description:  "Data Source: `azurerm_example` - fix a crash when `sku` is omitted",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+
+		path := filepath.Join(t.TempDir(), "changelog.json")
+		if err := writeChangelogJSON(tc.Changelog, path); err != nil {
+			t.Fatalf("writing the JSON changelog: %+v", err)
+		}
+
+		bytes, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("reading the JSON changelog: %+v", err)
+		}
+
+		var actual changelogJSON
+		if err := json.Unmarshal(bytes, &actual); err != nil {
+			t.Fatalf("unmarshaling the JSON changelog: %+v", err)
+		}
+
+		if !reflect.DeepEqual(actual, tc.Expected) {
+			t.Fatalf("expected %+v but got %+v", tc.Expected, actual)
+		}
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"text/template"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/templatehelpers"
+	"github.com/iancoleman/strcase"
 	"github.com/mitchellh/cli"
 )
 
@@ -33,6 +34,7 @@ type resourceIdentityData struct {
 	CompareValues      string
 	CompareValueMap    map[string]string
 	TestName           string
+	TestResourceType   string
 }
 
 var _ cli.Command = &ResourceIdentityCommand{}
@@ -59,13 +61,15 @@ Optional args:
 		'compare-values' specifies resource identity values that do not have a one to one relationship with any values in the schema or state (i.e. the schema references a parent resource id but the resource identity includes the pieces of that parent resource id).
 	- test-name [string]
 		'test-name' specifies the test config name that will be used to test Resource Identity. Defaults to 'basic'.
+	- test-resource-type [string]
+		'test-resource-type' specifies the name of the test resource type declared in the test package for the service, when this doesn't match the resource name. e.g. 'PrivateDnsAAAARecordResource'. Defaults to the resource name in camel case with the suffix 'Resource'.
 
 Example:
 generate-resource-identity -resource-name some_azure_resource -properties "resource_group_name,some_property" -test-params "customSku" -known-values "subscription_id:data.Subscriptions.Primary,kind:someApp;linux" -compare-values "parent_resource_name:parent_resource_id,resource_group_name:parent_resource_id"
 
 Caveats and TODOs:
 requires that the basic test for the resource is already present and has the name 'basic' for the config. TODO - Can be extended to make this configurable.
-Expects that the test resource type is already declared in the test package for the service. (e.g. type LinuxFunctionAppResource struct{})
+Expects that the test resource type is already declared in the test package for the service. (e.g. type LinuxFunctionAppResource struct{}) - see 'test-resource-type' for types which are named differently.
 `
 }
 
@@ -104,6 +108,7 @@ func (d *resourceIdentityData) parseArgs(args []string) (errors []error) {
 	argSet.StringVar(&d.KnownValues, "known-values", "", "(Optional) comma separated list of known (aka discriminated) value names and their values for this resource type, formatted as [attribute_name]:[attribute value]. e.g. `kind:linux;functionapp,foo:bar`")
 	argSet.StringVar(&d.CompareValues, "compare-values", "", "(Optional) comma separated list of resource identity names that are contained within a schema property value, formatted as [attribute_name]:[attribute value]. e.g. `parent_name:parent_resource_id;resource_group_name,parent_resource_id`")
 	argSet.StringVar(&d.TestName, "test-name", "basic", "(Optional) the name of the config that will be used to test Resource Identity. Defaults to `basic`.")
+	argSet.StringVar(&d.TestResourceType, "test-resource-type", "", "(Optional) the name of the test resource type declared in the test package. Defaults to the resource name in camel case with the suffix `Resource`.")
 
 	if err := argSet.Parse(args); err != nil {
 		errors = append(errors, err)
@@ -118,6 +123,10 @@ func (d *resourceIdentityData) parseArgs(args []string) (errors []error) {
 		errors = append(errors, fmt.Errorf("service-package-path is required"))
 	}
 
+	if d.TestResourceType == "" {
+		d.TestResourceType = fmt.Sprintf("%sResource", strcase.ToCamel(d.ResourceName))
+	}
+
 	// d.PropertyNameMap = strings.Split(d.IdentityProperties, ",")
 	if len(d.IdentityProperties) > 0 {
 		d.PropertyNameMap = map[string]string{}
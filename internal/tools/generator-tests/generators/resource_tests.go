@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generators
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/templatehelpers"
+	"github.com/mitchellh/cli"
+)
+
+var rtOutputFileFmt = "../../services/%s/%s_resource_test.go"
+
+type ResourceTestsCommand struct {
+	Ui cli.Ui
+}
+
+type resourceTestsData struct {
+	ResourceName       string
+	ServicePackageName string
+	ClientName         string
+	IdType             string
+	UsesLROCRUD        bool
+
+	// the values below are discovered from the registered Typed Resource
+	IDPackagePath        string
+	IDPackageName        string
+	IDParser             string
+	Updatable            bool
+	HasResourceGroup     bool
+	BasicConfig          string
+	RequiresImportConfig string
+	CompleteConfig       string
+	UpdateConfig         string
+}
+
+var _ cli.Command = &ResourceTestsCommand{}
+
+func (c *ResourceTestsCommand) Help() string {
+	return `
+Usage: testgenerator resourcetests [args]
+Required args:
+	- resource-name [string]
+		the name of the Typed Resource to generate the tests for, the 'azurerm_' prefix is not required.
+	- service-package-name [string]
+		the name of the Service Package the resource belongs to. This forms part of the output path for the generated file.
+	- client-name [string]
+		the path to the SDK client on the provider's Client struct used to check for the resource, e.g. 'Network.IPamPools'.
+
+Optional args:
+	- id-type [string]
+		the resource's ID type, formatted as [import path].[type name]. Only required when the resource does not implement 'sdk.ResourceWithIdentity'.
+		e.g. 'github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/ipampools.IPamPoolId'
+	- uses-lro-crud [bool]
+		the client uses LROs, so the resource is removed in the 'disappears' test with 'DeleteThenPoll'.
+
+Example:
+testgenerator resourcetests -resource-name network_manager_ipam_pool -service-package-name network -client-name Network.IPamPools -uses-lro-crud
+
+Caveats and TODOs:
+requires the resource to be a Typed Resource which is registered in the provider, since the test configurations are derived from its schema.
+the generated configurations contain placeholder values which must be replaced, these are marked with 'TODO'.
+`
+}
+
+func (c *ResourceTestsCommand) Synopsis() string {
+	return "generates the basic, complete, update, requiresImport and disappears acceptance tests for a Typed Resource"
+}
+
+func (c *ResourceTestsCommand) Run(args []string) int {
+	data := &resourceTestsData{}
+
+	if err := data.parseArgs(args); err != nil {
+		for _, e := range err {
+			c.Ui.Error(e.Error())
+		}
+
+		return 1
+	}
+
+	if err := data.discover(); err != nil {
+		c.Ui.Error(err.Error())
+		return 1
+	}
+
+	if err := data.exec(); err != nil {
+		c.Ui.Error(err.Error())
+
+		log.Println(err)
+		return 2
+	}
+
+	return 0
+}
+
+func (d *resourceTestsData) parseArgs(args []string) (errors []error) {
+	argSet := flag.NewFlagSet("rt", flag.ExitOnError)
+
+	argSet.StringVar(&d.ResourceName, "resource-name", "", "(Required) the name of the resource to generate the tests for.")
+	argSet.StringVar(&d.ServicePackageName, "service-package-name", "", "(Required) the path to the directory containing the service package to write the generated test to.")
+	argSet.StringVar(&d.ClientName, "client-name", "", "(Required) the path to the SDK client on the provider's Client struct, e.g. `Network.IPamPools`.")
+	argSet.StringVar(&d.IdType, "id-type", "", "(Optional) the resource's ID type, formatted as [import path].[type name]. Only required if the resource does not implement `sdk.ResourceWithIdentity`.")
+	argSet.BoolVar(&d.UsesLROCRUD, "uses-lro-crud", false, "(Optional) the client uses LROs for Delete.")
+
+	if err := argSet.Parse(args); err != nil {
+		errors = append(errors, err)
+		return
+	}
+
+	switch {
+	case d.ResourceName == "":
+		errors = append(errors, fmt.Errorf("resource name is required"))
+	case d.ServicePackageName == "":
+		errors = append(errors, fmt.Errorf("service-package-name is required"))
+	case d.ClientName == "":
+		errors = append(errors, fmt.Errorf("client-name is required"))
+	}
+
+	d.ResourceName = strings.TrimPrefix(d.ResourceName, "azurerm_")
+
+	return
+}
+
+// discover populates the ID parser and test configurations from the registered Typed Resource
+func (d *resourceTestsData) discover() error {
+	resourceType := fmt.Sprintf("azurerm_%s", d.ResourceName)
+
+	resource := registeredTypedResource(resourceType)
+	if resource == nil {
+		return fmt.Errorf("the Typed Resource %q was not registered", resourceType)
+	}
+
+	if v, ok := resource.(sdk.ResourceWithIdentity); ok && d.IdType == "" {
+		if err := d.setIDParserFromResourceId(v.Identity()); err != nil {
+			return err
+		}
+	} else {
+		idx := strings.LastIndex(d.IdType, ".")
+		if idx == -1 {
+			return fmt.Errorf("%q does not implement `sdk.ResourceWithIdentity` so `-id-type` must be specified as [import path].[type name]", resourceType)
+		}
+		d.IDPackagePath = d.IdType[:idx]
+		d.IDPackageName = path.Base(d.IDPackagePath)
+		d.IDParser = fmt.Sprintf("%s.Parse%s", d.IDPackageName, templatehelpers.IdToID(d.IdType[idx+1:]))
+	}
+
+	_, d.Updatable = resource.(sdk.ResourceWithUpdate)
+
+	arguments := resource.Arguments()
+	_, d.HasResourceGroup = arguments["resource_group_name"]
+
+	d.BasicConfig = testConfigForSchema(arguments, configModeBasic, 1)
+	d.RequiresImportConfig = requiresImportConfigForSchema(arguments, resourceType, 1)
+	d.CompleteConfig = testConfigForSchema(arguments, configModeComplete, 1)
+	if d.Updatable {
+		d.UpdateConfig = testConfigForSchema(arguments, configModeUpdate, 1)
+	}
+
+	return nil
+}
+
+// registeredTypedResource returns the Typed Resource registered for the resource type, or nil if it isn't registered
+func registeredTypedResource(resourceType string) sdk.Resource {
+	for _, service := range provider.SupportedTypedServices() {
+		for _, r := range service.Resources() {
+			if r.ResourceType() == resourceType {
+				return r
+			}
+		}
+	}
+
+	return nil
+}
+
+// usesRandomInteger returns whether the test configuration references the random integer passed to the config
+func usesRandomInteger(config string) bool {
+	return strings.Contains(config, randomIntegerVerb)
+}
+
+func (d *resourceTestsData) setIDParserFromResourceId(id resourceids.ResourceId) error {
+	t := reflect.TypeOf(id)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t.PkgPath() == "" || t.Name() == "" {
+		return fmt.Errorf("could not determine the package of the resource ID type %T, please specify `-id-type`", id)
+	}
+
+	d.IDPackagePath = t.PkgPath()
+	d.IDPackageName = path.Base(t.PkgPath())
+	d.IDParser = fmt.Sprintf("%s.Parse%s", d.IDPackageName, templatehelpers.IdToID(t.Name()))
+
+	return nil
+}
+
+func (d *resourceTestsData) exec() error {
+	tpl := template.Must(template.New("resource_test.gotpl").Funcs(templatehelpers.TplFuncMap).Funcs(template.FuncMap{"UsesRandomInteger": usesRandomInteger}).ParseFS(Templatedir, "templates/resource_test.gotpl"))
+
+	outputPath := fmt.Sprintf(rtOutputFileFmt, d.ServicePackageName, d.ResourceName)
+
+	if _, err := os.Stat(outputPath); err == nil {
+		return fmt.Errorf("the test file %s already exists", outputPath)
+	}
+
+	f, err := os.Create(outputPath)
+	if err != nil {
+		return fmt.Errorf("failed opening output test file for writing: %+v", err.Error())
+	}
+	defer func(f *os.File) {
+		err := f.Close()
+		if err != nil {
+			log.Println("failed closing output test file for writing:", err.Error())
+			os.Exit(3)
+		}
+	}(f)
+
+	if err := tpl.Execute(f, d); err != nil {
+		return fmt.Errorf("failed writing output test file (%s): %s", outputPath, err.Error())
+	}
+
+	if err := templatehelpers.GoImports(outputPath); err != nil {
+		return err
+	}
+
+	return nil
+}
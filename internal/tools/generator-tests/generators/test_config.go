@@ -0,0 +1,299 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generators
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type configMode int
+
+const (
+	configModeBasic configMode = iota
+	configModeComplete
+	configModeUpdate
+)
+
+// randomIntegerVerb is the format verb within the test configurations which is replaced with `data.RandomInteger`,
+// the first argument to each configuration being the template
+const randomIntegerVerb = "%[2]d"
+
+// configEntry is a single attribute or block within a generated test configuration
+type configEntry struct {
+	key     string
+	value   string
+	isBlock bool
+}
+
+// testConfigForSchema builds the HCL body of a test configuration from the resource's arguments. The basic configuration
+// contains only the Required arguments, the complete configuration contains all arguments that can be set, and the update
+// configuration is the complete configuration with different values for any arguments which are not ForceNew.
+// Placeholder values which need to be replaced are marked with TODO.
+func testConfigForSchema(arguments map[string]*pluginsdk.Schema, mode configMode, indent int) string {
+	entries := make([]configEntry, 0)
+
+	for _, key := range sortArguments(arguments) {
+		s := arguments[key]
+		if !includeArgument(key, s, arguments, mode) {
+			continue
+		}
+
+		updated := mode == configModeUpdate && !s.ForceNew
+
+		if r, ok := s.Elem.(*pluginsdk.Resource); ok && (s.Type == pluginsdk.TypeList || s.Type == pluginsdk.TypeSet) {
+			blockMode := mode
+			if mode == configModeUpdate && s.ForceNew {
+				blockMode = configModeComplete
+			}
+			entries = append(entries, configEntry{
+				key:     key,
+				value:   testConfigForSchema(r.Schema, blockMode, indent+1),
+				isBlock: true,
+			})
+			continue
+		}
+
+		entries = append(entries, configEntry{
+			key:   key,
+			value: testValueForArgument(key, s, updated, indent),
+		})
+	}
+
+	return renderConfigEntries(entries, indent)
+}
+
+func includeArgument(key string, s *pluginsdk.Schema, arguments map[string]*pluginsdk.Schema, mode configMode) bool {
+	if s.Required {
+		return true
+	}
+
+	if !s.Optional || s.Deprecated != "" {
+		return false
+	}
+
+	if mode != configModeBasic {
+		// only the first argument (by name) of a set of conflicting arguments is included
+		for _, c := range s.ConflictsWith {
+			if c < key && arguments[c] != nil && !strings.Contains(c, ".") {
+				return false
+			}
+		}
+
+		for _, c := range s.ExactlyOneOf {
+			if c < key && arguments[c] != nil && !strings.Contains(c, ".") {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	// the basic configuration must still satisfy `ExactlyOneOf` and `AtLeastOneOf`, so include the first of these by name
+	oneOf := append(append([]string{}, s.ExactlyOneOf...), s.AtLeastOneOf...)
+	if len(oneOf) == 0 {
+		return false
+	}
+
+	sort.Strings(oneOf)
+	for _, v := range oneOf {
+		if _, ok := arguments[v]; ok {
+			return v == key
+		}
+	}
+
+	return false
+}
+
+func testValueForArgument(key string, s *pluginsdk.Schema, updated bool, indent int) string {
+	switch key {
+	case "name":
+		return `"acctest-` + randomIntegerVerb + `"`
+	case "resource_group_name":
+		return "azurerm_resource_group.test.name"
+	case "location":
+		return "azurerm_resource_group.test.location"
+	case "tags":
+		value := "Test"
+		if updated {
+			value = "Updated"
+		}
+		return fmt.Sprintf("{\n%[1]sENV = %[2]q\n%[3]s}", strings.Repeat("  ", indent+1), value, strings.Repeat("  ", indent))
+	}
+
+	switch s.Type {
+	case pluginsdk.TypeString:
+		if strings.HasSuffix(key, "_id") {
+			return fmt.Sprintf("azurerm_%s.test.id", strings.TrimSuffix(key, "_id"))
+		}
+		if strings.HasSuffix(key, "_ids") {
+			return fmt.Sprintf("[azurerm_%s.test.id]", strings.TrimSuffix(key, "_ids"))
+		}
+		if updated {
+			return `"TODO-updated"`
+		}
+		return `"TODO"`
+
+	case pluginsdk.TypeBool:
+		if updated {
+			return "false"
+		}
+		return "true"
+
+	case pluginsdk.TypeInt:
+		if updated {
+			return "2"
+		}
+		return "1"
+
+	case pluginsdk.TypeFloat:
+		if updated {
+			return "2.0"
+		}
+		return "1.0"
+
+	case pluginsdk.TypeList, pluginsdk.TypeSet:
+		if strings.HasSuffix(key, "_ids") {
+			return fmt.Sprintf("[azurerm_%s.test.id]", strings.TrimSuffix(key, "_ids"))
+		}
+		if e, ok := s.Elem.(*pluginsdk.Schema); ok {
+			return fmt.Sprintf("[%s]", testValueForArgument("", e, updated, indent))
+		}
+		return `["TODO"]`
+
+	case pluginsdk.TypeMap:
+		value := "TODO"
+		if updated {
+			value = "TODO-updated"
+		}
+		return fmt.Sprintf("{\n%[1]skey = %[2]q\n%[3]s}", strings.Repeat("  ", indent+1), value, strings.Repeat("  ", indent))
+	}
+
+	return `"TODO"`
+}
+
+// sortArguments orders the arguments by convention, `name`, `resource_group_name` and `location` first, then the
+// remaining Required arguments, then the Optional arguments, with `tags` last
+func sortArguments(arguments map[string]*pluginsdk.Schema) []string {
+	weight := func(key string) int {
+		switch key {
+		case "name":
+			return 0
+		case "resource_group_name":
+			return 1
+		case "location":
+			return 2
+		case "tags":
+			return 6
+		}
+
+		s := arguments[key]
+		_, isBlock := s.Elem.(*pluginsdk.Resource)
+		switch {
+		case s.Required && !isBlock:
+			return 3
+		case !isBlock:
+			return 4
+		default:
+			return 5
+		}
+	}
+
+	keys := make([]string, 0, len(arguments))
+	for k := range arguments {
+		keys = append(keys, k)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		wi, wj := weight(keys[i]), weight(keys[j])
+		if wi != wj {
+			return wi < wj
+		}
+		return keys[i] < keys[j]
+	})
+
+	return keys
+}
+
+// renderConfigEntries writes out the entries, aligning the `=` of consecutive single line attributes as `terraform fmt` does
+func renderConfigEntries(entries []configEntry, indent int) string {
+	prefix := strings.Repeat("  ", indent)
+	lines := make([]string, 0)
+
+	for i := 0; i < len(entries); {
+		e := entries[i]
+		if e.isBlock {
+			if len(lines) > 0 {
+				lines = append(lines, "")
+			}
+			lines = append(lines, fmt.Sprintf("%s%s {", prefix, e.key))
+			if e.value != "" {
+				lines = append(lines, e.value)
+			}
+			lines = append(lines, prefix+"}")
+			i++
+			continue
+		}
+
+		// find the run of single line attributes to align
+		j := i
+		width := 0
+		for j < len(entries) && !entries[j].isBlock && !strings.Contains(entries[j].value, "\n") {
+			width = max(width, len(entries[j].key))
+			j++
+		}
+
+		if j == i {
+			// a multi-line attribute
+			if len(lines) > 0 {
+				lines = append(lines, "")
+			}
+			lines = append(lines, fmt.Sprintf("%s%s = %s", prefix, e.key, e.value))
+			i++
+			continue
+		}
+
+		if len(lines) > 0 && strings.HasSuffix(lines[len(lines)-1], "}") {
+			lines = append(lines, "")
+		}
+
+		for ; i < j; i++ {
+			lines = append(lines, fmt.Sprintf("%s%-*s = %s", prefix, width, entries[i].key, entries[i].value))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// requiresImportConfigForSchema builds the HCL body of the `requiresImport` test configuration, referencing the values of
+// the Required arguments from the resource created in the basic configuration
+func requiresImportConfigForSchema(arguments map[string]*pluginsdk.Schema, resourceType string, indent int) string {
+	entries := make([]configEntry, 0)
+
+	for _, key := range sortArguments(arguments) {
+		s := arguments[key]
+		if !includeArgument(key, s, arguments, configModeBasic) {
+			continue
+		}
+
+		if r, ok := s.Elem.(*pluginsdk.Resource); ok && (s.Type == pluginsdk.TypeList || s.Type == pluginsdk.TypeSet) {
+			entries = append(entries, configEntry{
+				key:     key,
+				value:   testConfigForSchema(r.Schema, configModeBasic, indent+1),
+				isBlock: true,
+			})
+			continue
+		}
+
+		entries = append(entries, configEntry{
+			key:   key,
+			value: fmt.Sprintf("%s.test.%s", resourceType, key),
+		})
+	}
+
+	return renderConfigEntries(entries, indent)
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generators
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func testConfigSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"resource_group_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"location": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"sku_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"network": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"subnet_id": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+
+		"tags": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+		},
+	}
+}
+
+func TestTestConfigForSchema(t *testing.T) {
+	testData := []struct {
+		mode     configMode
+		expected string
+	}{
+		{
+			mode: configModeBasic,
+			expected: `  name                = "acctest-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "TODO"`,
+		},
+		{
+			mode: configModeComplete,
+			expected: `  name                = "acctest-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "TODO"
+  enabled             = true
+
+  network {
+    subnet_id = azurerm_subnet.test.id
+  }
+
+  tags = {
+    ENV = "Test"
+  }`,
+		},
+		{
+			mode: configModeUpdate,
+			expected: `  name                = "acctest-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku_name            = "TODO-updated"
+  enabled             = false
+
+  network {
+    subnet_id = azurerm_subnet.test.id
+  }
+
+  tags = {
+    ENV = "Updated"
+  }`,
+		},
+	}
+
+	for _, v := range testData {
+		actual := testConfigForSchema(testConfigSchema(), v.mode, 1)
+		if actual != v.expected {
+			t.Fatalf("expected mode %d to produce:\n%s\n\ngot:\n%s", v.mode, v.expected, actual)
+		}
+	}
+}
+
+func TestRequiresImportConfigForSchema(t *testing.T) {
+	expected := `  name                = azurerm_example.test.name
+  resource_group_name = azurerm_example.test.resource_group_name
+  location            = azurerm_example.test.location
+  sku_name            = azurerm_example.test.sku_name`
+
+	actual := requiresImportConfigForSchema(testConfigSchema(), "azurerm_example", 1)
+	if actual != expected {
+		t.Fatalf("expected:\n%s\n\ngot:\n%s", expected, actual)
+	}
+}
+
+func TestUsesRandomInteger(t *testing.T) {
+	withoutName := testConfigSchema()
+	delete(withoutName, "name")
+
+	testData := []struct {
+		name     string
+		config   string
+		expected bool
+	}{
+		{
+			name:     "basic",
+			config:   testConfigForSchema(testConfigSchema(), configModeBasic, 1),
+			expected: true,
+		},
+		{
+			name:     "basic without a name",
+			config:   testConfigForSchema(withoutName, configModeBasic, 1),
+			expected: false,
+		},
+		{
+			name:     "requires import",
+			config:   requiresImportConfigForSchema(testConfigSchema(), "azurerm_example", 1),
+			expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		if actual := usesRandomInteger(v.config); actual != v.expected {
+			t.Fatalf("expected %q to be %t but got %t", v.name, v.expected, actual)
+		}
+	}
+}
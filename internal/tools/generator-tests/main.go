@@ -34,6 +34,11 @@ func realMain(args []string) int {
 				Ui: ui,
 			}, nil
 		},
+		"resourcetests": func() (cli.Command, error) {
+			return &generators.ResourceTestsCommand{
+				Ui: ui,
+			}, nil
+		},
 	}
 
 	gen := cli.CLI{
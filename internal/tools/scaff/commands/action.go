@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package commands
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/iancoleman/strcase"
+	"github.com/mitchellh/cli"
+)
+
+type ActionCommand struct {
+	Ui cli.Ui
+}
+
+var _ cli.Command = &ActionCommand{}
+
+func (c ActionCommand) Run(args []string) int {
+	data := &objectData{}
+
+	if err := data.parseArgs("action", args); err != nil {
+		for _, e := range err {
+			c.Ui.Error(e.Error())
+		}
+
+		return 1
+	}
+
+	files := []scaffoldFile{
+		{
+			template:   "action.gotpl",
+			outputPath: fmt.Sprintf(outputServiceFileFmt, data.ServicePackageName, data.Name+"_action.go"),
+			goFile:     true,
+		},
+		{
+			template:   "action_test.gotpl",
+			outputPath: fmt.Sprintf(outputServiceFileFmt, data.ServicePackageName, data.Name+"_action_test.go"),
+			goFile:     true,
+		},
+		{
+			template:   "website_action.gotpl",
+			outputPath: fmt.Sprintf(outputWebsiteFileFmt, "actions", data.Name),
+		},
+	}
+
+	if err := data.render(files); err != nil {
+		c.Ui.Error(err.Error())
+
+		log.Println(err)
+		return 2
+	}
+
+	if !data.SkipRegistration {
+		if err := registerInService(data.ServicePackageName, "Actions", "new"+strcase.ToCamel(data.Name)+"Action"); err != nil {
+			c.Ui.Error(err.Error())
+			return 2
+		}
+	}
+
+	return 0
+}
+
+func (c ActionCommand) Synopsis() string {
+	return "create boilerplate for a Framework Action, its acceptance tests and documentation"
+}
+
+func (c ActionCommand) Help() string {
+	return `
+Usage: scaff action -name="some_action_name" -service_package_name="someservice" -rp_name="compute" -client_name="SomeClient" -api_version="2024-03-01" -id_type="virtualmachines.VirtualMachineId" [-client_service_name="SomeService"] [-sdk_name="virtualmachines"] [-brand_name="Virtual Machine Power"] [-website_category="Compute"] [-skip_registration=true]
+
+Parameters:
+	-name (Required) the name of the Action to scaffold, without the 'azurerm_' prefix.
+	-service_package_name (Required) the name of the service package to scaffold the Action into.
+	-rp_name (Required) the name of the resource provider the Action operates on.
+	-client_name (Required) the name of the client on the service's Client struct used by the Action.
+	-api_version (Required) the API version of the SDK used by the Action. e.g. 2025-01-01
+	-id_type (Required) the ID type of the resource the Action operates on. e.g. 'virtualmachines.VirtualMachineId'.
+
+	-client_service_name (Optional) the name of the service's field on the provider's Client struct, e.g. 'MSSQL'. Defaults to a camel-cased version of service_package_name.
+	-sdk_name (Optional) the name of the SDK package. If omitted, the first slug of the id_type value will be used.
+	-brand_name (Optional) the friendly/brand name used in the documentation.
+	-website_category (Optional) the website subcategory of the documentation. Defaults to the service_package_name.
+	-skip_registration (Optional) do not add the Action to the service's registration.go.
+
+Example:
+scaff action -name="virtual_machine_power" -service_package_name="compute" -rp_name="compute" -client_name="VirtualMachinesClient" -api_version="2024-03-01" -id_type="virtualmachines.VirtualMachineId"
+`
+}
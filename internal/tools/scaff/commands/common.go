@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package commands
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tools/templatehelpers"
+	"github.com/iancoleman/strcase"
+)
+
+var outputServiceFileFmt = relativePathToRoot() + "internal/services/%s/%s"
+
+var outputWebsiteFileFmt = relativePathToRoot() + "website/docs/%s/%s.html.markdown"
+
+// objectData holds the values common to the scaffolding of Data Sources, List Resources, Actions and Ephemeral Resources
+type objectData struct {
+	Name               string `json:"name"                   hcl:"name"`
+	BrandName          string `json:"brand_name"             hcl:"brand_name"`
+	ServicePackageName string `json:"service_package_name"   hcl:"service_package_name"`
+	WebsiteCategory    string `json:"website_category"       hcl:"website_category"`
+	RPName             string `json:"resource_provider_name" hcl:"resource_provider_name"`
+	ClientName         string `json:"client_name"            hcl:"client_name"`
+	ClientServiceName  string `json:"client_service_name"    hcl:"client_service_name"`
+	APIVersion         string `json:"api_version"            hcl:"api_version"`
+	IdType             string `json:"id_type"                hcl:"id_type"`
+	IdTypeParts        []string
+	SDKName            string `json:"sdk_name"               hcl:"sdk_name"`
+	UseReadOptions     bool   `json:"use_read_options"       hcl:"use_read_options"`
+	SkipRegistration   bool   `json:"skip_registration"      hcl:"skip_registration"`
+}
+
+// scaffoldFile describes a single file to be rendered from a template
+type scaffoldFile struct {
+	template   string
+	outputPath string
+	goFile     bool
+}
+
+func (d *objectData) parseArgs(name string, args []string) (errs []error) {
+	argSet := flag.NewFlagSet(name, flag.ExitOnError)
+
+	argSet.StringVar(&d.Name, "name", "", "(Required) the name of the item to scaffold, without the `azurerm_` prefix.")
+	argSet.StringVar(&d.BrandName, "brand_name", "", "(Optional) the friendly/brand name of the item, e.g. `Virtual Network`. Defaults to a title-cased version of `name`.")
+	argSet.StringVar(&d.ServicePackageName, "service_package_name", "", "(Required) the name of the service package to scaffold the item into.")
+	argSet.StringVar(&d.WebsiteCategory, "website_category", "", "(Optional) the website subcategory for the documentation stub. Defaults to the `service_package_name`.")
+	argSet.StringVar(&d.RPName, "rp_name", "", "(Required) the name of the resource provider of the item.")
+	argSet.StringVar(&d.ClientName, "client_name", "", "(Required) the name of the client used to manage the item.")
+	argSet.StringVar(&d.ClientServiceName, "client_service_name", "", "(Optional) the name of the service's field on the provider's Client struct. Defaults to a camel-cased version of `service_package_name`.")
+	argSet.StringVar(&d.APIVersion, "api_version", "", "(Required) the API version of the SDK used by the item. e.g. 2025-01-01")
+	argSet.StringVar(&d.IdType, "id_type", "", "(Required) the ID type of the resource the item relates to. e.g. `commonids.AppServiceId`, or `virtualmachines.VirtualMachineId`.")
+	argSet.StringVar(&d.SDKName, "sdk_name", "", "(Optional) the name of the SDK package. If omitted, the first slug of the id_type value will be used.")
+	argSet.BoolVar(&d.UseReadOptions, "use_read_options", false, "(Optional) the client uses OperationOptions for Get.")
+	argSet.BoolVar(&d.SkipRegistration, "skip_registration", false, "(Optional) do not add the new item to the service's `registration.go`.")
+	if err := argSet.Parse(args); err != nil {
+		errs = append(errs, err)
+		return
+	}
+
+	switch {
+	case d.Name == "":
+		errs = append(errs, errors.New("name is required"))
+	case d.ServicePackageName == "":
+		errs = append(errs, errors.New("service package name is required"))
+	case d.RPName == "":
+		errs = append(errs, errors.New("resource provider name is required"))
+	case d.ClientName == "":
+		errs = append(errs, errors.New("client name is required"))
+	case d.APIVersion == "":
+		errs = append(errs, errors.New("api version is required"))
+	case d.IdType == "":
+		errs = append(errs, errors.New("id_type is required"))
+	}
+
+	d.Name = strings.TrimPrefix(d.Name, "azurerm_")
+
+	if d.ClientServiceName == "" {
+		d.ClientServiceName = strcase.ToCamel(d.ServicePackageName)
+	}
+	d.ServicePackageName = strings.ToLower(d.ServicePackageName)
+
+	d.IdTypeParts = strings.Split(d.IdType, ".")
+	if l := len(d.IdTypeParts); l != 2 {
+		errs = append(errs, fmt.Errorf("id_type has incorrect number of segments, expected 2 got %d", l))
+		return errs
+	}
+
+	if d.SDKName == "" {
+		d.SDKName = d.IdTypeParts[0]
+	}
+
+	if d.BrandName == "" {
+		d.BrandName = templatehelpers.ToDelimTitle(d.Name)
+	}
+
+	if d.WebsiteCategory == "" {
+		d.WebsiteCategory = d.ServicePackageName
+	}
+
+	return errs
+}
+
+// render writes each of the supplied files from their templates, running `goimports` over any Go files
+func (d *objectData) render(files []scaffoldFile) error {
+	for _, file := range files {
+		if err := renderTemplate(file.template, file.outputPath, d); err != nil {
+			return err
+		}
+
+		if file.goFile {
+			if err := templatehelpers.GoImports(file.outputPath); err != nil {
+				return fmt.Errorf("running goimports on %s: %+v", file.outputPath, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func renderTemplate(name string, outputPath string, data any) error {
+	tpl := template.Must(template.New(name).Funcs(templatehelpers.TplFuncMap).ParseFS(Templatedir, "templates/"+name))
+
+	if _, err := os.Stat(outputPath); err == nil {
+		return fmt.Errorf("output file %s already exists", outputPath)
+	}
+
+	f, err := os.Create(outputPath)
+	if err != nil {
+		return fmt.Errorf("failed opening output file %s for writing: %+v", outputPath, err)
+	}
+	defer func(f *os.File) {
+		if err := f.Close(); err != nil {
+			log.Printf("failed closing output file %s: %+v", outputPath, err)
+		}
+	}(f)
+
+	if err := tpl.Execute(f, data); err != nil {
+		return fmt.Errorf("failed writing output file (%s): %+v", outputPath, err)
+	}
+
+	return nil
+}
+
+// runWebsiteScaffold calls the `website-scaffold` tool to generate the documentation for a Data Source or Resource.
+// This requires the item to be registered and the provider to compile.
+func runWebsiteScaffold(name, brandName, resourceType string) error {
+	root := relativePathToRoot()
+	if root == "" {
+		root = "./"
+	}
+
+	args := []string{
+		"run", root + "internal/tools/website-scaffold",
+		"-name", fmt.Sprintf("azurerm_%s", name),
+		"-brand-name", brandName,
+		"-type", resourceType,
+		"-website-path", root + "website",
+	}
+
+	cmd := exec.Command("go", args...)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("running `go %s`: %+v\n%s", strings.Join(args, " "), err, string(out))
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package commands
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/iancoleman/strcase"
+	"github.com/mitchellh/cli"
+)
+
+type DataSourceCommand struct {
+	Ui cli.Ui
+}
+
+var _ cli.Command = &DataSourceCommand{}
+
+func (c DataSourceCommand) Run(args []string) int {
+	data := &objectData{}
+
+	if err := data.parseArgs("datasource", args); err != nil {
+		for _, e := range err {
+			c.Ui.Error(e.Error())
+		}
+
+		return 1
+	}
+
+	files := []scaffoldFile{
+		{
+			template:   "data_source.gotpl",
+			outputPath: fmt.Sprintf(outputServiceFileFmt, data.ServicePackageName, data.Name+"_data_source.go"),
+			goFile:     true,
+		},
+		{
+			template:   "data_source_test.gotpl",
+			outputPath: fmt.Sprintf(outputServiceFileFmt, data.ServicePackageName, data.Name+"_data_source_test.go"),
+			goFile:     true,
+		},
+	}
+
+	if err := data.render(files); err != nil {
+		c.Ui.Error(err.Error())
+
+		log.Println(err)
+		return 2
+	}
+
+	if !data.SkipRegistration {
+		if err := registerInService(data.ServicePackageName, "DataSources", strcase.ToCamel(data.Name)+"DataSource{}"); err != nil {
+			c.Ui.Error(err.Error())
+			return 2
+		}
+
+		// the documentation is generated from the registered schema, so this can only happen once the Data Source is wired up
+		if err := runWebsiteScaffold(data.Name, data.BrandName, "data"); err != nil {
+			c.Ui.Warn(fmt.Sprintf("the documentation could not be generated, re-run `website-scaffold` once the Data Source compiles:\n%s", err))
+		}
+	}
+
+	return 0
+}
+
+func (c DataSourceCommand) Synopsis() string {
+	return "create boilerplate for a Typed SDK Data Source, its acceptance tests and documentation"
+}
+
+func (c DataSourceCommand) Help() string {
+	return `
+Usage: scaff datasource -name="some_data_source_name" -service_package_name="someservice" -rp_name="sql" -client_name="SomeClient" -api_version="2023-08-01-preview" -id_type="commonids.SqlDatabaseId" [-client_service_name="SomeService"] [-sdk_name="databases"] [-brand_name="SQL Database"] [-website_category="Database"] [-use_read_options=true] [-skip_registration=true]
+
+Parameters:
+	-name (Required) the name of the Data Source to scaffold, without the 'azurerm_' prefix.
+	-service_package_name (Required) the name of the service package to scaffold the Data Source into.
+	-rp_name (Required) the name of the resource provider of the Data Source.
+	-client_name (Required) the name of the client on the service's Client struct used to read the resource.
+	-api_version (Required) the API version of the SDK used to read the resource. e.g. 2025-01-01
+	-id_type (Required) the ID type of the resource. e.g. 'commonids.AppServiceId', or 'virtualmachines.VirtualMachineId'.
+
+	-client_service_name (Optional) the name of the service's field on the provider's Client struct, e.g. 'MSSQL'. Defaults to a camel-cased version of service_package_name.
+	-sdk_name (Optional) the name of the SDK package. If omitted, the first slug of the id_type value will be used.
+	-brand_name (Optional) the friendly/brand name used in the documentation, e.g. 'SQL Database'.
+	-website_category (Optional) the website subcategory of the documentation. Defaults to the service_package_name.
+	-use_read_options (Optional) the client uses OperationOptions for Get.
+	-skip_registration (Optional) do not add the Data Source to the service's registration.go, or generate the documentation.
+
+Example:
+scaff datasource -name="mssql_database" -service_package_name="mssql" -rp_name="sql" -client_name="DatabasesClient" -api_version="2023-08-01-preview" -id_type="commonids.SqlDatabaseId" -sdk_name="databases"
+`
+}
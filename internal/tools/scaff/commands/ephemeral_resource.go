@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package commands
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/iancoleman/strcase"
+	"github.com/mitchellh/cli"
+)
+
+type EphemeralResourceCommand struct {
+	Ui cli.Ui
+}
+
+var _ cli.Command = &EphemeralResourceCommand{}
+
+func (c EphemeralResourceCommand) Run(args []string) int {
+	data := &objectData{}
+
+	if err := data.parseArgs("ephemeral", args); err != nil {
+		for _, e := range err {
+			c.Ui.Error(e.Error())
+		}
+
+		return 1
+	}
+
+	files := []scaffoldFile{
+		{
+			template:   "ephemeral_resource.gotpl",
+			outputPath: fmt.Sprintf(outputServiceFileFmt, data.ServicePackageName, data.Name+"_ephemeral.go"),
+			goFile:     true,
+		},
+		{
+			template:   "ephemeral_resource_test.gotpl",
+			outputPath: fmt.Sprintf(outputServiceFileFmt, data.ServicePackageName, data.Name+"_ephemeral_test.go"),
+			goFile:     true,
+		},
+		{
+			template:   "website_ephemeral_resource.gotpl",
+			outputPath: fmt.Sprintf(outputWebsiteFileFmt, "ephemeral-resources", data.Name),
+		},
+	}
+
+	if err := data.render(files); err != nil {
+		c.Ui.Error(err.Error())
+
+		log.Println(err)
+		return 2
+	}
+
+	if !data.SkipRegistration {
+		if err := registerInService(data.ServicePackageName, "EphemeralResources", "New"+strcase.ToCamel(data.Name)+"EphemeralResource"); err != nil {
+			c.Ui.Error(err.Error())
+			return 2
+		}
+	}
+
+	return 0
+}
+
+func (c EphemeralResourceCommand) Synopsis() string {
+	return "create boilerplate for a Framework Ephemeral Resource, its acceptance tests and documentation"
+}
+
+func (c EphemeralResourceCommand) Help() string {
+	return `
+Usage: scaff ephemeral -name="some_ephemeral_name" -service_package_name="someservice" -rp_name="keyvault" -client_name="SomeClient" -api_version="2023-07-01" -id_type="commonids.KeyVaultId" [-client_service_name="SomeService"] [-sdk_name="vaults"] [-brand_name="Key Vault Secret"] [-website_category="Key Vault"] [-use_read_options=true] [-skip_registration=true]
+
+Parameters:
+	-name (Required) the name of the Ephemeral Resource to scaffold, without the 'azurerm_' prefix.
+	-service_package_name (Required) the name of the service package to scaffold the Ephemeral Resource into.
+	-rp_name (Required) the name of the resource provider of the Ephemeral Resource.
+	-client_name (Required) the name of the client on the service's Client struct used to open the Ephemeral Resource.
+	-api_version (Required) the API version of the SDK used by the Ephemeral Resource. e.g. 2025-01-01
+	-id_type (Required) the ID type of the resource. e.g. 'commonids.KeyVaultId'.
+
+	-client_service_name (Optional) the name of the service's field on the provider's Client struct, e.g. 'MSSQL'. Defaults to a camel-cased version of service_package_name.
+	-sdk_name (Optional) the name of the SDK package. If omitted, the first slug of the id_type value will be used.
+	-brand_name (Optional) the friendly/brand name used in the documentation.
+	-website_category (Optional) the website subcategory of the documentation. Defaults to the service_package_name.
+	-use_read_options (Optional) the client uses OperationOptions for Get.
+	-skip_registration (Optional) do not add the Ephemeral Resource to the service's registration.go.
+
+Example:
+scaff ephemeral -name="storage_account_sas" -service_package_name="storage" -rp_name="storage" -client_name="ResourceManager.StorageAccounts" -api_version="2023-05-01" -id_type="commonids.StorageAccountId" -sdk_name="storageaccounts"
+`
+}
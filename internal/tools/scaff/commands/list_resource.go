@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package commands
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/iancoleman/strcase"
+	"github.com/mitchellh/cli"
+)
+
+type ListResourceCommand struct {
+	Ui cli.Ui
+}
+
+var _ cli.Command = &ListResourceCommand{}
+
+func (c ListResourceCommand) Run(args []string) int {
+	data := &objectData{}
+
+	if err := data.parseArgs("list", args); err != nil {
+		for _, e := range err {
+			c.Ui.Error(e.Error())
+		}
+
+		return 1
+	}
+
+	files := []scaffoldFile{
+		{
+			template:   "list_resource.gotpl",
+			outputPath: fmt.Sprintf(outputServiceFileFmt, data.ServicePackageName, data.Name+"_resource_list.go"),
+			goFile:     true,
+		},
+		{
+			template:   "list_resource_test.gotpl",
+			outputPath: fmt.Sprintf(outputServiceFileFmt, data.ServicePackageName, data.Name+"_resource_list_test.go"),
+			goFile:     true,
+		},
+		{
+			template:   "website_list_resource.gotpl",
+			outputPath: fmt.Sprintf(outputWebsiteFileFmt, "list-resources", data.Name),
+		},
+	}
+
+	if err := data.render(files); err != nil {
+		c.Ui.Error(err.Error())
+
+		log.Println(err)
+		return 2
+	}
+
+	if !data.SkipRegistration {
+		if err := registerInService(data.ServicePackageName, "ListResources", "New"+strcase.ToCamel(data.Name)+"ListResource"); err != nil {
+			c.Ui.Error(err.Error())
+			return 2
+		}
+	}
+
+	return 0
+}
+
+func (c ListResourceCommand) Synopsis() string {
+	return "create boilerplate for a Framework List Resource for an existing Resource, its acceptance tests and documentation"
+}
+
+func (c ListResourceCommand) Help() string {
+	return `
+Usage: scaff list -name="some_resource_name" -service_package_name="someservice" -rp_name="network" -client_name="SomeClient" -api_version="2025-01-01" -id_type="commonids.VirtualNetworkId" [-client_service_name="SomeService"] [-sdk_name="virtualnetworks"] [-brand_name="Virtual Network"] [-website_category="Network"] [-skip_registration=true]
+
+Parameters:
+	-name (Required) the name of the existing Resource to list, without the 'azurerm_' prefix.
+	-service_package_name (Required) the name of the service package containing the Resource.
+	-rp_name (Required) the name of the resource provider of the Resource.
+	-client_name (Required) the name of the client on the service's Client struct used to list the Resource.
+	-api_version (Required) the API version of the SDK used to list the Resource. e.g. 2025-01-01
+	-id_type (Required) the ID type of the Resource. e.g. 'commonids.VirtualNetworkId'.
+
+	-client_service_name (Optional) the name of the service's field on the provider's Client struct, e.g. 'MSSQL'. Defaults to a camel-cased version of service_package_name.
+	-sdk_name (Optional) the name of the SDK package. If omitted, the first slug of the id_type value will be used.
+	-brand_name (Optional) the friendly/brand name used in the documentation, e.g. 'Virtual Network'.
+	-website_category (Optional) the website subcategory of the documentation. Defaults to the service_package_name.
+	-skip_registration (Optional) do not add the List Resource to the service's registration.go.
+
+Example:
+scaff list -name="virtual_network" -service_package_name="network" -rp_name="network" -client_name="VirtualNetworks" -api_version="2024-05-01" -id_type="commonids.VirtualNetworkId" -sdk_name="virtualnetworks"
+`
+}
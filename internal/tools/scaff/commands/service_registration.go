@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package commands
+
+import (
+	"fmt"
+	"go/format"
+	"os"
+	"regexp"
+	"strings"
+)
+
+var outputRegistrationFileFmt = relativePathToRoot() + "internal/services/%s/registration.go"
+
+// registerInService adds entry to the slice returned by the named method of the service's Registration
+func registerInService(servicePackageName, method, entry string) error {
+	path := fmt.Sprintf(outputRegistrationFileFmt, servicePackageName)
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading %s: %+v", path, err)
+	}
+
+	updated, err := addRegistrationEntry(string(b), method, entry)
+	if err != nil {
+		return fmt.Errorf("updating %s: %+v", path, err)
+	}
+
+	formatted, err := format.Source([]byte(updated))
+	if err != nil {
+		return fmt.Errorf("formatting %s: %+v", path, err)
+	}
+
+	return os.WriteFile(path, formatted, 0o644)
+}
+
+// addRegistrationEntry inserts entry as the last element of the slice literal returned by the
+// `func (r Registration) <method>()` in src. An error is returned if the method cannot be found,
+// as the service may not yet implement the required registration interface.
+func addRegistrationEntry(src, method, entry string) (string, error) {
+	funcRegex := regexp.MustCompile(`func \(\w+ Registration\) ` + regexp.QuoteMeta(method) + `\(\) ([^{\n]+) \{\n`)
+	loc := funcRegex.FindStringSubmatchIndex(src)
+	if loc == nil {
+		return "", fmt.Errorf("the `%s` method was not found on the service Registration, this must be added before the new item can be registered", method)
+	}
+
+	returnType := strings.TrimSpace(src[loc[2]:loc[3]])
+	body := src[loc[1]:]
+
+	literal := "return " + returnType + "{"
+	start := strings.Index(body, literal)
+	if start == -1 {
+		return "", fmt.Errorf("could not find `%s` in the `%s` method", literal, method)
+	}
+	start += len(literal)
+
+	offset := loc[1] + start
+
+	// an empty literal, e.g. `return []sdk.DataSource{}`
+	if strings.HasPrefix(body[start:], "}") {
+		return src[:offset] + "\n" + entry + ",\n" + src[offset:], nil
+	}
+
+	end := strings.Index(body[start:], "\n\t}")
+	if end == -1 {
+		return "", fmt.Errorf("could not find the end of the slice returned by the `%s` method", method)
+	}
+
+	if strings.Contains(body[start:start+end], "\t"+entry+",") {
+		return "", fmt.Errorf("`%s` is already registered in the `%s` method", entry, method)
+	}
+
+	offset += end
+	return src[:offset] + "\n" + entry + "," + src[offset:], nil
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package commands
+
+import (
+	"go/format"
+	"strings"
+	"testing"
+)
+
+const testRegistration = `package example
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{
+		ExistingDataSource{},
+	}
+}
+
+func (r Registration) Actions() []func() action.Action {
+	return []func() action.Action{}
+}
+`
+
+func TestAddRegistrationEntry(t *testing.T) {
+	testData := []struct {
+		method   string
+		entry    string
+		expected string
+		error    bool
+	}{
+		{
+			method:   "DataSources",
+			entry:    "NewDataSource{}",
+			expected: "\t\tExistingDataSource{},\n\t\tNewDataSource{},\n\t}",
+		},
+		{
+			method:   "Actions",
+			entry:    "newExampleAction",
+			expected: "return []func() action.Action{\n\t\tnewExampleAction,\n\t}",
+		},
+		{
+			method: "DataSources",
+			entry:  "ExistingDataSource{}",
+			error:  true,
+		},
+		{
+			method: "ListResources",
+			entry:  "NewExampleListResource",
+			error:  true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q in %q", v.entry, v.method)
+
+		actual, err := addRegistrationEntry(testRegistration, v.method, v.entry)
+		if err != nil {
+			if v.error {
+				continue
+			}
+
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		if v.error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		formatted, err := format.Source([]byte(actual))
+		if err != nil {
+			t.Fatalf("formatting output: %+v", err)
+		}
+
+		if !strings.Contains(string(formatted), v.expected) {
+			t.Fatalf("expected output to contain %q, got:\n%s", v.expected, string(formatted))
+		}
+	}
+}
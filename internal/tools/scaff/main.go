@@ -34,6 +34,26 @@ func realMain(args []string) int {
 				Ui: ui,
 			}, nil
 		},
+		"datasource": func() (cli.Command, error) {
+			return &commands.DataSourceCommand{
+				Ui: ui,
+			}, nil
+		},
+		"list": func() (cli.Command, error) {
+			return &commands.ListResourceCommand{
+				Ui: ui,
+			}, nil
+		},
+		"action": func() (cli.Command, error) {
+			return &commands.ActionCommand{
+				Ui: ui,
+			}, nil
+		},
+		"ephemeral": func() (cli.Command, error) {
+			return &commands.EphemeralResourceCommand{
+				Ui: ui,
+			}, nil
+		},
 	}
 
 	gen := cli.CLI{
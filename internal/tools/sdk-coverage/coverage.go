@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// serviceCoverage is the coverage report for a single Service Package
+type serviceCoverage struct {
+	Service          string             `json:"service"`
+	MissingCount     int                `json:"missing_count"`
+	Resources        []resourceCoverage `json:"resources"`
+	SkippedResources []skippedResource  `json:"skipped_resources,omitempty"`
+}
+
+// resourceCoverage is the list of writable properties in the request model which have no corresponding schema argument
+type resourceCoverage struct {
+	ResourceType      string            `json:"resource_type"`
+	SdkPackage        string            `json:"sdk_package"`
+	ApiVersion        string            `json:"api_version"`
+	RequestModel      string            `json:"request_model"`
+	MissingProperties []missingProperty `json:"missing_properties"`
+}
+
+type missingProperty struct {
+	// Path is the JSON path to the property within the request body, e.g. `properties.publicNetworkAccess`
+	Path string `json:"path"`
+	Type string `json:"type"`
+}
+
+type skippedResource struct {
+	ResourceType string `json:"resource_type"`
+	Reason       string `json:"reason"`
+}
+
+// readOnlyProperties are properties (normalised to lower case) which are returned by the API but can't be set, the
+// go-azure-sdk models don't distinguish these so they're excluded by name
+var readOnlyProperties = map[string]struct{}{
+	"etag":                       {},
+	"privateendpointconnections": {},
+	"provisioningstate":          {},
+	"resourceguid":               {},
+	"systemdata":                 {},
+}
+
+// readOnlyTopLevelProperties are the properties which are part of the ARM envelope and are set from the Resource ID
+var readOnlyTopLevelProperties = map[string]struct{}{
+	"id":   {},
+	"name": {},
+	"type": {},
+}
+
+// findMissingProperties walks the request model and returns the properties which have no corresponding schema argument.
+// Where none of the nested properties of a model are exposed, the parent property is returned rather than each of the
+// nested properties.
+func findMissingProperties(pkg *sdkPackage, arguments map[string]*pluginsdk.Schema) []missingProperty {
+	schemaKeys := normalisedSchemaKeys(arguments)
+
+	var walk func(model string, path string, visited map[string]struct{}) (missing []missingProperty, anyCovered bool)
+	walk = func(model string, path string, visited map[string]struct{}) ([]missingProperty, bool) {
+		missing := make([]missingProperty, 0)
+		anyCovered := false
+
+		if _, ok := visited[model]; ok {
+			// recursive models are only walked once
+			return missing, false
+		}
+		visited[model] = struct{}{}
+		defer delete(visited, model)
+
+		for _, field := range pkg.Models[model] {
+			if _, ok := readOnlyProperties[strings.ToLower(field.JsonName)]; ok {
+				continue
+			}
+			if _, ok := readOnlyTopLevelProperties[field.JsonName]; ok && path == "" {
+				continue
+			}
+
+			fieldPath := field.JsonName
+			if path != "" {
+				fieldPath = path + "." + field.JsonName
+			}
+
+			covered, reference := isCovered(field.JsonName, schemaKeys)
+			if reference {
+				// the nested model is a reference to another resource (e.g. `subnet` exposed as `subnet_id`)
+				anyCovered = true
+				continue
+			}
+			if field.Model == "" {
+				if covered {
+					anyCovered = true
+				} else {
+					missing = append(missing, missingProperty{Path: fieldPath, Type: field.Type})
+				}
+				continue
+			}
+
+			nestedMissing, nestedCovered := walk(field.Model, fieldPath, visited)
+			switch {
+			case covered || nestedCovered:
+				anyCovered = true
+				missing = append(missing, nestedMissing...)
+			case len(nestedMissing) > 0:
+				missing = append(missing, missingProperty{Path: fieldPath, Type: field.Type})
+			}
+		}
+
+		return missing, anyCovered
+	}
+
+	missing, _ := walk(pkg.CreateModel, "", map[string]struct{}{})
+
+	// shallower properties are generally more significant features, so these are ranked first
+	sort.Slice(missing, func(i, j int) bool {
+		di, dj := strings.Count(missing[i].Path, "."), strings.Count(missing[j].Path, ".")
+		if di != dj {
+			return di < dj
+		}
+		return missing[i].Path < missing[j].Path
+	})
+	return missing
+}
+
+// normalisedSchemaKeys returns the names of all arguments at any level within the schema
+func normalisedSchemaKeys(arguments map[string]*pluginsdk.Schema) map[string]struct{} {
+	keys := make(map[string]struct{})
+
+	var walk func(arguments map[string]*pluginsdk.Schema)
+	walk = func(arguments map[string]*pluginsdk.Schema) {
+		for key, s := range arguments {
+			keys[normalise(key)] = struct{}{}
+			if r, ok := s.Elem.(*pluginsdk.Resource); ok {
+				walk(r.Schema)
+			}
+		}
+	}
+	walk(arguments)
+
+	return keys
+}
+
+// isCovered returns whether the API property has a corresponding schema argument, accounting for the common renames
+// used within the Provider (e.g. `enableFoo` is exposed as `foo_enabled`, and `sku` as `sku_name`), and whether the
+// property is exposed as a reference to another resource (e.g. `subnet` as `subnet_id`)
+func isCovered(jsonName string, schemaKeys map[string]struct{}) (covered bool, reference bool) {
+	name := normalise(jsonName)
+
+	for _, candidate := range []string{name + "id", name + "ids", strings.TrimSuffix(name, "s") + "ids"} {
+		if _, ok := schemaKeys[candidate]; ok {
+			return true, true
+		}
+	}
+
+	candidates := []string{name, strings.TrimSuffix(name, "s"), name + "s"}
+	for _, prefix := range []string{"enable", "enabled", "disable", "is"} {
+		if trimmed := strings.TrimPrefix(name, prefix); trimmed != name && trimmed != "" {
+			candidates = append(candidates, trimmed, trimmed+"enabled", trimmed+"disabled")
+		}
+	}
+	candidates = append(candidates, name+"enabled", name+"name")
+
+	for _, candidate := range candidates {
+		if _, ok := schemaKeys[candidate]; ok {
+			return true, false
+		}
+	}
+	return false, false
+}
+
+func normalise(input string) string {
+	return strings.ToLower(strings.ReplaceAll(input, "_", ""))
+}
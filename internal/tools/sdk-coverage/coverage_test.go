@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestFindMissingProperties(t *testing.T) {
+	pkg := &sdkPackage{
+		CreateModel: "Widget",
+		Models: map[string][]sdkField{
+			"Widget": {
+				{Name: "Id", JsonName: "id", Type: "*string"},
+				{Name: "Location", JsonName: "location", Type: "string"},
+				{Name: "Properties", JsonName: "properties", Type: "*WidgetProperties", Model: "WidgetProperties"},
+				{Name: "Sku", JsonName: "sku", Type: "*Sku", Model: "Sku"},
+				{Name: "Tags", JsonName: "tags", Type: "*map[string]string"},
+			},
+			"WidgetProperties": {
+				{Name: "EnableHttps", JsonName: "enableHttps", Type: "*bool"},
+				{Name: "Network", JsonName: "network", Type: "*NetworkSettings", Model: "NetworkSettings"},
+				{Name: "ProvisioningState", JsonName: "provisioningState", Type: "*ProvisioningState"},
+				{Name: "PublicNetworkAccess", JsonName: "publicNetworkAccess", Type: "*PublicNetworkAccess"},
+				{Name: "Subnet", JsonName: "subnet", Type: "*SubResource", Model: "SubResource"},
+			},
+			"NetworkSettings": {
+				{Name: "AllowedIPs", JsonName: "allowedIPs", Type: "*[]string"},
+				{Name: "DefaultAction", JsonName: "defaultAction", Type: "*string"},
+			},
+			"Sku": {
+				{Name: "Name", JsonName: "name", Type: "string"},
+				{Name: "Tier", JsonName: "tier", Type: "*string"},
+			},
+			"SubResource": {
+				{Name: "Id", JsonName: "id", Type: "*string"},
+			},
+		},
+	}
+
+	arguments := map[string]*pluginsdk.Schema{
+		"name":           {Type: pluginsdk.TypeString},
+		"location":       {Type: pluginsdk.TypeString},
+		"sku_name":       {Type: pluginsdk.TypeString},
+		"https_enabled":  {Type: pluginsdk.TypeBool},
+		"subnet_id":      {Type: pluginsdk.TypeString},
+		"tags":           {Type: pluginsdk.TypeMap},
+		"unused_setting": {Type: pluginsdk.TypeString},
+	}
+
+	expected := []missingProperty{
+		{Path: "properties.network", Type: "*NetworkSettings"},
+		{Path: "properties.publicNetworkAccess", Type: "*PublicNetworkAccess"},
+		{Path: "sku.tier", Type: "*string"},
+	}
+
+	actual := findMissingProperties(pkg, arguments)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+)
+
+func main() {
+	f := flag.NewFlagSet("sdk-coverage", flag.ExitOnError)
+
+	rootDirectory := f.String("root-dir", "../../..", "the path to the root of the repository, used to locate the vendored go-azure-sdk packages")
+	serviceName := f.String("service", "", "(Optional) only report on this Service Package, e.g. `redis`")
+	outputDirectory := f.String("output-dir", "", "(Optional) write a JSON report per Service Package into this directory rather than to stdout")
+
+	if err := f.Parse(os.Args[1:]); err != nil {
+		log.Fatalf("parsing arguments: %+v", err)
+	}
+
+	services, err := run(*rootDirectory, *serviceName)
+	if err != nil {
+		log.Fatalf("error: %+v", err)
+	}
+
+	if *outputDirectory == "" {
+		out, err := json.MarshalIndent(services, "", "  ")
+		if err != nil {
+			log.Fatalf("marshaling the report: %+v", err)
+		}
+		fmt.Println(string(out))
+		return
+	}
+
+	if err := os.MkdirAll(*outputDirectory, 0o755); err != nil {
+		log.Fatalf("creating the output directory %q: %+v", *outputDirectory, err)
+	}
+	for _, service := range services {
+		out, err := json.MarshalIndent(service, "", "  ")
+		if err != nil {
+			log.Fatalf("marshaling the report for %q: %+v", service.Service, err)
+		}
+		fileName := filepath.Join(*outputDirectory, fmt.Sprintf("%s.json", service.Service))
+		if err := os.WriteFile(fileName, out, 0o644); err != nil {
+			log.Fatalf("writing the report for %q to %q: %+v", service.Service, fileName, err)
+		}
+	}
+}
+
+// run builds the coverage report for each Typed Service, ranked by the number of missing properties
+func run(rootDirectory string, serviceName string) ([]serviceCoverage, error) {
+	// packages are shared between resources, so are only parsed once
+	packages := make(map[string]*sdkPackage)
+
+	services := make([]serviceCoverage, 0)
+	for _, service := range provider.SupportedTypedServices() {
+		name := path.Base(reflect.TypeOf(service).PkgPath())
+		if serviceName != "" && name != serviceName {
+			continue
+		}
+
+		coverage := serviceCoverage{
+			Service:   name,
+			Resources: make([]resourceCoverage, 0),
+		}
+
+		for _, resource := range service.Resources() {
+			result, reason, err := coverageForResource(rootDirectory, name, resource, packages)
+			if err != nil {
+				return nil, fmt.Errorf("building the coverage for %q: %+v", resource.ResourceType(), err)
+			}
+			if result == nil {
+				coverage.SkippedResources = append(coverage.SkippedResources, skippedResource{
+					ResourceType: resource.ResourceType(),
+					Reason:       reason,
+				})
+				continue
+			}
+
+			if len(result.MissingProperties) > 0 {
+				coverage.Resources = append(coverage.Resources, *result)
+				coverage.MissingCount += len(result.MissingProperties)
+			}
+		}
+
+		sort.Slice(coverage.Resources, func(i, j int) bool {
+			a, b := coverage.Resources[i], coverage.Resources[j]
+			if len(a.MissingProperties) != len(b.MissingProperties) {
+				return len(a.MissingProperties) > len(b.MissingProperties)
+			}
+			return a.ResourceType < b.ResourceType
+		})
+
+		services = append(services, coverage)
+	}
+
+	sort.Slice(services, func(i, j int) bool {
+		if services[i].MissingCount != services[j].MissingCount {
+			return services[i].MissingCount > services[j].MissingCount
+		}
+		return services[i].Service < services[j].Service
+	})
+
+	return services, nil
+}
+
+// coverageForResource compares the request model for the resource's go-azure-sdk package with its schema
+func coverageForResource(rootDirectory string, service string, resource sdk.Resource, packages map[string]*sdkPackage) (*resourceCoverage, string, error) {
+	if strings.HasSuffix(resource.ResourceType(), "_association") {
+		return nil, "association resources only manage part of the request model", nil
+	}
+
+	packagePath, reason, err := sdkPackageForResource(rootDirectory, service, resource)
+	if err != nil {
+		return nil, "", err
+	}
+	if packagePath == "" {
+		return nil, reason, nil
+	}
+
+	pkg, ok := packages[packagePath]
+	if !ok {
+		var err error
+		pkg, err = parseSdkPackage(filepath.Join(rootDirectory, "vendor", packagePath))
+		if err != nil {
+			return nil, "", err
+		}
+		packages[packagePath] = pkg
+	}
+
+	if pkg.CreateModel == "" {
+		return nil, fmt.Sprintf("no Create operation was found within `%s`", packagePath), nil
+	}
+
+	// the package path is in the format `{module}/{service}/{apiVersion}/{package}`
+	segments := strings.Split(strings.TrimPrefix(packagePath, sdkModulePath+"/"), "/")
+	apiVersion := ""
+	if len(segments) >= 2 {
+		apiVersion = segments[1]
+	}
+
+	return &resourceCoverage{
+		ResourceType:      resource.ResourceType(),
+		SdkPackage:        packagePath,
+		ApiVersion:        apiVersion,
+		RequestModel:      pkg.CreateModel,
+		MissingProperties: findMissingProperties(pkg, resource.Arguments()),
+	}, "", nil
+}
+
+// sdkPackageForResource determines the go-azure-sdk package used by the resource, either from the resource's ID type
+// or, where the ID type is defined elsewhere (e.g. `commonids`), from the ID parser used within the resource's source
+// file. When the package can't be determined, the reason is returned.
+func sdkPackageForResource(rootDirectory string, service string, resource sdk.Resource) (string, string, error) {
+	if withIdentity, ok := resource.(sdk.ResourceWithIdentity); ok {
+		idType := reflect.TypeOf(withIdentity.Identity())
+		if idType.Kind() == reflect.Pointer {
+			idType = idType.Elem()
+		}
+		if strings.HasPrefix(idType.PkgPath(), sdkModulePath+"/") {
+			return idType.PkgPath(), "", nil
+		}
+	}
+
+	fileName := filepath.Join(rootDirectory, "internal", "services", service, fmt.Sprintf("%s_resource.go", strings.TrimPrefix(resource.ResourceType(), "azurerm_")))
+	if _, err := os.Stat(fileName); os.IsNotExist(err) {
+		return "", fmt.Sprintf("the source file %q was not found", filepath.Base(fileName)), nil
+	}
+
+	packagePath, err := sdkPackageFromSourceFile(fileName)
+	if err != nil {
+		return "", "", err
+	}
+	if packagePath == "" {
+		return "", "the go-azure-sdk package could not be determined from the ID parser used by the resource", nil
+	}
+
+	return packagePath, "", nil
+}
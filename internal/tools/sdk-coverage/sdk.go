@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const sdkModulePath = "github.com/hashicorp/go-azure-sdk/resource-manager"
+
+// sdkField is a single field within a model in a go-azure-sdk package
+type sdkField struct {
+	Name     string
+	JsonName string
+	Type     string
+
+	// Model is the name of the model within the same package which this field references (including via a pointer,
+	// slice or map), if any
+	Model string
+}
+
+// sdkPackage is the set of models and request models for the Create/Update operations within a go-azure-sdk package
+type sdkPackage struct {
+	Models map[string][]sdkField
+
+	// CreateModel is the model used as the request body for the Create/CreateOrUpdate/Put operation
+	CreateModel string
+}
+
+// parseSdkPackage parses the models and methods defined within a go-azure-sdk package directory
+func parseSdkPackage(directory string) (*sdkPackage, error) {
+	fileSet := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fileSet, directory, func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", directory, err)
+	}
+
+	out := &sdkPackage{
+		Models: make(map[string][]sdkField),
+	}
+
+	createCandidates := make([]createCandidate, 0)
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				switch v := decl.(type) {
+				case *ast.GenDecl:
+					if v.Tok != token.TYPE {
+						continue
+					}
+					for _, spec := range v.Specs {
+						typeSpec, ok := spec.(*ast.TypeSpec)
+						if !ok {
+							continue
+						}
+						if structType, ok := typeSpec.Type.(*ast.StructType); ok {
+							out.Models[typeSpec.Name.Name] = parseStructFields(structType)
+						}
+					}
+
+				case *ast.FuncDecl:
+					if candidate := createCandidateForMethod(v); candidate != nil {
+						createCandidates = append(createCandidates, *candidate)
+					}
+				}
+			}
+		}
+	}
+
+	// any references to types which aren't models (e.g. constants) are cleared, so that only models are walked
+	for name, fields := range out.Models {
+		for i := range fields {
+			if _, ok := out.Models[fields[i].Model]; !ok {
+				fields[i].Model = ""
+			}
+		}
+		out.Models[name] = fields
+	}
+
+	// when a package has multiple Create operations, the one with the shortest name is the primary operation,
+	// e.g. `CreateOrUpdate` rather than `CreateOrUpdateConfiguration`
+	var chosen *createCandidate
+	for i, candidate := range createCandidates {
+		if _, ok := out.Models[candidate.Model]; !ok {
+			continue
+		}
+		if chosen == nil || len(candidate.Method) < len(chosen.Method) || (len(candidate.Method) == len(chosen.Method) && candidate.Method < chosen.Method) {
+			chosen = &createCandidates[i]
+		}
+	}
+	if chosen != nil {
+		out.CreateModel = chosen.Model
+	}
+
+	return out, nil
+}
+
+type createCandidate struct {
+	Method string
+	Model  string
+}
+
+// createCandidateForMethod returns the request model for a Create/CreateOrUpdate/Put method on the client
+func createCandidateForMethod(fn *ast.FuncDecl) *createCandidate {
+	if fn.Recv == nil || !fn.Name.IsExported() {
+		return nil
+	}
+
+	name := fn.Name.Name
+	if strings.HasSuffix(name, "ThenPoll") || strings.HasSuffix(name, "Complete") {
+		return nil
+	}
+	// e.g. `CreateOrUpdate`, `Create`, `AccessPolicyCreateUpdate` or `Put`
+	if !strings.Contains(name, "Create") && !strings.HasSuffix(name, "Put") {
+		return nil
+	}
+
+	for _, param := range fn.Type.Params.List {
+		for _, paramName := range param.Names {
+			if paramName.Name != "input" {
+				continue
+			}
+			if model := modelNameForExpr(param.Type); model != "" {
+				return &createCandidate{
+					Method: name,
+					Model:  model,
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func parseStructFields(structType *ast.StructType) []sdkField {
+	fields := make([]sdkField, 0)
+	for _, field := range structType.Fields.List {
+		jsonName := ""
+		if field.Tag != nil {
+			if tag, err := strconv.Unquote(field.Tag.Value); err == nil {
+				jsonName = strings.Split(reflect.StructTag(tag).Get("json"), ",")[0]
+			}
+		}
+		if jsonName == "" || jsonName == "-" {
+			continue
+		}
+
+		for _, name := range field.Names {
+			fields = append(fields, sdkField{
+				Name:     name.Name,
+				JsonName: jsonName,
+				Type:     types.ExprString(field.Type),
+				Model:    modelNameForExpr(field.Type),
+			})
+		}
+	}
+	return fields
+}
+
+// modelNameForExpr returns the name of the type within the same package, unwrapping any pointers, slices and maps
+func modelNameForExpr(expr ast.Expr) string {
+	for {
+		switch v := expr.(type) {
+		case *ast.StarExpr:
+			expr = v.X
+		case *ast.ArrayType:
+			expr = v.Elt
+		case *ast.MapType:
+			expr = v.Value
+		case *ast.Ident:
+			if v.IsExported() {
+				return v.Name
+			}
+			return ""
+		default:
+			return ""
+		}
+	}
+}
+
+// sdkPackageFromSourceFile returns the go-azure-sdk package whose Resource ID parser (e.g. `widgets.ParseWidgetID`) is
+// used within the source file, or when only a single go-azure-sdk package is imported, that package
+func sdkPackageFromSourceFile(fileName string) (string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), fileName, nil, 0)
+	if err != nil {
+		return "", fmt.Errorf("parsing %q: %+v", fileName, err)
+	}
+
+	aliases := make(map[string]string)
+	for _, item := range file.Imports {
+		importPath, err := strconv.Unquote(item.Path.Value)
+		if err != nil || !strings.HasPrefix(importPath, sdkModulePath+"/") || strings.HasSuffix(importPath, "/commonids") {
+			continue
+		}
+		alias := path.Base(importPath)
+		if item.Name != nil {
+			alias = item.Name.Name
+		}
+		aliases[alias] = importPath
+	}
+
+	packagePath := ""
+	ast.Inspect(file, func(n ast.Node) bool {
+		if packagePath != "" {
+			return false
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		selector, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || !strings.HasPrefix(selector.Sel.Name, "Parse") || !strings.HasSuffix(selector.Sel.Name, "ID") {
+			return true
+		}
+		if ident, ok := selector.X.(*ast.Ident); ok {
+			packagePath = aliases[ident.Name]
+		}
+		return true
+	})
+
+	if packagePath == "" && len(aliases) == 1 {
+		for _, v := range aliases {
+			packagePath = v
+		}
+	}
+
+	return packagePath, nil
+}
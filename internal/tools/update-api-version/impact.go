@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+)
+
+// functionUsage records which models (and fields of those models) from an SDK package are used within a function
+type functionUsage struct {
+	FileName     string
+	FunctionName string
+	ResourceType string
+
+	// Models is the set of models from the SDK package which are referenced within the function
+	Models map[string]struct{}
+	// Selectors is the set of field/method names which are selected within the function, e.g. `props.Foo`
+	Selectors map[string]struct{}
+	// Keys is the set of fields which are set by name in composite literals, keyed by model
+	Keys map[string]map[string]struct{}
+	// SchemaProperties is the set of schema properties (and `tfschema` tags) defined within the same file
+	SchemaProperties map[string]struct{}
+}
+
+// referencesField returns whether the function likely references the field of the model, that is the model is used
+// within the function and the field is either selected or set in a composite literal of that model.
+func (u functionUsage) referencesField(model, field string) bool {
+	if _, ok := u.Models[model]; !ok {
+		return false
+	}
+	if _, ok := u.Selectors[field]; ok {
+		return true
+	}
+	_, ok := u.Keys[model][field]
+	return ok
+}
+
+func (u functionUsage) referencesModel(model string) bool {
+	_, ok := u.Models[model]
+	return ok
+}
+
+// schemaPropertyForField returns the schema property matching the field, if one is defined in the same file
+func (u functionUsage) schemaPropertyForField(field string) string {
+	property := strcase.ToSnake(field)
+	if _, ok := u.SchemaProperties[property]; ok {
+		return property
+	}
+	return ""
+}
+
+// packageUsages is the list of functions using each SDK package, keyed by the package name
+type packageUsages map[string][]functionUsage
+
+// findPackageUsages parses the Go files within the directories and records the functions which use each of the SDK
+// packages imported from the old API version
+func findPackageUsages(directories []string, importPathForPreviousApiVersion string) (packageUsages, error) {
+	usages := make(packageUsages)
+
+	for _, directory := range directories {
+		fileSet := token.NewFileSet()
+		pkgs, err := parser.ParseDir(fileSet, directory, func(info fs.FileInfo) bool {
+			return !strings.HasSuffix(info.Name(), "_test.go")
+		}, 0)
+		if err != nil {
+			return nil, fmt.Errorf("parsing files within %q: %+v", directory, err)
+		}
+
+		for _, pkg := range pkgs {
+			for fileName, file := range pkg.Files {
+				findPackageUsagesInFile(usages, fileName, file, importPathForPreviousApiVersion)
+			}
+		}
+	}
+
+	for name := range usages {
+		sort.Slice(usages[name], func(i, j int) bool {
+			a, b := usages[name][i], usages[name][j]
+			if a.FileName != b.FileName {
+				return a.FileName < b.FileName
+			}
+			return a.FunctionName < b.FunctionName
+		})
+	}
+
+	return usages, nil
+}
+
+func findPackageUsagesInFile(usages packageUsages, fileName string, file *ast.File, importPathForPreviousApiVersion string) {
+	// map the alias used within this file to the SDK package name
+	aliases := make(map[string]string)
+	for _, item := range file.Imports {
+		importPath, err := strconv.Unquote(item.Path.Value)
+		if err != nil || !strings.HasPrefix(importPath, importPathForPreviousApiVersion+"/") {
+			continue
+		}
+
+		packageName := path.Base(importPath)
+		alias := packageName
+		if item.Name != nil {
+			alias = item.Name.Name
+		}
+		aliases[alias] = packageName
+	}
+	if len(aliases) == 0 {
+		return
+	}
+
+	schemaProperties := findSchemaProperties(file)
+	resourceType := resourceTypeForFile(fileName)
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+
+		functionName := fn.Name.Name
+		if fn.Recv != nil && len(fn.Recv.List) > 0 {
+			functionName = fmt.Sprintf("%s.%s", receiverTypeName(fn.Recv.List[0].Type), functionName)
+		}
+
+		usagesForFunction := make(map[string]*functionUsage)
+		usageFor := func(packageName string) *functionUsage {
+			if v, ok := usagesForFunction[packageName]; ok {
+				return v
+			}
+			v := &functionUsage{
+				FileName:         fileName,
+				FunctionName:     functionName,
+				ResourceType:     resourceType,
+				Models:           make(map[string]struct{}),
+				Selectors:        make(map[string]struct{}),
+				Keys:             make(map[string]map[string]struct{}),
+				SchemaProperties: schemaProperties,
+			}
+			usagesForFunction[packageName] = v
+			return v
+		}
+
+		selectors := make(map[string]struct{})
+		ast.Inspect(fn, func(n ast.Node) bool {
+			switch v := n.(type) {
+			case *ast.SelectorExpr:
+				if ident, ok := v.X.(*ast.Ident); ok {
+					if packageName, ok := aliases[ident.Name]; ok {
+						usageFor(packageName).Models[v.Sel.Name] = struct{}{}
+						return true
+					}
+				}
+				selectors[v.Sel.Name] = struct{}{}
+
+			case *ast.CompositeLit:
+				packageName, model := sdkTypeForExpr(v.Type, aliases)
+				if packageName == "" {
+					return true
+				}
+				usage := usageFor(packageName)
+				if _, ok := usage.Keys[model]; !ok {
+					usage.Keys[model] = make(map[string]struct{})
+				}
+				for _, elt := range v.Elts {
+					if kv, ok := elt.(*ast.KeyValueExpr); ok {
+						if key, ok := kv.Key.(*ast.Ident); ok {
+							usage.Keys[model][key.Name] = struct{}{}
+						}
+					}
+				}
+			}
+			return true
+		})
+
+		for packageName, usage := range usagesForFunction {
+			usage.Selectors = selectors
+			usages[packageName] = append(usages[packageName], *usage)
+		}
+	}
+}
+
+// sdkTypeForExpr returns the package name and model name for a (pointer to a) type from one of the SDK packages
+func sdkTypeForExpr(expr ast.Expr, aliases map[string]string) (string, string) {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	selector, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return "", ""
+	}
+	ident, ok := selector.X.(*ast.Ident)
+	if !ok {
+		return "", ""
+	}
+	packageName, ok := aliases[ident.Name]
+	if !ok {
+		return "", ""
+	}
+	return packageName, selector.Sel.Name
+}
+
+func receiverTypeName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// findSchemaProperties returns the keys of any `map[string]...` composite literals (e.g. the Schema) and the values
+// of any `tfschema` struct tags (e.g. the Typed Resource models) within the file
+func findSchemaProperties(file *ast.File) map[string]struct{} {
+	properties := make(map[string]struct{})
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch v := n.(type) {
+		case *ast.CompositeLit:
+			mapType, ok := v.Type.(*ast.MapType)
+			if !ok {
+				return true
+			}
+			if key, ok := mapType.Key.(*ast.Ident); !ok || key.Name != "string" {
+				return true
+			}
+			for _, elt := range v.Elts {
+				kv, ok := elt.(*ast.KeyValueExpr)
+				if !ok {
+					continue
+				}
+				if lit, ok := kv.Key.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+					if value, err := strconv.Unquote(lit.Value); err == nil {
+						properties[value] = struct{}{}
+					}
+				}
+			}
+
+		case *ast.Field:
+			if v.Tag == nil {
+				return true
+			}
+			tag, err := strconv.Unquote(v.Tag.Value)
+			if err != nil {
+				return true
+			}
+			if value := reflect.StructTag(tag).Get("tfschema"); value != "" {
+				properties[strings.Split(value, ",")[0]] = struct{}{}
+			}
+		}
+		return true
+	})
+
+	return properties
+}
+
+// resourceTypeForFile returns the Terraform resource/data source type for a file following the naming conventions
+// used within the Service Packages, e.g. `virtual_machine_resource.go` is `azurerm_virtual_machine`
+func resourceTypeForFile(fileName string) string {
+	name := strings.TrimSuffix(filepath.Base(fileName), ".go")
+	for _, suffix := range []string{"_resource", "_data_source"} {
+		if strings.HasSuffix(name, suffix) {
+			return fmt.Sprintf("azurerm_%s", strings.TrimSuffix(name, suffix))
+		}
+	}
+	return ""
+}
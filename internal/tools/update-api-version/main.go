@@ -34,6 +34,8 @@ func main() {
 	serviceName := f.String("service", "", "-service=compute")
 	oldApiVersion := f.String("old-api-version", "", "-old-api-version=2019-01-01")
 	newApiVersion := f.String("new-api-version", "", "-new-api-version=2023-06-01")
+	report := f.Bool("report", false, "-report")
+	reportFile := f.String("report-file", "", "-report-file=upgrade.md")
 	if len(os.Args) == 1 { // 0 is the app name
 		log.Fatalf("expected multiple arguments but didn't get any")
 	}
@@ -51,9 +53,32 @@ func main() {
 	}
 
 	workingDirectory := "../.." // path to the `internal` folder
+
+	// the impact report needs to be built prior to updating the imports, since it relies on the usages of the old API version
+	var impact *impactReport
+	if *report || *reportFile != "" {
+		var err error
+		impact, err = buildImpactReport(*serviceName, *oldApiVersion, *newApiVersion, workingDirectory)
+		if err != nil {
+			log.Fatalf("building the impact report: %+v", err)
+		}
+	}
+
 	if err := run(*serviceName, *oldApiVersion, *newApiVersion, workingDirectory); err != nil {
 		log.Fatalf("error: %+v", err)
 	}
+
+	if impact != nil {
+		impact.compile(workingDirectory)
+
+		if *reportFile == "" {
+			fmt.Print(impact.markdown())
+			return
+		}
+		if err := os.WriteFile(*reportFile, []byte(impact.markdown()), 0o644); err != nil {
+			log.Fatalf("writing the impact report to %q: %+v", *reportFile, err)
+		}
+	}
 }
 
 func run(serviceName string, oldApiVersion string, newApiVersion string, workingDirectory string) error {
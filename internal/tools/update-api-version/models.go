@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const sdkModulePath = "github.com/hashicorp/go-azure-sdk/resource-manager"
+
+// sdkModel is the set of fields (Go field name to Go type) for each struct type within an SDK package
+type sdkModel map[string]map[string]string
+
+type fieldChangeType string
+
+const (
+	fieldAdded   fieldChangeType = "added"
+	fieldRemoved fieldChangeType = "removed"
+	fieldRetyped fieldChangeType = "retyped"
+)
+
+type fieldChange struct {
+	Model   string
+	Field   string
+	Change  fieldChangeType
+	OldType string
+	NewType string
+}
+
+type packageDiff struct {
+	Package       string
+	Removed       bool
+	AddedModels   []string
+	RemovedModels []string
+	FieldChanges  []fieldChange
+}
+
+func (d packageDiff) hasChanges() bool {
+	return d.Removed || len(d.AddedModels) > 0 || len(d.RemovedModels) > 0 || len(d.FieldChanges) > 0
+}
+
+// sdkPackageDirectory returns the directory containing the source for an SDK package, looking in the `vendor`
+// directory first and then falling back to the Go module cache, since the new API version won't be vendored
+// until something imports it.
+func sdkPackageDirectory(repositoryDirectory string, importPath string) (string, error) {
+	vendored := filepath.Join(repositoryDirectory, "vendor", importPath)
+	if _, err := os.Stat(vendored); err == nil {
+		return vendored, nil
+	}
+
+	cmd := exec.Command("go", "list", "-mod=mod", "-m", "-f", "{{.Dir}}", sdkModulePath)
+	cmd.Dir = repositoryDirectory
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("locating the module directory for %q: %+v", sdkModulePath, err)
+	}
+
+	moduleDirectory := strings.TrimSpace(string(out))
+	if moduleDirectory == "" {
+		return "", fmt.Errorf("the module %q has not been downloaded, run `go mod download` first", sdkModulePath)
+	}
+
+	return filepath.Join(moduleDirectory, strings.TrimPrefix(importPath, sdkModulePath)), nil
+}
+
+// parseSdkModels parses the struct types defined in the `model_*.go` files within an SDK package directory
+func parseSdkModels(directory string) (sdkModel, error) {
+	fileSet := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fileSet, directory, func(info fs.FileInfo) bool {
+		return strings.HasPrefix(info.Name(), "model_") && !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, fmt.Errorf("parsing models within %q: %+v", directory, err)
+	}
+
+	models := make(sdkModel)
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.TYPE {
+					continue
+				}
+
+				for _, spec := range gen.Specs {
+					typeSpec, ok := spec.(*ast.TypeSpec)
+					if !ok {
+						continue
+					}
+					structType, ok := typeSpec.Type.(*ast.StructType)
+					if !ok {
+						continue
+					}
+
+					fields := make(map[string]string)
+					for _, field := range structType.Fields.List {
+						fieldType := types.ExprString(field.Type)
+						for _, name := range field.Names {
+							fields[name.Name] = fieldType
+						}
+						if len(field.Names) == 0 {
+							// embedded type
+							fields[fieldType] = fieldType
+						}
+					}
+					models[typeSpec.Name.Name] = fields
+				}
+			}
+		}
+	}
+
+	return models, nil
+}
+
+// diffSdkModels compares the models from the old and new API versions of an SDK package
+func diffSdkModels(packageName string, oldModels, newModels sdkModel) packageDiff {
+	diff := packageDiff{
+		Package: packageName,
+	}
+
+	for model, oldFields := range oldModels {
+		newFields, ok := newModels[model]
+		if !ok {
+			diff.RemovedModels = append(diff.RemovedModels, model)
+			continue
+		}
+
+		for field, oldType := range oldFields {
+			newType, ok := newFields[field]
+			switch {
+			case !ok:
+				diff.FieldChanges = append(diff.FieldChanges, fieldChange{Model: model, Field: field, Change: fieldRemoved, OldType: oldType})
+			case oldType != newType:
+				diff.FieldChanges = append(diff.FieldChanges, fieldChange{Model: model, Field: field, Change: fieldRetyped, OldType: oldType, NewType: newType})
+			}
+		}
+
+		for field, newType := range newFields {
+			if _, ok := oldFields[field]; !ok {
+				diff.FieldChanges = append(diff.FieldChanges, fieldChange{Model: model, Field: field, Change: fieldAdded, NewType: newType})
+			}
+		}
+	}
+
+	for model := range newModels {
+		if _, ok := oldModels[model]; !ok {
+			diff.AddedModels = append(diff.AddedModels, model)
+		}
+	}
+
+	sort.Strings(diff.AddedModels)
+	sort.Strings(diff.RemovedModels)
+	sort.Slice(diff.FieldChanges, func(i, j int) bool {
+		a, b := diff.FieldChanges[i], diff.FieldChanges[j]
+		if a.Model != b.Model {
+			return a.Model < b.Model
+		}
+		return a.Field < b.Field
+	})
+
+	return diff
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"reflect"
+	"testing"
+)
+
+func TestDiffSdkModels(t *testing.T) {
+	oldModels := sdkModel{
+		"Properties": {
+			"Enabled":  "*bool",
+			"Capacity": "*int64",
+			"Legacy":   "*string",
+		},
+		"Removed": {},
+	}
+	newModels := sdkModel{
+		"Properties": {
+			"Enabled":  "*bool",
+			"Capacity": "*string",
+			"Zones":    "*[]string",
+		},
+		"Added": {},
+	}
+
+	actual := diffSdkModels("example", oldModels, newModels)
+	expected := packageDiff{
+		Package:       "example",
+		AddedModels:   []string{"Added"},
+		RemovedModels: []string{"Removed"},
+		FieldChanges: []fieldChange{
+			{Model: "Properties", Field: "Capacity", Change: fieldRetyped, OldType: "*int64", NewType: "*string"},
+			{Model: "Properties", Field: "Legacy", Change: fieldRemoved, OldType: "*string"},
+			{Model: "Properties", Field: "Zones", Change: fieldAdded, NewType: "*[]string"},
+		},
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestFindPackageUsagesInFile(t *testing.T) {
+	src := `package example
+
+import (
+	"github.com/hashicorp/go-azure-sdk/resource-manager/example/2020-01-01/widgets"
+)
+
+func (r WidgetResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"capacity": {},
+	}
+}
+
+func expandWidgetProperties(input []interface{}) *widgets.Properties {
+	return &widgets.Properties{
+		Capacity: pointer.To(int64(input[0].(int))),
+	}
+}
+
+func flattenWidgetProperties(input *widgets.Properties) []interface{} {
+	return []interface{}{input.Enabled}
+}
+`
+
+	file, err := parser.ParseFile(token.NewFileSet(), "widget_resource.go", src, 0)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	usages := make(packageUsages)
+	findPackageUsagesInFile(usages, "widget_resource.go", file, "github.com/hashicorp/go-azure-sdk/resource-manager/example/2020-01-01")
+
+	if len(usages["widgets"]) != 2 {
+		t.Fatalf("expected 2 usages of `widgets` but got %d", len(usages["widgets"]))
+	}
+
+	for _, usage := range usages["widgets"] {
+		if usage.ResourceType != "azurerm_widget" {
+			t.Fatalf("expected the resource type `azurerm_widget` but got %q", usage.ResourceType)
+		}
+
+		switch usage.FunctionName {
+		case "expandWidgetProperties":
+			if !usage.referencesField("Properties", "Capacity") {
+				t.Fatalf("expected %q to reference `Properties.Capacity`", usage.FunctionName)
+			}
+			if usage.schemaPropertyForField("Capacity") != "capacity" {
+				t.Fatalf("expected `Capacity` to map to the schema property `capacity`")
+			}
+		case "flattenWidgetProperties":
+			if !usage.referencesField("Properties", "Enabled") {
+				t.Fatalf("expected %q to reference `Properties.Enabled`", usage.FunctionName)
+			}
+			if usage.referencesField("Properties", "Capacity") {
+				t.Fatalf("expected %q not to reference `Properties.Capacity`", usage.FunctionName)
+			}
+		default:
+			t.Fatalf("unexpected usage in %q", usage.FunctionName)
+		}
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// impactReport describes the changes to the SDK models between the old and new API versions, the functions within
+// the Service Package which are likely impacted by them, and whether the Service Package compiles after the update.
+type impactReport struct {
+	ServiceName   string
+	OldApiVersion string
+	NewApiVersion string
+
+	Diffs  []packageDiff
+	Usages packageUsages
+
+	CompileOutput string
+	Compiled      bool
+}
+
+// buildImpactReport diffs the models for each of the SDK packages used by the Service Package, this needs to be called
+// prior to the imports being updated so that the usages of the old API version can be found.
+func buildImpactReport(serviceName string, oldApiVersion string, newApiVersion string, workingDirectory string) (*impactReport, error) {
+	report := &impactReport{
+		ServiceName:   serviceName,
+		OldApiVersion: oldApiVersion,
+		NewApiVersion: newApiVersion,
+	}
+
+	directories, err := directoriesForService(serviceName, workingDirectory)
+	if err != nil {
+		return nil, err
+	}
+
+	importPathForPreviousApiVersion := fmt.Sprintf("%s/%s/%s", sdkModulePath, serviceName, oldApiVersion)
+	importPathForNewApiVersion := fmt.Sprintf("%s/%s/%s", sdkModulePath, serviceName, newApiVersion)
+
+	logger.Debug(fmt.Sprintf("Finding usages of %q..", importPathForPreviousApiVersion))
+	report.Usages, err = findPackageUsages(directories, importPathForPreviousApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("finding usages of %q: %+v", importPathForPreviousApiVersion, err)
+	}
+
+	repositoryDirectory := path.Join(workingDirectory, "..")
+	packageNames := make([]string, 0, len(report.Usages))
+	for packageName := range report.Usages {
+		packageNames = append(packageNames, packageName)
+	}
+	sort.Strings(packageNames)
+
+	for _, packageName := range packageNames {
+		logger.Info(fmt.Sprintf("Comparing the models for the SDK package %q..", packageName))
+		oldDirectory, err := sdkPackageDirectory(repositoryDirectory, fmt.Sprintf("%s/%s", importPathForPreviousApiVersion, packageName))
+		if err != nil {
+			return nil, err
+		}
+		oldModels, err := parseSdkModels(oldDirectory)
+		if err != nil {
+			return nil, err
+		}
+
+		newDirectory, err := sdkPackageDirectory(repositoryDirectory, fmt.Sprintf("%s/%s", importPathForNewApiVersion, packageName))
+		if err != nil {
+			return nil, err
+		}
+		if _, err := os.Stat(newDirectory); os.IsNotExist(err) {
+			report.Diffs = append(report.Diffs, packageDiff{
+				Package: packageName,
+				Removed: true,
+			})
+			continue
+		}
+		newModels, err := parseSdkModels(newDirectory)
+		if err != nil {
+			return nil, err
+		}
+
+		report.Diffs = append(report.Diffs, diffSdkModels(packageName, oldModels, newModels))
+	}
+
+	return report, nil
+}
+
+// compile vendors the dependencies and then builds the Service Package to check whether the updated imports compile -
+// the repository builds from the `vendor` directory, which won't contain the new API version until it's re-vendored
+func (r *impactReport) compile(workingDirectory string) {
+	repositoryDirectory := path.Join(workingDirectory, "..")
+
+	logger.Info("Vendoring the dependencies..")
+	if output, err := runGo(repositoryDirectory, "mod", "vendor"); err != nil {
+		r.CompileOutput = fmt.Sprintf("running `go mod vendor`:\n%s", output)
+		return
+	}
+
+	logger.Info(fmt.Sprintf("Compiling the Service Package %q..", r.ServiceName))
+	output, err := runGo(repositoryDirectory, "build", fmt.Sprintf("./internal/services/%s/...", r.ServiceName))
+	r.Compiled = err == nil
+	r.CompileOutput = output
+}
+
+// runGo runs the Go command with the arguments within the directory, returning the combined output
+func runGo(directory string, args ...string) (string, error) {
+	cmd := exec.Command("go", args...)
+	cmd.Dir = directory
+
+	var output bytes.Buffer
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+
+	err := cmd.Run()
+	return strings.TrimSpace(output.String()), err
+}
+
+// markdown renders the report as a Markdown checklist
+func (r impactReport) markdown() string {
+	var out strings.Builder
+
+	out.WriteString(fmt.Sprintf("# API Version Upgrade: `%s` from `%s` to `%s`\n\n", r.ServiceName, r.OldApiVersion, r.NewApiVersion))
+
+	out.WriteString("## Compilation\n\n")
+	if r.Compiled {
+		out.WriteString("- [x] the Service Package compiles\n\n")
+	} else {
+		out.WriteString("- [ ] the Service Package compiles, the following errors need to be resolved:\n\n")
+		out.WriteString(fmt.Sprintf("```\n%s\n```\n\n", r.CompileOutput))
+	}
+
+	out.WriteString("## Model Changes\n\n")
+	changed := false
+	for _, diff := range r.Diffs {
+		if !diff.hasChanges() {
+			continue
+		}
+		changed = true
+
+		out.WriteString(fmt.Sprintf("### `%s`\n\n", diff.Package))
+		if diff.Removed {
+			out.WriteString(fmt.Sprintf("- [ ] the package `%s` is not present in the new API version, all usages need to be migrated:\n", diff.Package))
+			for _, usage := range r.Usages[diff.Package] {
+				out.WriteString(fmt.Sprintf("  - %s\n", usage.describe("")))
+			}
+			out.WriteString("\n")
+			continue
+		}
+
+		for _, model := range diff.RemovedModels {
+			out.WriteString(fmt.Sprintf("- [ ] the model `%s` was removed\n", model))
+			for _, usage := range r.Usages[diff.Package] {
+				if usage.referencesModel(model) {
+					out.WriteString(fmt.Sprintf("  - %s\n", usage.describe("")))
+				}
+			}
+		}
+
+		for _, change := range diff.FieldChanges {
+			switch change.Change {
+			case fieldAdded:
+				out.WriteString(fmt.Sprintf("- [ ] `%s.%s` (`%s`) was added, consider exposing it\n", change.Model, change.Field, change.NewType))
+				// the field can't be referenced yet, so list the functions using the model as candidates for exposing it
+				for _, usage := range r.Usages[diff.Package] {
+					if usage.referencesModel(change.Model) && isExpandOrFlatten(usage.FunctionName) {
+						out.WriteString(fmt.Sprintf("  - %s\n", usage.describe("")))
+					}
+				}
+
+			case fieldRemoved:
+				out.WriteString(fmt.Sprintf("- [ ] `%s.%s` (`%s`) was removed\n", change.Model, change.Field, change.OldType))
+				for _, usage := range r.Usages[diff.Package] {
+					if usage.referencesField(change.Model, change.Field) {
+						out.WriteString(fmt.Sprintf("  - %s\n", usage.describe(change.Field)))
+					}
+				}
+
+			case fieldRetyped:
+				out.WriteString(fmt.Sprintf("- [ ] `%s.%s` changed type from `%s` to `%s`\n", change.Model, change.Field, change.OldType, change.NewType))
+				for _, usage := range r.Usages[diff.Package] {
+					if usage.referencesField(change.Model, change.Field) {
+						out.WriteString(fmt.Sprintf("  - %s\n", usage.describe(change.Field)))
+					}
+				}
+			}
+		}
+
+		if len(diff.AddedModels) > 0 {
+			out.WriteString(fmt.Sprintf("- new models: `%s`\n", strings.Join(diff.AddedModels, "`, `")))
+		}
+		out.WriteString("\n")
+	}
+
+	if !changed {
+		out.WriteString("No changes were found to the models used by this Service Package.\n")
+	}
+
+	return out.String()
+}
+
+// describe returns a description of the function, including the resource and schema property where these are known
+func (u functionUsage) describe(field string) string {
+	description := fmt.Sprintf("`%s` in `%s`", u.FunctionName, filepath.Base(u.FileName))
+	if u.ResourceType != "" {
+		description += fmt.Sprintf(" (`%s`", u.ResourceType)
+		if property := u.schemaPropertyForField(field); field != "" && property != "" {
+			description += fmt.Sprintf(" property `%s`", property)
+		}
+		description += ")"
+	}
+	return description
+}
+
+func isExpandOrFlatten(functionName string) bool {
+	name := strings.ToLower(functionName[strings.LastIndex(functionName, ".")+1:])
+	return strings.HasPrefix(name, "expand") || strings.HasPrefix(name, "flatten")
+}
+
+// directoriesForService returns the Service Package directory and any directories nested within it
+func directoriesForService(serviceName string, workingDirectory string) ([]string, error) {
+	serviceDirectory := path.Join(workingDirectory, "services", serviceName)
+	entries, err := os.ReadDir(serviceDirectory)
+	if err != nil {
+		return nil, fmt.Errorf("opening the working directory at %q: %+v", serviceDirectory, err)
+	}
+
+	directories := []string{serviceDirectory}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			directories = append(directories, filepath.Join(serviceDirectory, entry.Name()))
+		}
+	}
+	return directories, nil
+}
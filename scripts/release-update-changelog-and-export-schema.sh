@@ -20,10 +20,10 @@ if [[ ! -f CHANGELOG.md ]]; then
   exit 2
 fi
 
-echo "Formatting changelog..."
+echo "Formatting changelog and exporting the JSON changelog for the release..."
 (
   set -x
-  go run internal/tools/changelog-formatter/main.go CHANGELOG.md
+  go run internal/tools/changelog-formatter/main.go CHANGELOG.md .release/changelog.json
 )
 
 # Get the next release